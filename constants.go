@@ -3,10 +3,9 @@ package emoji
 // Code generated by github.com/AkinAD/emoji/internal/generator DO NOT EDIT.
 
 // Source: https://unicode.org/Public/emoji/14.0/emoji-test.txt
-// Create at: 2022-01-13T21:03:21-05:00
+// Create at: 2026-10-19T05:56:29Z
 
 var (
-
 	// GROUP: Smileys & Emotion
 	// SUBGROUP: face-smiling
 	GrinningFace                Emoji = "\U0001f600" // grinning face
@@ -1712,279 +1711,1981 @@ var (
 	RadioButton            Emoji = "\U0001f518"   // radio button
 	WhiteSquareButton      Emoji = "\U0001f533"   // white square button
 	BlackSquareButton      Emoji = "\U0001f532"   // black square button
+)
 
-	// GROUP: Flags
-	// SUBGROUP: flag
-	ChequeredFlag   Emoji = "\U0001f3c1"                         // chequered flag
-	TriangularFlag  Emoji = "\U0001f6a9"                         // triangular flag
-	CrossedFlags    Emoji = "\U0001f38c"                         // crossed flags
-	BlackFlag       Emoji = "\U0001f3f4"                         // black flag
-	WhiteFlag       Emoji = "\U0001f3f3\ufe0f"                   // white flag
-	RainbowFlag     Emoji = "\U0001f3f3\ufe0f\u200d\U0001f308"   // rainbow flag
-	TransgenderFlag Emoji = "\U0001f3f3\ufe0f\u200d\u26a7\ufe0f" // transgender flag
-	PirateFlag      Emoji = "\U0001f3f4\u200d\u2620\ufe0f"       // pirate flag
-	// SUBGROUP: country-flag
-	FlagForAscensionIsland                     Emoji = "\U0001f1e6\U0001f1e8" // flag: Ascension Island
-	FlagForAndorra                             Emoji = "\U0001f1e6\U0001f1e9" // flag: Andorra
-	FlagForUnitedArabEmirates                  Emoji = "\U0001f1e6\U0001f1ea" // flag: United Arab Emirates
-	FlagForAfghanistan                         Emoji = "\U0001f1e6\U0001f1eb" // flag: Afghanistan
-	FlagForAntiguaAndBarbuda                   Emoji = "\U0001f1e6\U0001f1ec" // flag: Antigua & Barbuda
-	FlagForAnguilla                            Emoji = "\U0001f1e6\U0001f1ee" // flag: Anguilla
-	FlagForAlbania                             Emoji = "\U0001f1e6\U0001f1f1" // flag: Albania
-	FlagForArmenia                             Emoji = "\U0001f1e6\U0001f1f2" // flag: Armenia
-	FlagForAngola                              Emoji = "\U0001f1e6\U0001f1f4" // flag: Angola
-	FlagForAntarctica                          Emoji = "\U0001f1e6\U0001f1f6" // flag: Antarctica
-	FlagForArgentina                           Emoji = "\U0001f1e6\U0001f1f7" // flag: Argentina
-	FlagForAmericanSamoa                       Emoji = "\U0001f1e6\U0001f1f8" // flag: American Samoa
-	FlagForAustria                             Emoji = "\U0001f1e6\U0001f1f9" // flag: Austria
-	FlagForAustralia                           Emoji = "\U0001f1e6\U0001f1fa" // flag: Australia
-	FlagForAruba                               Emoji = "\U0001f1e6\U0001f1fc" // flag: Aruba
-	FlagForAlandIslands                        Emoji = "\U0001f1e6\U0001f1fd" // flag: Åland Islands
-	FlagForAzerbaijan                          Emoji = "\U0001f1e6\U0001f1ff" // flag: Azerbaijan
-	FlagForBosniaAndHerzegovina                Emoji = "\U0001f1e7\U0001f1e6" // flag: Bosnia & Herzegovina
-	FlagForBarbados                            Emoji = "\U0001f1e7\U0001f1e7" // flag: Barbados
-	FlagForBangladesh                          Emoji = "\U0001f1e7\U0001f1e9" // flag: Bangladesh
-	FlagForBelgium                             Emoji = "\U0001f1e7\U0001f1ea" // flag: Belgium
-	FlagForBurkinaFaso                         Emoji = "\U0001f1e7\U0001f1eb" // flag: Burkina Faso
-	FlagForBulgaria                            Emoji = "\U0001f1e7\U0001f1ec" // flag: Bulgaria
-	FlagForBahrain                             Emoji = "\U0001f1e7\U0001f1ed" // flag: Bahrain
-	FlagForBurundi                             Emoji = "\U0001f1e7\U0001f1ee" // flag: Burundi
-	FlagForBenin                               Emoji = "\U0001f1e7\U0001f1ef" // flag: Benin
-	FlagForStBarthelemy                        Emoji = "\U0001f1e7\U0001f1f1" // flag: St. Barthélemy
-	FlagForBermuda                             Emoji = "\U0001f1e7\U0001f1f2" // flag: Bermuda
-	FlagForBrunei                              Emoji = "\U0001f1e7\U0001f1f3" // flag: Brunei
-	FlagForBolivia                             Emoji = "\U0001f1e7\U0001f1f4" // flag: Bolivia
-	FlagForCaribbeanNetherlands                Emoji = "\U0001f1e7\U0001f1f6" // flag: Caribbean Netherlands
-	FlagForBrazil                              Emoji = "\U0001f1e7\U0001f1f7" // flag: Brazil
-	FlagForBahamas                             Emoji = "\U0001f1e7\U0001f1f8" // flag: Bahamas
-	FlagForBhutan                              Emoji = "\U0001f1e7\U0001f1f9" // flag: Bhutan
-	FlagForBouvetIsland                        Emoji = "\U0001f1e7\U0001f1fb" // flag: Bouvet Island
-	FlagForBotswana                            Emoji = "\U0001f1e7\U0001f1fc" // flag: Botswana
-	FlagForBelarus                             Emoji = "\U0001f1e7\U0001f1fe" // flag: Belarus
-	FlagForBelize                              Emoji = "\U0001f1e7\U0001f1ff" // flag: Belize
-	FlagForCanada                              Emoji = "\U0001f1e8\U0001f1e6" // flag: Canada
-	FlagForCocosKeelingIslands                 Emoji = "\U0001f1e8\U0001f1e8" // flag: Cocos (Keeling) Islands
-	FlagForCongoKinshasa                       Emoji = "\U0001f1e8\U0001f1e9" // flag: Congo - Kinshasa
-	FlagForCentralAfricanRepublic              Emoji = "\U0001f1e8\U0001f1eb" // flag: Central African Republic
-	FlagForCongoBrazzaville                    Emoji = "\U0001f1e8\U0001f1ec" // flag: Congo - Brazzaville
-	FlagForSwitzerland                         Emoji = "\U0001f1e8\U0001f1ed" // flag: Switzerland
-	FlagForCoteDIvoire                         Emoji = "\U0001f1e8\U0001f1ee" // flag: Côte d’Ivoire
-	FlagForCookIslands                         Emoji = "\U0001f1e8\U0001f1f0" // flag: Cook Islands
-	FlagForChile                               Emoji = "\U0001f1e8\U0001f1f1" // flag: Chile
-	FlagForCameroon                            Emoji = "\U0001f1e8\U0001f1f2" // flag: Cameroon
-	FlagForChina                               Emoji = "\U0001f1e8\U0001f1f3" // flag: China
-	FlagForColombia                            Emoji = "\U0001f1e8\U0001f1f4" // flag: Colombia
-	FlagForClippertonIsland                    Emoji = "\U0001f1e8\U0001f1f5" // flag: Clipperton Island
-	FlagForCostaRica                           Emoji = "\U0001f1e8\U0001f1f7" // flag: Costa Rica
-	FlagForCuba                                Emoji = "\U0001f1e8\U0001f1fa" // flag: Cuba
-	FlagForCapeVerde                           Emoji = "\U0001f1e8\U0001f1fb" // flag: Cape Verde
-	FlagForCuracao                             Emoji = "\U0001f1e8\U0001f1fc" // flag: Curaçao
-	FlagForChristmasIsland                     Emoji = "\U0001f1e8\U0001f1fd" // flag: Christmas Island
-	FlagForCyprus                              Emoji = "\U0001f1e8\U0001f1fe" // flag: Cyprus
-	FlagForCzechia                             Emoji = "\U0001f1e8\U0001f1ff" // flag: Czechia
-	FlagForGermany                             Emoji = "\U0001f1e9\U0001f1ea" // flag: Germany
-	FlagForDiegoGarcia                         Emoji = "\U0001f1e9\U0001f1ec" // flag: Diego Garcia
-	FlagForDjibouti                            Emoji = "\U0001f1e9\U0001f1ef" // flag: Djibouti
-	FlagForDenmark                             Emoji = "\U0001f1e9\U0001f1f0" // flag: Denmark
-	FlagForDominica                            Emoji = "\U0001f1e9\U0001f1f2" // flag: Dominica
-	FlagForDominicanRepublic                   Emoji = "\U0001f1e9\U0001f1f4" // flag: Dominican Republic
-	FlagForAlgeria                             Emoji = "\U0001f1e9\U0001f1ff" // flag: Algeria
-	FlagForCeutaAndMelilla                     Emoji = "\U0001f1ea\U0001f1e6" // flag: Ceuta & Melilla
-	FlagForEcuador                             Emoji = "\U0001f1ea\U0001f1e8" // flag: Ecuador
-	FlagForEstonia                             Emoji = "\U0001f1ea\U0001f1ea" // flag: Estonia
-	FlagForEgypt                               Emoji = "\U0001f1ea\U0001f1ec" // flag: Egypt
-	FlagForWesternSahara                       Emoji = "\U0001f1ea\U0001f1ed" // flag: Western Sahara
-	FlagForEritrea                             Emoji = "\U0001f1ea\U0001f1f7" // flag: Eritrea
-	FlagForSpain                               Emoji = "\U0001f1ea\U0001f1f8" // flag: Spain
-	FlagForEthiopia                            Emoji = "\U0001f1ea\U0001f1f9" // flag: Ethiopia
-	FlagForEuropeanUnion                       Emoji = "\U0001f1ea\U0001f1fa" // flag: European Union
-	FlagForFinland                             Emoji = "\U0001f1eb\U0001f1ee" // flag: Finland
-	FlagForFiji                                Emoji = "\U0001f1eb\U0001f1ef" // flag: Fiji
-	FlagForFalklandIslands                     Emoji = "\U0001f1eb\U0001f1f0" // flag: Falkland Islands
-	FlagForMicronesia                          Emoji = "\U0001f1eb\U0001f1f2" // flag: Micronesia
-	FlagForFaroeIslands                        Emoji = "\U0001f1eb\U0001f1f4" // flag: Faroe Islands
-	FlagForFrance                              Emoji = "\U0001f1eb\U0001f1f7" // flag: France
-	FlagForGabon                               Emoji = "\U0001f1ec\U0001f1e6" // flag: Gabon
-	FlagForUnitedKingdom                       Emoji = "\U0001f1ec\U0001f1e7" // flag: United Kingdom
-	FlagForGrenada                             Emoji = "\U0001f1ec\U0001f1e9" // flag: Grenada
-	FlagForGeorgia                             Emoji = "\U0001f1ec\U0001f1ea" // flag: Georgia
-	FlagForFrenchGuiana                        Emoji = "\U0001f1ec\U0001f1eb" // flag: French Guiana
-	FlagForGuernsey                            Emoji = "\U0001f1ec\U0001f1ec" // flag: Guernsey
-	FlagForGhana                               Emoji = "\U0001f1ec\U0001f1ed" // flag: Ghana
-	FlagForGibraltar                           Emoji = "\U0001f1ec\U0001f1ee" // flag: Gibraltar
-	FlagForGreenland                           Emoji = "\U0001f1ec\U0001f1f1" // flag: Greenland
-	FlagForGambia                              Emoji = "\U0001f1ec\U0001f1f2" // flag: Gambia
-	FlagForGuinea                              Emoji = "\U0001f1ec\U0001f1f3" // flag: Guinea
-	FlagForGuadeloupe                          Emoji = "\U0001f1ec\U0001f1f5" // flag: Guadeloupe
-	FlagForEquatorialGuinea                    Emoji = "\U0001f1ec\U0001f1f6" // flag: Equatorial Guinea
-	FlagForGreece                              Emoji = "\U0001f1ec\U0001f1f7" // flag: Greece
-	FlagForSouthGeorgiaAndSouthSandwichIslands Emoji = "\U0001f1ec\U0001f1f8" // flag: South Georgia & South Sandwich Islands
-	FlagForGuatemala                           Emoji = "\U0001f1ec\U0001f1f9" // flag: Guatemala
-	FlagForGuam                                Emoji = "\U0001f1ec\U0001f1fa" // flag: Guam
-	FlagForGuineaBissau                        Emoji = "\U0001f1ec\U0001f1fc" // flag: Guinea-Bissau
-	FlagForGuyana                              Emoji = "\U0001f1ec\U0001f1fe" // flag: Guyana
-	FlagForHongKongSarChina                    Emoji = "\U0001f1ed\U0001f1f0" // flag: Hong Kong SAR China
-	FlagForHeardAndMcdonaldIslands             Emoji = "\U0001f1ed\U0001f1f2" // flag: Heard & McDonald Islands
-	FlagForHonduras                            Emoji = "\U0001f1ed\U0001f1f3" // flag: Honduras
-	FlagForCroatia                             Emoji = "\U0001f1ed\U0001f1f7" // flag: Croatia
-	FlagForHaiti                               Emoji = "\U0001f1ed\U0001f1f9" // flag: Haiti
-	FlagForHungary                             Emoji = "\U0001f1ed\U0001f1fa" // flag: Hungary
-	FlagForCanaryIslands                       Emoji = "\U0001f1ee\U0001f1e8" // flag: Canary Islands
-	FlagForIndonesia                           Emoji = "\U0001f1ee\U0001f1e9" // flag: Indonesia
-	FlagForIreland                             Emoji = "\U0001f1ee\U0001f1ea" // flag: Ireland
-	FlagForIsrael                              Emoji = "\U0001f1ee\U0001f1f1" // flag: Israel
-	FlagForIsleOfMan                           Emoji = "\U0001f1ee\U0001f1f2" // flag: Isle of Man
-	FlagForIndia                               Emoji = "\U0001f1ee\U0001f1f3" // flag: India
-	FlagForBritishIndianOceanTerritory         Emoji = "\U0001f1ee\U0001f1f4" // flag: British Indian Ocean Territory
-	FlagForIraq                                Emoji = "\U0001f1ee\U0001f1f6" // flag: Iraq
-	FlagForIran                                Emoji = "\U0001f1ee\U0001f1f7" // flag: Iran
-	FlagForIceland                             Emoji = "\U0001f1ee\U0001f1f8" // flag: Iceland
-	FlagForItaly                               Emoji = "\U0001f1ee\U0001f1f9" // flag: Italy
-	FlagForJersey                              Emoji = "\U0001f1ef\U0001f1ea" // flag: Jersey
-	FlagForJamaica                             Emoji = "\U0001f1ef\U0001f1f2" // flag: Jamaica
-	FlagForJordan                              Emoji = "\U0001f1ef\U0001f1f4" // flag: Jordan
-	FlagForJapan                               Emoji = "\U0001f1ef\U0001f1f5" // flag: Japan
-	FlagForKenya                               Emoji = "\U0001f1f0\U0001f1ea" // flag: Kenya
-	FlagForKyrgyzstan                          Emoji = "\U0001f1f0\U0001f1ec" // flag: Kyrgyzstan
-	FlagForCambodia                            Emoji = "\U0001f1f0\U0001f1ed" // flag: Cambodia
-	FlagForKiribati                            Emoji = "\U0001f1f0\U0001f1ee" // flag: Kiribati
-	FlagForComoros                             Emoji = "\U0001f1f0\U0001f1f2" // flag: Comoros
-	FlagForStKittsAndNevis                     Emoji = "\U0001f1f0\U0001f1f3" // flag: St. Kitts & Nevis
-	FlagForNorthKorea                          Emoji = "\U0001f1f0\U0001f1f5" // flag: North Korea
-	FlagForSouthKorea                          Emoji = "\U0001f1f0\U0001f1f7" // flag: South Korea
-	FlagForKuwait                              Emoji = "\U0001f1f0\U0001f1fc" // flag: Kuwait
-	FlagForCaymanIslands                       Emoji = "\U0001f1f0\U0001f1fe" // flag: Cayman Islands
-	FlagForKazakhstan                          Emoji = "\U0001f1f0\U0001f1ff" // flag: Kazakhstan
-	FlagForLaos                                Emoji = "\U0001f1f1\U0001f1e6" // flag: Laos
-	FlagForLebanon                             Emoji = "\U0001f1f1\U0001f1e7" // flag: Lebanon
-	FlagForStLucia                             Emoji = "\U0001f1f1\U0001f1e8" // flag: St. Lucia
-	FlagForLiechtenstein                       Emoji = "\U0001f1f1\U0001f1ee" // flag: Liechtenstein
-	FlagForSriLanka                            Emoji = "\U0001f1f1\U0001f1f0" // flag: Sri Lanka
-	FlagForLiberia                             Emoji = "\U0001f1f1\U0001f1f7" // flag: Liberia
-	FlagForLesotho                             Emoji = "\U0001f1f1\U0001f1f8" // flag: Lesotho
-	FlagForLithuania                           Emoji = "\U0001f1f1\U0001f1f9" // flag: Lithuania
-	FlagForLuxembourg                          Emoji = "\U0001f1f1\U0001f1fa" // flag: Luxembourg
-	FlagForLatvia                              Emoji = "\U0001f1f1\U0001f1fb" // flag: Latvia
-	FlagForLibya                               Emoji = "\U0001f1f1\U0001f1fe" // flag: Libya
-	FlagForMorocco                             Emoji = "\U0001f1f2\U0001f1e6" // flag: Morocco
-	FlagForMonaco                              Emoji = "\U0001f1f2\U0001f1e8" // flag: Monaco
-	FlagForMoldova                             Emoji = "\U0001f1f2\U0001f1e9" // flag: Moldova
-	FlagForMontenegro                          Emoji = "\U0001f1f2\U0001f1ea" // flag: Montenegro
-	FlagForStMartin                            Emoji = "\U0001f1f2\U0001f1eb" // flag: St. Martin
-	FlagForMadagascar                          Emoji = "\U0001f1f2\U0001f1ec" // flag: Madagascar
-	FlagForMarshallIslands                     Emoji = "\U0001f1f2\U0001f1ed" // flag: Marshall Islands
-	FlagForNorthMacedonia                      Emoji = "\U0001f1f2\U0001f1f0" // flag: North Macedonia
-	FlagForMali                                Emoji = "\U0001f1f2\U0001f1f1" // flag: Mali
-	FlagForMyanmarBurma                        Emoji = "\U0001f1f2\U0001f1f2" // flag: Myanmar (Burma)
-	FlagForMongolia                            Emoji = "\U0001f1f2\U0001f1f3" // flag: Mongolia
-	FlagForMacaoSarChina                       Emoji = "\U0001f1f2\U0001f1f4" // flag: Macao SAR China
-	FlagForNorthernMarianaIslands              Emoji = "\U0001f1f2\U0001f1f5" // flag: Northern Mariana Islands
-	FlagForMartinique                          Emoji = "\U0001f1f2\U0001f1f6" // flag: Martinique
-	FlagForMauritania                          Emoji = "\U0001f1f2\U0001f1f7" // flag: Mauritania
-	FlagForMontserrat                          Emoji = "\U0001f1f2\U0001f1f8" // flag: Montserrat
-	FlagForMalta                               Emoji = "\U0001f1f2\U0001f1f9" // flag: Malta
-	FlagForMauritius                           Emoji = "\U0001f1f2\U0001f1fa" // flag: Mauritius
-	FlagForMaldives                            Emoji = "\U0001f1f2\U0001f1fb" // flag: Maldives
-	FlagForMalawi                              Emoji = "\U0001f1f2\U0001f1fc" // flag: Malawi
-	FlagForMexico                              Emoji = "\U0001f1f2\U0001f1fd" // flag: Mexico
-	FlagForMalaysia                            Emoji = "\U0001f1f2\U0001f1fe" // flag: Malaysia
-	FlagForMozambique                          Emoji = "\U0001f1f2\U0001f1ff" // flag: Mozambique
-	FlagForNamibia                             Emoji = "\U0001f1f3\U0001f1e6" // flag: Namibia
-	FlagForNewCaledonia                        Emoji = "\U0001f1f3\U0001f1e8" // flag: New Caledonia
-	FlagForNiger                               Emoji = "\U0001f1f3\U0001f1ea" // flag: Niger
-	FlagForNorfolkIsland                       Emoji = "\U0001f1f3\U0001f1eb" // flag: Norfolk Island
-	FlagForNigeria                             Emoji = "\U0001f1f3\U0001f1ec" // flag: Nigeria
-	FlagForNicaragua                           Emoji = "\U0001f1f3\U0001f1ee" // flag: Nicaragua
-	FlagForNetherlands                         Emoji = "\U0001f1f3\U0001f1f1" // flag: Netherlands
-	FlagForNorway                              Emoji = "\U0001f1f3\U0001f1f4" // flag: Norway
-	FlagForNepal                               Emoji = "\U0001f1f3\U0001f1f5" // flag: Nepal
-	FlagForNauru                               Emoji = "\U0001f1f3\U0001f1f7" // flag: Nauru
-	FlagForNiue                                Emoji = "\U0001f1f3\U0001f1fa" // flag: Niue
-	FlagForNewZealand                          Emoji = "\U0001f1f3\U0001f1ff" // flag: New Zealand
-	FlagForOman                                Emoji = "\U0001f1f4\U0001f1f2" // flag: Oman
-	FlagForPanama                              Emoji = "\U0001f1f5\U0001f1e6" // flag: Panama
-	FlagForPeru                                Emoji = "\U0001f1f5\U0001f1ea" // flag: Peru
-	FlagForFrenchPolynesia                     Emoji = "\U0001f1f5\U0001f1eb" // flag: French Polynesia
-	FlagForPapuaNewGuinea                      Emoji = "\U0001f1f5\U0001f1ec" // flag: Papua New Guinea
-	FlagForPhilippines                         Emoji = "\U0001f1f5\U0001f1ed" // flag: Philippines
-	FlagForPakistan                            Emoji = "\U0001f1f5\U0001f1f0" // flag: Pakistan
-	FlagForPoland                              Emoji = "\U0001f1f5\U0001f1f1" // flag: Poland
-	FlagForStPierreAndMiquelon                 Emoji = "\U0001f1f5\U0001f1f2" // flag: St. Pierre & Miquelon
-	FlagForPitcairnIslands                     Emoji = "\U0001f1f5\U0001f1f3" // flag: Pitcairn Islands
-	FlagForPuertoRico                          Emoji = "\U0001f1f5\U0001f1f7" // flag: Puerto Rico
-	FlagForPalestinianTerritories              Emoji = "\U0001f1f5\U0001f1f8" // flag: Palestinian Territories
-	FlagForPortugal                            Emoji = "\U0001f1f5\U0001f1f9" // flag: Portugal
-	FlagForPalau                               Emoji = "\U0001f1f5\U0001f1fc" // flag: Palau
-	FlagForParaguay                            Emoji = "\U0001f1f5\U0001f1fe" // flag: Paraguay
-	FlagForQatar                               Emoji = "\U0001f1f6\U0001f1e6" // flag: Qatar
-	FlagForReunion                             Emoji = "\U0001f1f7\U0001f1ea" // flag: Réunion
-	FlagForRomania                             Emoji = "\U0001f1f7\U0001f1f4" // flag: Romania
-	FlagForSerbia                              Emoji = "\U0001f1f7\U0001f1f8" // flag: Serbia
-	FlagForRussia                              Emoji = "\U0001f1f7\U0001f1fa" // flag: Russia
-	FlagForRwanda                              Emoji = "\U0001f1f7\U0001f1fc" // flag: Rwanda
-	FlagForSaudiArabia                         Emoji = "\U0001f1f8\U0001f1e6" // flag: Saudi Arabia
-	FlagForSolomonIslands                      Emoji = "\U0001f1f8\U0001f1e7" // flag: Solomon Islands
-	FlagForSeychelles                          Emoji = "\U0001f1f8\U0001f1e8" // flag: Seychelles
-	FlagForSudan                               Emoji = "\U0001f1f8\U0001f1e9" // flag: Sudan
-	FlagForSweden                              Emoji = "\U0001f1f8\U0001f1ea" // flag: Sweden
-	FlagForSingapore                           Emoji = "\U0001f1f8\U0001f1ec" // flag: Singapore
-	FlagForStHelena                            Emoji = "\U0001f1f8\U0001f1ed" // flag: St. Helena
-	FlagForSlovenia                            Emoji = "\U0001f1f8\U0001f1ee" // flag: Slovenia
-	FlagForSvalbardAndJanMayen                 Emoji = "\U0001f1f8\U0001f1ef" // flag: Svalbard & Jan Mayen
-	FlagForSlovakia                            Emoji = "\U0001f1f8\U0001f1f0" // flag: Slovakia
-	FlagForSierraLeone                         Emoji = "\U0001f1f8\U0001f1f1" // flag: Sierra Leone
-	FlagForSanMarino                           Emoji = "\U0001f1f8\U0001f1f2" // flag: San Marino
-	FlagForSenegal                             Emoji = "\U0001f1f8\U0001f1f3" // flag: Senegal
-	FlagForSomalia                             Emoji = "\U0001f1f8\U0001f1f4" // flag: Somalia
-	FlagForSuriname                            Emoji = "\U0001f1f8\U0001f1f7" // flag: Suriname
-	FlagForSouthSudan                          Emoji = "\U0001f1f8\U0001f1f8" // flag: South Sudan
-	FlagForSaoTomeAndPrincipe                  Emoji = "\U0001f1f8\U0001f1f9" // flag: São Tomé & Príncipe
-	FlagForElSalvador                          Emoji = "\U0001f1f8\U0001f1fb" // flag: El Salvador
-	FlagForSintMaarten                         Emoji = "\U0001f1f8\U0001f1fd" // flag: Sint Maarten
-	FlagForSyria                               Emoji = "\U0001f1f8\U0001f1fe" // flag: Syria
-	FlagForEswatini                            Emoji = "\U0001f1f8\U0001f1ff" // flag: Eswatini
-	FlagForTristanDaCunha                      Emoji = "\U0001f1f9\U0001f1e6" // flag: Tristan da Cunha
-	FlagForTurksAndCaicosIslands               Emoji = "\U0001f1f9\U0001f1e8" // flag: Turks & Caicos Islands
-	FlagForChad                                Emoji = "\U0001f1f9\U0001f1e9" // flag: Chad
-	FlagForFrenchSouthernTerritories           Emoji = "\U0001f1f9\U0001f1eb" // flag: French Southern Territories
-	FlagForTogo                                Emoji = "\U0001f1f9\U0001f1ec" // flag: Togo
-	FlagForThailand                            Emoji = "\U0001f1f9\U0001f1ed" // flag: Thailand
-	FlagForTajikistan                          Emoji = "\U0001f1f9\U0001f1ef" // flag: Tajikistan
-	FlagForTokelau                             Emoji = "\U0001f1f9\U0001f1f0" // flag: Tokelau
-	FlagForTimorLeste                          Emoji = "\U0001f1f9\U0001f1f1" // flag: Timor-Leste
-	FlagForTurkmenistan                        Emoji = "\U0001f1f9\U0001f1f2" // flag: Turkmenistan
-	FlagForTunisia                             Emoji = "\U0001f1f9\U0001f1f3" // flag: Tunisia
-	FlagForTonga                               Emoji = "\U0001f1f9\U0001f1f4" // flag: Tonga
-	FlagForTurkey                              Emoji = "\U0001f1f9\U0001f1f7" // flag: Turkey
-	FlagForTrinidadAndTobago                   Emoji = "\U0001f1f9\U0001f1f9" // flag: Trinidad & Tobago
-	FlagForTuvalu                              Emoji = "\U0001f1f9\U0001f1fb" // flag: Tuvalu
-	FlagForTaiwan                              Emoji = "\U0001f1f9\U0001f1fc" // flag: Taiwan
-	FlagForTanzania                            Emoji = "\U0001f1f9\U0001f1ff" // flag: Tanzania
-	FlagForUkraine                             Emoji = "\U0001f1fa\U0001f1e6" // flag: Ukraine
-	FlagForUganda                              Emoji = "\U0001f1fa\U0001f1ec" // flag: Uganda
-	FlagForUsOutlyingIslands                   Emoji = "\U0001f1fa\U0001f1f2" // flag: U.S. Outlying Islands
-	FlagForUnitedNations                       Emoji = "\U0001f1fa\U0001f1f3" // flag: United Nations
-	FlagForUnitedStates                        Emoji = "\U0001f1fa\U0001f1f8" // flag: United States
-	FlagForUruguay                             Emoji = "\U0001f1fa\U0001f1fe" // flag: Uruguay
-	FlagForUzbekistan                          Emoji = "\U0001f1fa\U0001f1ff" // flag: Uzbekistan
-	FlagForVaticanCity                         Emoji = "\U0001f1fb\U0001f1e6" // flag: Vatican City
-	FlagForStVincentAndGrenadines              Emoji = "\U0001f1fb\U0001f1e8" // flag: St. Vincent & Grenadines
-	FlagForVenezuela                           Emoji = "\U0001f1fb\U0001f1ea" // flag: Venezuela
-	FlagForBritishVirginIslands                Emoji = "\U0001f1fb\U0001f1ec" // flag: British Virgin Islands
-	FlagForUsVirginIslands                     Emoji = "\U0001f1fb\U0001f1ee" // flag: U.S. Virgin Islands
-	FlagForVietnam                             Emoji = "\U0001f1fb\U0001f1f3" // flag: Vietnam
-	FlagForVanuatu                             Emoji = "\U0001f1fb\U0001f1fa" // flag: Vanuatu
-	FlagForWallisAndFutuna                     Emoji = "\U0001f1fc\U0001f1eb" // flag: Wallis & Futuna
-	FlagForSamoa                               Emoji = "\U0001f1fc\U0001f1f8" // flag: Samoa
-	FlagForKosovo                              Emoji = "\U0001f1fd\U0001f1f0" // flag: Kosovo
-	FlagForYemen                               Emoji = "\U0001f1fe\U0001f1ea" // flag: Yemen
-	FlagForMayotte                             Emoji = "\U0001f1fe\U0001f1f9" // flag: Mayotte
-	FlagForSouthAfrica                         Emoji = "\U0001f1ff\U0001f1e6" // flag: South Africa
-	FlagForZambia                              Emoji = "\U0001f1ff\U0001f1f2" // flag: Zambia
-	FlagForZimbabwe                            Emoji = "\U0001f1ff\U0001f1fc" // flag: Zimbabwe
-	// SUBGROUP: subdivision-flag
-	FlagForEngland  Emoji = "\U0001f3f4\U000e0067\U000e0062\U000e0065\U000e006e\U000e0067\U000e007f" // flag: England
-	FlagForScotland Emoji = "\U0001f3f4\U000e0067\U000e0062\U000e0073\U000e0063\U000e0074\U000e007f" // flag: Scotland
-	FlagForWales    Emoji = "\U0001f3f4\U000e0067\U000e0062\U000e0077\U000e006c\U000e0073\U000e007f" // flag: Wales
+// Groups of the emojis.
+const (
+	GroupSmileysAndEmotion Group = iota // Smileys & Emotion
+	GroupPeopleAndBody                  // People & Body
+	GroupComponent                      // Component
+	GroupAnimalsAndNature               // Animals & Nature
+	GroupFoodAndDrink                   // Food & Drink
+	GroupTravelAndPlaces                // Travel & Places
+	GroupActivities                     // Activities
+	GroupObjects                        // Objects
+	GroupSymbols                        // Symbols
+	GroupFlags                          // Flags
+)
 
+// Subgroups of the emojis.
+const (
+	SubgroupFaceSmiling          Subgroup = iota // face-smiling
+	SubgroupFaceAffection                        // face-affection
+	SubgroupFaceTongue                           // face-tongue
+	SubgroupFaceHand                             // face-hand
+	SubgroupFaceNeutralSkeptical                 // face-neutral-skeptical
+	SubgroupFaceSleepy                           // face-sleepy
+	SubgroupFaceUnwell                           // face-unwell
+	SubgroupFaceHat                              // face-hat
+	SubgroupFaceGlasses                          // face-glasses
+	SubgroupFaceConcerned                        // face-concerned
+	SubgroupFaceNegative                         // face-negative
+	SubgroupFaceCostume                          // face-costume
+	SubgroupCatFace                              // cat-face
+	SubgroupMonkeyFace                           // monkey-face
+	SubgroupEmotion                              // emotion
+	SubgroupHandFingersOpen                      // hand-fingers-open
+	SubgroupHandFingersPartial                   // hand-fingers-partial
+	SubgroupHandSingleFinger                     // hand-single-finger
+	SubgroupHandFingersClosed                    // hand-fingers-closed
+	SubgroupHands                                // hands
+	SubgroupHandProp                             // hand-prop
+	SubgroupBodyParts                            // body-parts
+	SubgroupPerson                               // person
+	SubgroupPersonGesture                        // person-gesture
+	SubgroupPersonRole                           // person-role
+	SubgroupPersonFantasy                        // person-fantasy
+	SubgroupPersonActivity                       // person-activity
+	SubgroupPersonSport                          // person-sport
+	SubgroupPersonResting                        // person-resting
+	SubgroupFamily                               // family
+	SubgroupPersonSymbol                         // person-symbol
+	SubgroupSkinTone                             // skin-tone
+	SubgroupHairStyle                            // hair-style
+	SubgroupAnimalMammal                         // animal-mammal
+	SubgroupAnimalBird                           // animal-bird
+	SubgroupAnimalAmphibian                      // animal-amphibian
+	SubgroupAnimalReptile                        // animal-reptile
+	SubgroupAnimalMarine                         // animal-marine
+	SubgroupAnimalBug                            // animal-bug
+	SubgroupPlantFlower                          // plant-flower
+	SubgroupPlantOther                           // plant-other
+	SubgroupFoodFruit                            // food-fruit
+	SubgroupFoodVegetable                        // food-vegetable
+	SubgroupFoodPrepared                         // food-prepared
+	SubgroupFoodAsian                            // food-asian
+	SubgroupFoodMarine                           // food-marine
+	SubgroupFoodSweet                            // food-sweet
+	SubgroupDrink                                // drink
+	SubgroupDishware                             // dishware
+	SubgroupPlaceMap                             // place-map
+	SubgroupPlaceGeographic                      // place-geographic
+	SubgroupPlaceBuilding                        // place-building
+	SubgroupPlaceReligious                       // place-religious
+	SubgroupPlaceOther                           // place-other
+	SubgroupTransportGround                      // transport-ground
+	SubgroupTransportWater                       // transport-water
+	SubgroupTransportAir                         // transport-air
+	SubgroupHotel                                // hotel
+	SubgroupTime                                 // time
+	SubgroupSkyAndWeather                        // sky & weather
+	SubgroupEvent                                // event
+	SubgroupAwardMedal                           // award-medal
+	SubgroupSport                                // sport
+	SubgroupGame                                 // game
+	SubgroupArtsAndCrafts                        // arts & crafts
+	SubgroupClothing                             // clothing
+	SubgroupSound                                // sound
+	SubgroupMusic                                // music
+	SubgroupMusicalInstrument                    // musical-instrument
+	SubgroupPhone                                // phone
+	SubgroupComputer                             // computer
+	SubgroupLightAndVideo                        // light & video
+	SubgroupBookPaper                            // book-paper
+	SubgroupMoney                                // money
+	SubgroupMail                                 // mail
+	SubgroupWriting                              // writing
+	SubgroupOffice                               // office
+	SubgroupLock                                 // lock
+	SubgroupTool                                 // tool
+	SubgroupScience                              // science
+	SubgroupMedical                              // medical
+	SubgroupHousehold                            // household
+	SubgroupOtherObject                          // other-object
+	SubgroupTransportSign                        // transport-sign
+	SubgroupWarning                              // warning
+	SubgroupArrow                                // arrow
+	SubgroupReligion                             // religion
+	SubgroupZodiac                               // zodiac
+	SubgroupAvSymbol                             // av-symbol
+	SubgroupGender                               // gender
+	SubgroupMath                                 // math
+	SubgroupPunctuation                          // punctuation
+	SubgroupCurrency                             // currency
+	SubgroupOtherSymbol                          // other-symbol
+	SubgroupKeycap                               // keycap
+	SubgroupAlphanum                             // alphanum
+	SubgroupGeometric                            // geometric
+	SubgroupFlag                                 // flag
+	SubgroupCountryFlag                          // country-flag
+	SubgroupSubdivisionFlag                      // subdivision-flag
 )
+
+var groupNames = [...]string{
+	GroupSmileysAndEmotion: "Smileys & Emotion",
+	GroupPeopleAndBody:     "People & Body",
+	GroupComponent:         "Component",
+	GroupAnimalsAndNature:  "Animals & Nature",
+	GroupFoodAndDrink:      "Food & Drink",
+	GroupTravelAndPlaces:   "Travel & Places",
+	GroupActivities:        "Activities",
+	GroupObjects:           "Objects",
+	GroupSymbols:           "Symbols",
+	GroupFlags:             "Flags",
+}
+
+var subgroupNames = [...]string{
+	SubgroupFaceSmiling:          "face-smiling",
+	SubgroupFaceAffection:        "face-affection",
+	SubgroupFaceTongue:           "face-tongue",
+	SubgroupFaceHand:             "face-hand",
+	SubgroupFaceNeutralSkeptical: "face-neutral-skeptical",
+	SubgroupFaceSleepy:           "face-sleepy",
+	SubgroupFaceUnwell:           "face-unwell",
+	SubgroupFaceHat:              "face-hat",
+	SubgroupFaceGlasses:          "face-glasses",
+	SubgroupFaceConcerned:        "face-concerned",
+	SubgroupFaceNegative:         "face-negative",
+	SubgroupFaceCostume:          "face-costume",
+	SubgroupCatFace:              "cat-face",
+	SubgroupMonkeyFace:           "monkey-face",
+	SubgroupEmotion:              "emotion",
+	SubgroupHandFingersOpen:      "hand-fingers-open",
+	SubgroupHandFingersPartial:   "hand-fingers-partial",
+	SubgroupHandSingleFinger:     "hand-single-finger",
+	SubgroupHandFingersClosed:    "hand-fingers-closed",
+	SubgroupHands:                "hands",
+	SubgroupHandProp:             "hand-prop",
+	SubgroupBodyParts:            "body-parts",
+	SubgroupPerson:               "person",
+	SubgroupPersonGesture:        "person-gesture",
+	SubgroupPersonRole:           "person-role",
+	SubgroupPersonFantasy:        "person-fantasy",
+	SubgroupPersonActivity:       "person-activity",
+	SubgroupPersonSport:          "person-sport",
+	SubgroupPersonResting:        "person-resting",
+	SubgroupFamily:               "family",
+	SubgroupPersonSymbol:         "person-symbol",
+	SubgroupSkinTone:             "skin-tone",
+	SubgroupHairStyle:            "hair-style",
+	SubgroupAnimalMammal:         "animal-mammal",
+	SubgroupAnimalBird:           "animal-bird",
+	SubgroupAnimalAmphibian:      "animal-amphibian",
+	SubgroupAnimalReptile:        "animal-reptile",
+	SubgroupAnimalMarine:         "animal-marine",
+	SubgroupAnimalBug:            "animal-bug",
+	SubgroupPlantFlower:          "plant-flower",
+	SubgroupPlantOther:           "plant-other",
+	SubgroupFoodFruit:            "food-fruit",
+	SubgroupFoodVegetable:        "food-vegetable",
+	SubgroupFoodPrepared:         "food-prepared",
+	SubgroupFoodAsian:            "food-asian",
+	SubgroupFoodMarine:           "food-marine",
+	SubgroupFoodSweet:            "food-sweet",
+	SubgroupDrink:                "drink",
+	SubgroupDishware:             "dishware",
+	SubgroupPlaceMap:             "place-map",
+	SubgroupPlaceGeographic:      "place-geographic",
+	SubgroupPlaceBuilding:        "place-building",
+	SubgroupPlaceReligious:       "place-religious",
+	SubgroupPlaceOther:           "place-other",
+	SubgroupTransportGround:      "transport-ground",
+	SubgroupTransportWater:       "transport-water",
+	SubgroupTransportAir:         "transport-air",
+	SubgroupHotel:                "hotel",
+	SubgroupTime:                 "time",
+	SubgroupSkyAndWeather:        "sky & weather",
+	SubgroupEvent:                "event",
+	SubgroupAwardMedal:           "award-medal",
+	SubgroupSport:                "sport",
+	SubgroupGame:                 "game",
+	SubgroupArtsAndCrafts:        "arts & crafts",
+	SubgroupClothing:             "clothing",
+	SubgroupSound:                "sound",
+	SubgroupMusic:                "music",
+	SubgroupMusicalInstrument:    "musical-instrument",
+	SubgroupPhone:                "phone",
+	SubgroupComputer:             "computer",
+	SubgroupLightAndVideo:        "light & video",
+	SubgroupBookPaper:            "book-paper",
+	SubgroupMoney:                "money",
+	SubgroupMail:                 "mail",
+	SubgroupWriting:              "writing",
+	SubgroupOffice:               "office",
+	SubgroupLock:                 "lock",
+	SubgroupTool:                 "tool",
+	SubgroupScience:              "science",
+	SubgroupMedical:              "medical",
+	SubgroupHousehold:            "household",
+	SubgroupOtherObject:          "other-object",
+	SubgroupTransportSign:        "transport-sign",
+	SubgroupWarning:              "warning",
+	SubgroupArrow:                "arrow",
+	SubgroupReligion:             "religion",
+	SubgroupZodiac:               "zodiac",
+	SubgroupAvSymbol:             "av-symbol",
+	SubgroupGender:               "gender",
+	SubgroupMath:                 "math",
+	SubgroupPunctuation:          "punctuation",
+	SubgroupCurrency:             "currency",
+	SubgroupOtherSymbol:          "other-symbol",
+	SubgroupKeycap:               "keycap",
+	SubgroupAlphanum:             "alphanum",
+	SubgroupGeometric:            "geometric",
+	SubgroupFlag:                 "flag",
+	SubgroupCountryFlag:          "country-flag",
+	SubgroupSubdivisionFlag:      "subdivision-flag",
+}
+
+var subgroupGroups = [...]Group{
+	SubgroupFaceSmiling:          GroupSmileysAndEmotion,
+	SubgroupFaceAffection:        GroupSmileysAndEmotion,
+	SubgroupFaceTongue:           GroupSmileysAndEmotion,
+	SubgroupFaceHand:             GroupSmileysAndEmotion,
+	SubgroupFaceNeutralSkeptical: GroupSmileysAndEmotion,
+	SubgroupFaceSleepy:           GroupSmileysAndEmotion,
+	SubgroupFaceUnwell:           GroupSmileysAndEmotion,
+	SubgroupFaceHat:              GroupSmileysAndEmotion,
+	SubgroupFaceGlasses:          GroupSmileysAndEmotion,
+	SubgroupFaceConcerned:        GroupSmileysAndEmotion,
+	SubgroupFaceNegative:         GroupSmileysAndEmotion,
+	SubgroupFaceCostume:          GroupSmileysAndEmotion,
+	SubgroupCatFace:              GroupSmileysAndEmotion,
+	SubgroupMonkeyFace:           GroupSmileysAndEmotion,
+	SubgroupEmotion:              GroupSmileysAndEmotion,
+	SubgroupHandFingersOpen:      GroupPeopleAndBody,
+	SubgroupHandFingersPartial:   GroupPeopleAndBody,
+	SubgroupHandSingleFinger:     GroupPeopleAndBody,
+	SubgroupHandFingersClosed:    GroupPeopleAndBody,
+	SubgroupHands:                GroupPeopleAndBody,
+	SubgroupHandProp:             GroupPeopleAndBody,
+	SubgroupBodyParts:            GroupPeopleAndBody,
+	SubgroupPerson:               GroupPeopleAndBody,
+	SubgroupPersonGesture:        GroupPeopleAndBody,
+	SubgroupPersonRole:           GroupPeopleAndBody,
+	SubgroupPersonFantasy:        GroupPeopleAndBody,
+	SubgroupPersonActivity:       GroupPeopleAndBody,
+	SubgroupPersonSport:          GroupPeopleAndBody,
+	SubgroupPersonResting:        GroupPeopleAndBody,
+	SubgroupFamily:               GroupPeopleAndBody,
+	SubgroupPersonSymbol:         GroupPeopleAndBody,
+	SubgroupSkinTone:             GroupComponent,
+	SubgroupHairStyle:            GroupComponent,
+	SubgroupAnimalMammal:         GroupAnimalsAndNature,
+	SubgroupAnimalBird:           GroupAnimalsAndNature,
+	SubgroupAnimalAmphibian:      GroupAnimalsAndNature,
+	SubgroupAnimalReptile:        GroupAnimalsAndNature,
+	SubgroupAnimalMarine:         GroupAnimalsAndNature,
+	SubgroupAnimalBug:            GroupAnimalsAndNature,
+	SubgroupPlantFlower:          GroupAnimalsAndNature,
+	SubgroupPlantOther:           GroupAnimalsAndNature,
+	SubgroupFoodFruit:            GroupFoodAndDrink,
+	SubgroupFoodVegetable:        GroupFoodAndDrink,
+	SubgroupFoodPrepared:         GroupFoodAndDrink,
+	SubgroupFoodAsian:            GroupFoodAndDrink,
+	SubgroupFoodMarine:           GroupFoodAndDrink,
+	SubgroupFoodSweet:            GroupFoodAndDrink,
+	SubgroupDrink:                GroupFoodAndDrink,
+	SubgroupDishware:             GroupFoodAndDrink,
+	SubgroupPlaceMap:             GroupTravelAndPlaces,
+	SubgroupPlaceGeographic:      GroupTravelAndPlaces,
+	SubgroupPlaceBuilding:        GroupTravelAndPlaces,
+	SubgroupPlaceReligious:       GroupTravelAndPlaces,
+	SubgroupPlaceOther:           GroupTravelAndPlaces,
+	SubgroupTransportGround:      GroupTravelAndPlaces,
+	SubgroupTransportWater:       GroupTravelAndPlaces,
+	SubgroupTransportAir:         GroupTravelAndPlaces,
+	SubgroupHotel:                GroupTravelAndPlaces,
+	SubgroupTime:                 GroupTravelAndPlaces,
+	SubgroupSkyAndWeather:        GroupTravelAndPlaces,
+	SubgroupEvent:                GroupActivities,
+	SubgroupAwardMedal:           GroupActivities,
+	SubgroupSport:                GroupActivities,
+	SubgroupGame:                 GroupActivities,
+	SubgroupArtsAndCrafts:        GroupActivities,
+	SubgroupClothing:             GroupObjects,
+	SubgroupSound:                GroupObjects,
+	SubgroupMusic:                GroupObjects,
+	SubgroupMusicalInstrument:    GroupObjects,
+	SubgroupPhone:                GroupObjects,
+	SubgroupComputer:             GroupObjects,
+	SubgroupLightAndVideo:        GroupObjects,
+	SubgroupBookPaper:            GroupObjects,
+	SubgroupMoney:                GroupObjects,
+	SubgroupMail:                 GroupObjects,
+	SubgroupWriting:              GroupObjects,
+	SubgroupOffice:               GroupObjects,
+	SubgroupLock:                 GroupObjects,
+	SubgroupTool:                 GroupObjects,
+	SubgroupScience:              GroupObjects,
+	SubgroupMedical:              GroupObjects,
+	SubgroupHousehold:            GroupObjects,
+	SubgroupOtherObject:          GroupObjects,
+	SubgroupTransportSign:        GroupSymbols,
+	SubgroupWarning:              GroupSymbols,
+	SubgroupArrow:                GroupSymbols,
+	SubgroupReligion:             GroupSymbols,
+	SubgroupZodiac:               GroupSymbols,
+	SubgroupAvSymbol:             GroupSymbols,
+	SubgroupGender:               GroupSymbols,
+	SubgroupMath:                 GroupSymbols,
+	SubgroupPunctuation:          GroupSymbols,
+	SubgroupCurrency:             GroupSymbols,
+	SubgroupOtherSymbol:          GroupSymbols,
+	SubgroupKeycap:               GroupSymbols,
+	SubgroupAlphanum:             GroupSymbols,
+	SubgroupGeometric:            GroupSymbols,
+	SubgroupFlag:                 GroupFlags,
+	SubgroupCountryFlag:          GroupFlags,
+	SubgroupSubdivisionFlag:      GroupFlags,
+}
+
+var groupEmojis = [...][]Emoji{
+	GroupSmileysAndEmotion: SmileysAndEmotion,
+	GroupPeopleAndBody:     PeopleAndBody,
+	GroupComponent:         Component,
+	GroupAnimalsAndNature:  AnimalsAndNature,
+	GroupFoodAndDrink:      FoodAndDrink,
+	GroupTravelAndPlaces:   TravelAndPlaces,
+	GroupActivities:        Activities,
+	GroupObjects:           Objects,
+	GroupSymbols:           Symbols,
+	GroupFlags:             Flags,
+}
+
+// SmileysAndEmotion are the emojis of the group Smileys & Emotion without skin tones.
+var SmileysAndEmotion = []Emoji{
+	"\U0001f600",                             // grinning face
+	"\U0001f603",                             // grinning face with big eyes
+	"\U0001f604",                             // grinning face with smiling eyes
+	"\U0001f601",                             // beaming face with smiling eyes
+	"\U0001f606",                             // grinning squinting face
+	"\U0001f605",                             // grinning face with sweat
+	"\U0001f923",                             // rolling on the floor laughing
+	"\U0001f602",                             // face with tears of joy
+	"\U0001f642",                             // slightly smiling face
+	"\U0001f643",                             // upside-down face
+	"\U0001fae0",                             // melting face
+	"\U0001f609",                             // winking face
+	"\U0001f60a",                             // smiling face with smiling eyes
+	"\U0001f607",                             // smiling face with halo
+	"\U0001f970",                             // smiling face with hearts
+	"\U0001f60d",                             // smiling face with heart-eyes
+	"\U0001f929",                             // star-struck
+	"\U0001f618",                             // face blowing a kiss
+	"\U0001f617",                             // kissing face
+	"\u263a\ufe0f",                           // smiling face
+	"\U0001f61a",                             // kissing face with closed eyes
+	"\U0001f619",                             // kissing face with smiling eyes
+	"\U0001f972",                             // smiling face with tear
+	"\U0001f60b",                             // face savoring food
+	"\U0001f61b",                             // face with tongue
+	"\U0001f61c",                             // winking face with tongue
+	"\U0001f92a",                             // zany face
+	"\U0001f61d",                             // squinting face with tongue
+	"\U0001f911",                             // money-mouth face
+	"\U0001f917",                             // smiling face with open hands
+	"\U0001f92d",                             // face with hand over mouth
+	"\U0001fae2",                             // face with open eyes and hand over mouth
+	"\U0001fae3",                             // face with peeking eye
+	"\U0001f92b",                             // shushing face
+	"\U0001f914",                             // thinking face
+	"\U0001fae1",                             // saluting face
+	"\U0001f910",                             // zipper-mouth face
+	"\U0001f928",                             // face with raised eyebrow
+	"\U0001f610",                             // neutral face
+	"\U0001f611",                             // expressionless face
+	"\U0001f636",                             // face without mouth
+	"\U0001fae5",                             // dotted line face
+	"\U0001f636\u200d\U0001f32b\ufe0f",       // face in clouds
+	"\U0001f60f",                             // smirking face
+	"\U0001f612",                             // unamused face
+	"\U0001f644",                             // face with rolling eyes
+	"\U0001f62c",                             // grimacing face
+	"\U0001f62e\u200d\U0001f4a8",             // face exhaling
+	"\U0001f925",                             // lying face
+	"\U0001f60c",                             // relieved face
+	"\U0001f614",                             // pensive face
+	"\U0001f62a",                             // sleepy face
+	"\U0001f924",                             // drooling face
+	"\U0001f634",                             // sleeping face
+	"\U0001f637",                             // face with medical mask
+	"\U0001f912",                             // face with thermometer
+	"\U0001f915",                             // face with head-bandage
+	"\U0001f922",                             // nauseated face
+	"\U0001f92e",                             // face vomiting
+	"\U0001f927",                             // sneezing face
+	"\U0001f975",                             // hot face
+	"\U0001f976",                             // cold face
+	"\U0001f974",                             // woozy face
+	"\U0001f635",                             // face with crossed-out eyes
+	"\U0001f635\u200d\U0001f4ab",             // face with spiral eyes
+	"\U0001f92f",                             // exploding head
+	"\U0001f920",                             // cowboy hat face
+	"\U0001f973",                             // partying face
+	"\U0001f978",                             // disguised face
+	"\U0001f60e",                             // smiling face with sunglasses
+	"\U0001f913",                             // nerd face
+	"\U0001f9d0",                             // face with monocle
+	"\U0001f615",                             // confused face
+	"\U0001fae4",                             // face with diagonal mouth
+	"\U0001f61f",                             // worried face
+	"\U0001f641",                             // slightly frowning face
+	"\u2639\ufe0f",                           // frowning face
+	"\U0001f62e",                             // face with open mouth
+	"\U0001f62f",                             // hushed face
+	"\U0001f632",                             // astonished face
+	"\U0001f633",                             // flushed face
+	"\U0001f97a",                             // pleading face
+	"\U0001f979",                             // face holding back tears
+	"\U0001f626",                             // frowning face with open mouth
+	"\U0001f627",                             // anguished face
+	"\U0001f628",                             // fearful face
+	"\U0001f630",                             // anxious face with sweat
+	"\U0001f625",                             // sad but relieved face
+	"\U0001f622",                             // crying face
+	"\U0001f62d",                             // loudly crying face
+	"\U0001f631",                             // face screaming in fear
+	"\U0001f616",                             // confounded face
+	"\U0001f623",                             // persevering face
+	"\U0001f61e",                             // disappointed face
+	"\U0001f613",                             // downcast face with sweat
+	"\U0001f629",                             // weary face
+	"\U0001f62b",                             // tired face
+	"\U0001f971",                             // yawning face
+	"\U0001f624",                             // face with steam from nose
+	"\U0001f621",                             // pouting face
+	"\U0001f620",                             // angry face
+	"\U0001f92c",                             // face with symbols on mouth
+	"\U0001f608",                             // smiling face with horns
+	"\U0001f47f",                             // angry face with horns
+	"\U0001f480",                             // skull
+	"\u2620\ufe0f",                           // skull and crossbones
+	"\U0001f4a9",                             // pile of poo
+	"\U0001f921",                             // clown face
+	"\U0001f479",                             // ogre
+	"\U0001f47a",                             // goblin
+	"\U0001f47b",                             // ghost
+	"\U0001f47d",                             // alien
+	"\U0001f47e",                             // alien monster
+	"\U0001f916",                             // robot
+	"\U0001f63a",                             // grinning cat
+	"\U0001f638",                             // grinning cat with smiling eyes
+	"\U0001f639",                             // cat with tears of joy
+	"\U0001f63b",                             // smiling cat with heart-eyes
+	"\U0001f63c",                             // cat with wry smile
+	"\U0001f63d",                             // kissing cat
+	"\U0001f640",                             // weary cat
+	"\U0001f63f",                             // crying cat
+	"\U0001f63e",                             // pouting cat
+	"\U0001f648",                             // see-no-evil monkey
+	"\U0001f649",                             // hear-no-evil monkey
+	"\U0001f64a",                             // speak-no-evil monkey
+	"\U0001f48b",                             // kiss mark
+	"\U0001f48c",                             // love letter
+	"\U0001f498",                             // heart with arrow
+	"\U0001f49d",                             // heart with ribbon
+	"\U0001f496",                             // sparkling heart
+	"\U0001f497",                             // growing heart
+	"\U0001f493",                             // beating heart
+	"\U0001f49e",                             // revolving hearts
+	"\U0001f495",                             // two hearts
+	"\U0001f49f",                             // heart decoration
+	"\u2763\ufe0f",                           // heart exclamation
+	"\U0001f494",                             // broken heart
+	"\u2764\ufe0f\u200d\U0001f525",           // heart on fire
+	"\u2764\ufe0f\u200d\U0001fa79",           // mending heart
+	"\u2764\ufe0f",                           // red heart
+	"\U0001f9e1",                             // orange heart
+	"\U0001f49b",                             // yellow heart
+	"\U0001f49a",                             // green heart
+	"\U0001f499",                             // blue heart
+	"\U0001f49c",                             // purple heart
+	"\U0001f90e",                             // brown heart
+	"\U0001f5a4",                             // black heart
+	"\U0001f90d",                             // white heart
+	"\U0001f4af",                             // hundred points
+	"\U0001f4a2",                             // anger symbol
+	"\U0001f4a5",                             // collision
+	"\U0001f4ab",                             // dizzy
+	"\U0001f4a6",                             // sweat droplets
+	"\U0001f4a8",                             // dashing away
+	"\U0001f573\ufe0f",                       // hole
+	"\U0001f4a3",                             // bomb
+	"\U0001f4ac",                             // speech balloon
+	"\U0001f441\ufe0f\u200d\U0001f5e8\ufe0f", // eye in speech bubble
+	"\U0001f5e8\ufe0f",                       // left speech bubble
+	"\U0001f5ef\ufe0f",                       // right anger bubble
+	"\U0001f4ad",                             // thought balloon
+	"\U0001f4a4",                             // zzz
+}
+
+// PeopleAndBody are the emojis of the group People & Body without skin tones.
+var PeopleAndBody = []Emoji{
+	"\U0001f44b",                                 // waving hand
+	"\U0001f91a",                                 // raised back of hand
+	"\U0001f590\ufe0f",                           // hand with fingers splayed
+	"\u270b",                                     // raised hand
+	"\U0001f596",                                 // vulcan salute
+	"\U0001faf1",                                 // rightwards hand
+	"\U0001faf2",                                 // leftwards hand
+	"\U0001faf3",                                 // palm down hand
+	"\U0001faf4",                                 // palm up hand
+	"\U0001f44c",                                 // OK hand
+	"\U0001f90c",                                 // pinched fingers
+	"\U0001f90f",                                 // pinching hand
+	"\u270c\ufe0f",                               // victory hand
+	"\U0001f91e",                                 // crossed fingers
+	"\U0001faf0",                                 // hand with index finger and thumb crossed
+	"\U0001f91f",                                 // love-you gesture
+	"\U0001f918",                                 // sign of the horns
+	"\U0001f919",                                 // call me hand
+	"\U0001f448",                                 // backhand index pointing left
+	"\U0001f449",                                 // backhand index pointing right
+	"\U0001f446",                                 // backhand index pointing up
+	"\U0001f595",                                 // middle finger
+	"\U0001f447",                                 // backhand index pointing down
+	"\u261d\ufe0f",                               // index pointing up
+	"\U0001faf5",                                 // index pointing at the viewer
+	"\U0001f44d",                                 // thumbs up
+	"\U0001f44e",                                 // thumbs down
+	"\u270a",                                     // raised fist
+	"\U0001f44a",                                 // oncoming fist
+	"\U0001f91b",                                 // left-facing fist
+	"\U0001f91c",                                 // right-facing fist
+	"\U0001f44f",                                 // clapping hands
+	"\U0001f64c",                                 // raising hands
+	"\U0001faf6",                                 // heart hands
+	"\U0001f450",                                 // open hands
+	"\U0001f932",                                 // palms up together
+	"\U0001f91d",                                 // handshake
+	"\U0001f64f",                                 // folded hands
+	"\u270d\ufe0f",                               // writing hand
+	"\U0001f485",                                 // nail polish
+	"\U0001f933",                                 // selfie
+	"\U0001f4aa",                                 // flexed biceps
+	"\U0001f9be",                                 // mechanical arm
+	"\U0001f9bf",                                 // mechanical leg
+	"\U0001f9b5",                                 // leg
+	"\U0001f9b6",                                 // foot
+	"\U0001f442",                                 // ear
+	"\U0001f9bb",                                 // ear with hearing aid
+	"\U0001f443",                                 // nose
+	"\U0001f9e0",                                 // brain
+	"\U0001fac0",                                 // anatomical heart
+	"\U0001fac1",                                 // lungs
+	"\U0001f9b7",                                 // tooth
+	"\U0001f9b4",                                 // bone
+	"\U0001f440",                                 // eyes
+	"\U0001f441\ufe0f",                           // eye
+	"\U0001f445",                                 // tongue
+	"\U0001f444",                                 // mouth
+	"\U0001fae6",                                 // biting lip
+	"\U0001f476",                                 // baby
+	"\U0001f9d2",                                 // child
+	"\U0001f466",                                 // boy
+	"\U0001f467",                                 // girl
+	"\U0001f9d1",                                 // person
+	"\U0001f471",                                 // person: blond hair
+	"\U0001f468",                                 // man
+	"\U0001f9d4",                                 // person: beard
+	"\U0001f9d4\u200d\u2642\ufe0f",               // man: beard
+	"\U0001f9d4\u200d\u2640\ufe0f",               // woman: beard
+	"\U0001f468\u200d\U0001f9b0",                 // man: red hair
+	"\U0001f468\u200d\U0001f9b1",                 // man: curly hair
+	"\U0001f468\u200d\U0001f9b3",                 // man: white hair
+	"\U0001f468\u200d\U0001f9b2",                 // man: bald
+	"\U0001f469",                                 // woman
+	"\U0001f469\u200d\U0001f9b0",                 // woman: red hair
+	"\U0001f9d1\u200d\U0001f9b0",                 // person: red hair
+	"\U0001f469\u200d\U0001f9b1",                 // woman: curly hair
+	"\U0001f9d1\u200d\U0001f9b1",                 // person: curly hair
+	"\U0001f469\u200d\U0001f9b3",                 // woman: white hair
+	"\U0001f9d1\u200d\U0001f9b3",                 // person: white hair
+	"\U0001f469\u200d\U0001f9b2",                 // woman: bald
+	"\U0001f9d1\u200d\U0001f9b2",                 // person: bald
+	"\U0001f471\u200d\u2640\ufe0f",               // woman: blond hair
+	"\U0001f471\u200d\u2642\ufe0f",               // man: blond hair
+	"\U0001f9d3",                                 // older person
+	"\U0001f474",                                 // old man
+	"\U0001f475",                                 // old woman
+	"\U0001f64d",                                 // person frowning
+	"\U0001f64d\u200d\u2642\ufe0f",               // man frowning
+	"\U0001f64d\u200d\u2640\ufe0f",               // woman frowning
+	"\U0001f64e",                                 // person pouting
+	"\U0001f64e\u200d\u2642\ufe0f",               // man pouting
+	"\U0001f64e\u200d\u2640\ufe0f",               // woman pouting
+	"\U0001f645",                                 // person gesturing NO
+	"\U0001f645\u200d\u2642\ufe0f",               // man gesturing NO
+	"\U0001f645\u200d\u2640\ufe0f",               // woman gesturing NO
+	"\U0001f646",                                 // person gesturing OK
+	"\U0001f646\u200d\u2642\ufe0f",               // man gesturing OK
+	"\U0001f646\u200d\u2640\ufe0f",               // woman gesturing OK
+	"\U0001f481",                                 // person tipping hand
+	"\U0001f481\u200d\u2642\ufe0f",               // man tipping hand
+	"\U0001f481\u200d\u2640\ufe0f",               // woman tipping hand
+	"\U0001f64b",                                 // person raising hand
+	"\U0001f64b\u200d\u2642\ufe0f",               // man raising hand
+	"\U0001f64b\u200d\u2640\ufe0f",               // woman raising hand
+	"\U0001f9cf",                                 // deaf person
+	"\U0001f9cf\u200d\u2642\ufe0f",               // deaf man
+	"\U0001f9cf\u200d\u2640\ufe0f",               // deaf woman
+	"\U0001f647",                                 // person bowing
+	"\U0001f647\u200d\u2642\ufe0f",               // man bowing
+	"\U0001f647\u200d\u2640\ufe0f",               // woman bowing
+	"\U0001f926",                                 // person facepalming
+	"\U0001f926\u200d\u2642\ufe0f",               // man facepalming
+	"\U0001f926\u200d\u2640\ufe0f",               // woman facepalming
+	"\U0001f937",                                 // person shrugging
+	"\U0001f937\u200d\u2642\ufe0f",               // man shrugging
+	"\U0001f937\u200d\u2640\ufe0f",               // woman shrugging
+	"\U0001f9d1\u200d\u2695\ufe0f",               // health worker
+	"\U0001f468\u200d\u2695\ufe0f",               // man health worker
+	"\U0001f469\u200d\u2695\ufe0f",               // woman health worker
+	"\U0001f9d1\u200d\U0001f393",                 // student
+	"\U0001f468\u200d\U0001f393",                 // man student
+	"\U0001f469\u200d\U0001f393",                 // woman student
+	"\U0001f9d1\u200d\U0001f3eb",                 // teacher
+	"\U0001f468\u200d\U0001f3eb",                 // man teacher
+	"\U0001f469\u200d\U0001f3eb",                 // woman teacher
+	"\U0001f9d1\u200d\u2696\ufe0f",               // judge
+	"\U0001f468\u200d\u2696\ufe0f",               // man judge
+	"\U0001f469\u200d\u2696\ufe0f",               // woman judge
+	"\U0001f9d1\u200d\U0001f33e",                 // farmer
+	"\U0001f468\u200d\U0001f33e",                 // man farmer
+	"\U0001f469\u200d\U0001f33e",                 // woman farmer
+	"\U0001f9d1\u200d\U0001f373",                 // cook
+	"\U0001f468\u200d\U0001f373",                 // man cook
+	"\U0001f469\u200d\U0001f373",                 // woman cook
+	"\U0001f9d1\u200d\U0001f527",                 // mechanic
+	"\U0001f468\u200d\U0001f527",                 // man mechanic
+	"\U0001f469\u200d\U0001f527",                 // woman mechanic
+	"\U0001f9d1\u200d\U0001f3ed",                 // factory worker
+	"\U0001f468\u200d\U0001f3ed",                 // man factory worker
+	"\U0001f469\u200d\U0001f3ed",                 // woman factory worker
+	"\U0001f9d1\u200d\U0001f4bc",                 // office worker
+	"\U0001f468\u200d\U0001f4bc",                 // man office worker
+	"\U0001f469\u200d\U0001f4bc",                 // woman office worker
+	"\U0001f9d1\u200d\U0001f52c",                 // scientist
+	"\U0001f468\u200d\U0001f52c",                 // man scientist
+	"\U0001f469\u200d\U0001f52c",                 // woman scientist
+	"\U0001f9d1\u200d\U0001f4bb",                 // technologist
+	"\U0001f468\u200d\U0001f4bb",                 // man technologist
+	"\U0001f469\u200d\U0001f4bb",                 // woman technologist
+	"\U0001f9d1\u200d\U0001f3a4",                 // singer
+	"\U0001f468\u200d\U0001f3a4",                 // man singer
+	"\U0001f469\u200d\U0001f3a4",                 // woman singer
+	"\U0001f9d1\u200d\U0001f3a8",                 // artist
+	"\U0001f468\u200d\U0001f3a8",                 // man artist
+	"\U0001f469\u200d\U0001f3a8",                 // woman artist
+	"\U0001f9d1\u200d\u2708\ufe0f",               // pilot
+	"\U0001f468\u200d\u2708\ufe0f",               // man pilot
+	"\U0001f469\u200d\u2708\ufe0f",               // woman pilot
+	"\U0001f9d1\u200d\U0001f680",                 // astronaut
+	"\U0001f468\u200d\U0001f680",                 // man astronaut
+	"\U0001f469\u200d\U0001f680",                 // woman astronaut
+	"\U0001f9d1\u200d\U0001f692",                 // firefighter
+	"\U0001f468\u200d\U0001f692",                 // man firefighter
+	"\U0001f469\u200d\U0001f692",                 // woman firefighter
+	"\U0001f46e",                                 // police officer
+	"\U0001f46e\u200d\u2642\ufe0f",               // man police officer
+	"\U0001f46e\u200d\u2640\ufe0f",               // woman police officer
+	"\U0001f575\ufe0f",                           // detective
+	"\U0001f575\ufe0f\u200d\u2642\ufe0f",         // man detective
+	"\U0001f575\ufe0f\u200d\u2640\ufe0f",         // woman detective
+	"\U0001f482",                                 // guard
+	"\U0001f482\u200d\u2642\ufe0f",               // man guard
+	"\U0001f482\u200d\u2640\ufe0f",               // woman guard
+	"\U0001f977",                                 // ninja
+	"\U0001f477",                                 // construction worker
+	"\U0001f477\u200d\u2642\ufe0f",               // man construction worker
+	"\U0001f477\u200d\u2640\ufe0f",               // woman construction worker
+	"\U0001fac5",                                 // person with crown
+	"\U0001f934",                                 // prince
+	"\U0001f478",                                 // princess
+	"\U0001f473",                                 // person wearing turban
+	"\U0001f473\u200d\u2642\ufe0f",               // man wearing turban
+	"\U0001f473\u200d\u2640\ufe0f",               // woman wearing turban
+	"\U0001f472",                                 // person with skullcap
+	"\U0001f9d5",                                 // woman with headscarf
+	"\U0001f935",                                 // person in tuxedo
+	"\U0001f935\u200d\u2642\ufe0f",               // man in tuxedo
+	"\U0001f935\u200d\u2640\ufe0f",               // woman in tuxedo
+	"\U0001f470",                                 // person with veil
+	"\U0001f470\u200d\u2642\ufe0f",               // man with veil
+	"\U0001f470\u200d\u2640\ufe0f",               // woman with veil
+	"\U0001f930",                                 // pregnant woman
+	"\U0001fac3",                                 // pregnant man
+	"\U0001fac4",                                 // pregnant person
+	"\U0001f931",                                 // breast-feeding
+	"\U0001f469\u200d\U0001f37c",                 // woman feeding baby
+	"\U0001f468\u200d\U0001f37c",                 // man feeding baby
+	"\U0001f9d1\u200d\U0001f37c",                 // person feeding baby
+	"\U0001f47c",                                 // baby angel
+	"\U0001f385",                                 // Santa Claus
+	"\U0001f936",                                 // Mrs. Claus
+	"\U0001f9d1\u200d\U0001f384",                 // mx claus
+	"\U0001f9b8",                                 // superhero
+	"\U0001f9b8\u200d\u2642\ufe0f",               // man superhero
+	"\U0001f9b8\u200d\u2640\ufe0f",               // woman superhero
+	"\U0001f9b9",                                 // supervillain
+	"\U0001f9b9\u200d\u2642\ufe0f",               // man supervillain
+	"\U0001f9b9\u200d\u2640\ufe0f",               // woman supervillain
+	"\U0001f9d9",                                 // mage
+	"\U0001f9d9\u200d\u2642\ufe0f",               // man mage
+	"\U0001f9d9\u200d\u2640\ufe0f",               // woman mage
+	"\U0001f9da",                                 // fairy
+	"\U0001f9da\u200d\u2642\ufe0f",               // man fairy
+	"\U0001f9da\u200d\u2640\ufe0f",               // woman fairy
+	"\U0001f9db",                                 // vampire
+	"\U0001f9db\u200d\u2642\ufe0f",               // man vampire
+	"\U0001f9db\u200d\u2640\ufe0f",               // woman vampire
+	"\U0001f9dc",                                 // merperson
+	"\U0001f9dc\u200d\u2642\ufe0f",               // merman
+	"\U0001f9dc\u200d\u2640\ufe0f",               // mermaid
+	"\U0001f9dd",                                 // elf
+	"\U0001f9dd\u200d\u2642\ufe0f",               // man elf
+	"\U0001f9dd\u200d\u2640\ufe0f",               // woman elf
+	"\U0001f9de",                                 // genie
+	"\U0001f9de\u200d\u2642\ufe0f",               // man genie
+	"\U0001f9de\u200d\u2640\ufe0f",               // woman genie
+	"\U0001f9df",                                 // zombie
+	"\U0001f9df\u200d\u2642\ufe0f",               // man zombie
+	"\U0001f9df\u200d\u2640\ufe0f",               // woman zombie
+	"\U0001f9cc",                                 // troll
+	"\U0001f486",                                 // person getting massage
+	"\U0001f486\u200d\u2642\ufe0f",               // man getting massage
+	"\U0001f486\u200d\u2640\ufe0f",               // woman getting massage
+	"\U0001f487",                                 // person getting haircut
+	"\U0001f487\u200d\u2642\ufe0f",               // man getting haircut
+	"\U0001f487\u200d\u2640\ufe0f",               // woman getting haircut
+	"\U0001f6b6",                                 // person walking
+	"\U0001f6b6\u200d\u2642\ufe0f",               // man walking
+	"\U0001f6b6\u200d\u2640\ufe0f",               // woman walking
+	"\U0001f9cd",                                 // person standing
+	"\U0001f9cd\u200d\u2642\ufe0f",               // man standing
+	"\U0001f9cd\u200d\u2640\ufe0f",               // woman standing
+	"\U0001f9ce",                                 // person kneeling
+	"\U0001f9ce\u200d\u2642\ufe0f",               // man kneeling
+	"\U0001f9ce\u200d\u2640\ufe0f",               // woman kneeling
+	"\U0001f9d1\u200d\U0001f9af",                 // person with white cane
+	"\U0001f468\u200d\U0001f9af",                 // man with white cane
+	"\U0001f469\u200d\U0001f9af",                 // woman with white cane
+	"\U0001f9d1\u200d\U0001f9bc",                 // person in motorized wheelchair
+	"\U0001f468\u200d\U0001f9bc",                 // man in motorized wheelchair
+	"\U0001f469\u200d\U0001f9bc",                 // woman in motorized wheelchair
+	"\U0001f9d1\u200d\U0001f9bd",                 // person in manual wheelchair
+	"\U0001f468\u200d\U0001f9bd",                 // man in manual wheelchair
+	"\U0001f469\u200d\U0001f9bd",                 // woman in manual wheelchair
+	"\U0001f3c3",                                 // person running
+	"\U0001f3c3\u200d\u2642\ufe0f",               // man running
+	"\U0001f3c3\u200d\u2640\ufe0f",               // woman running
+	"\U0001f483",                                 // woman dancing
+	"\U0001f57a",                                 // man dancing
+	"\U0001f574\ufe0f",                           // person in suit levitating
+	"\U0001f46f",                                 // people with bunny ears
+	"\U0001f46f\u200d\u2642\ufe0f",               // men with bunny ears
+	"\U0001f46f\u200d\u2640\ufe0f",               // women with bunny ears
+	"\U0001f9d6",                                 // person in steamy room
+	"\U0001f9d6\u200d\u2642\ufe0f",               // man in steamy room
+	"\U0001f9d6\u200d\u2640\ufe0f",               // woman in steamy room
+	"\U0001f9d7",                                 // person climbing
+	"\U0001f9d7\u200d\u2642\ufe0f",               // man climbing
+	"\U0001f9d7\u200d\u2640\ufe0f",               // woman climbing
+	"\U0001f93a",                                 // person fencing
+	"\U0001f3c7",                                 // horse racing
+	"\u26f7\ufe0f",                               // skier
+	"\U0001f3c2",                                 // snowboarder
+	"\U0001f3cc\ufe0f",                           // person golfing
+	"\U0001f3cc\ufe0f\u200d\u2642\ufe0f",         // man golfing
+	"\U0001f3cc\ufe0f\u200d\u2640\ufe0f",         // woman golfing
+	"\U0001f3c4",                                 // person surfing
+	"\U0001f3c4\u200d\u2642\ufe0f",               // man surfing
+	"\U0001f3c4\u200d\u2640\ufe0f",               // woman surfing
+	"\U0001f6a3",                                 // person rowing boat
+	"\U0001f6a3\u200d\u2642\ufe0f",               // man rowing boat
+	"\U0001f6a3\u200d\u2640\ufe0f",               // woman rowing boat
+	"\U0001f3ca",                                 // person swimming
+	"\U0001f3ca\u200d\u2642\ufe0f",               // man swimming
+	"\U0001f3ca\u200d\u2640\ufe0f",               // woman swimming
+	"\u26f9\ufe0f",                               // person bouncing ball
+	"\u26f9\ufe0f\u200d\u2642\ufe0f",             // man bouncing ball
+	"\u26f9\ufe0f\u200d\u2640\ufe0f",             // woman bouncing ball
+	"\U0001f3cb\ufe0f",                           // person lifting weights
+	"\U0001f3cb\ufe0f\u200d\u2642\ufe0f",         // man lifting weights
+	"\U0001f3cb\ufe0f\u200d\u2640\ufe0f",         // woman lifting weights
+	"\U0001f6b4",                                 // person biking
+	"\U0001f6b4\u200d\u2642\ufe0f",               // man biking
+	"\U0001f6b4\u200d\u2640\ufe0f",               // woman biking
+	"\U0001f6b5",                                 // person mountain biking
+	"\U0001f6b5\u200d\u2642\ufe0f",               // man mountain biking
+	"\U0001f6b5\u200d\u2640\ufe0f",               // woman mountain biking
+	"\U0001f938",                                 // person cartwheeling
+	"\U0001f938\u200d\u2642\ufe0f",               // man cartwheeling
+	"\U0001f938\u200d\u2640\ufe0f",               // woman cartwheeling
+	"\U0001f93c",                                 // people wrestling
+	"\U0001f93c\u200d\u2642\ufe0f",               // men wrestling
+	"\U0001f93c\u200d\u2640\ufe0f",               // women wrestling
+	"\U0001f93d",                                 // person playing water polo
+	"\U0001f93d\u200d\u2642\ufe0f",               // man playing water polo
+	"\U0001f93d\u200d\u2640\ufe0f",               // woman playing water polo
+	"\U0001f93e",                                 // person playing handball
+	"\U0001f93e\u200d\u2642\ufe0f",               // man playing handball
+	"\U0001f93e\u200d\u2640\ufe0f",               // woman playing handball
+	"\U0001f939",                                 // person juggling
+	"\U0001f939\u200d\u2642\ufe0f",               // man juggling
+	"\U0001f939\u200d\u2640\ufe0f",               // woman juggling
+	"\U0001f9d8",                                 // person in lotus position
+	"\U0001f9d8\u200d\u2642\ufe0f",               // man in lotus position
+	"\U0001f9d8\u200d\u2640\ufe0f",               // woman in lotus position
+	"\U0001f6c0",                                 // person taking bath
+	"\U0001f6cc",                                 // person in bed
+	"\U0001f9d1\u200d\U0001f91d\u200d\U0001f9d1", // people holding hands
+	"\U0001f46d",                                 // women holding hands
+	"\U0001f46b",                                 // woman and man holding hands
+	"\U0001f46c",                                 // men holding hands
+	"\U0001f48f",                                 // kiss
+	"\U0001f9d1\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f9d1", // kiss: person, person, light skin tone, medium-light skin tone
+	"\U0001f469\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468", // kiss: woman, man
+	"\U0001f468\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468", // kiss: man, man
+	"\U0001f469\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f469", // kiss: woman, woman
+	"\U0001f491", // couple with heart
+	"\U0001f9d1\u200d\u2764\ufe0f\u200d\U0001f9d1", // couple with heart: person, person, light skin tone, medium-light skin tone
+	"\U0001f469\u200d\u2764\ufe0f\u200d\U0001f468", // couple with heart: woman, man
+	"\U0001f468\u200d\u2764\ufe0f\u200d\U0001f468", // couple with heart: man, man
+	"\U0001f469\u200d\u2764\ufe0f\u200d\U0001f469", // couple with heart: woman, woman
+	"\U0001f46a", // family
+	"\U0001f468\u200d\U0001f469\u200d\U0001f466",                 // family: man, woman, boy
+	"\U0001f468\u200d\U0001f469\u200d\U0001f467",                 // family: man, woman, girl
+	"\U0001f468\u200d\U0001f469\u200d\U0001f467\u200d\U0001f466", // family: man, woman, girl, boy
+	"\U0001f468\u200d\U0001f469\u200d\U0001f466\u200d\U0001f466", // family: man, woman, boy, boy
+	"\U0001f468\u200d\U0001f469\u200d\U0001f467\u200d\U0001f467", // family: man, woman, girl, girl
+	"\U0001f468\u200d\U0001f468\u200d\U0001f466",                 // family: man, man, boy
+	"\U0001f468\u200d\U0001f468\u200d\U0001f467",                 // family: man, man, girl
+	"\U0001f468\u200d\U0001f468\u200d\U0001f467\u200d\U0001f466", // family: man, man, girl, boy
+	"\U0001f468\u200d\U0001f468\u200d\U0001f466\u200d\U0001f466", // family: man, man, boy, boy
+	"\U0001f468\u200d\U0001f468\u200d\U0001f467\u200d\U0001f467", // family: man, man, girl, girl
+	"\U0001f469\u200d\U0001f469\u200d\U0001f466",                 // family: woman, woman, boy
+	"\U0001f469\u200d\U0001f469\u200d\U0001f467",                 // family: woman, woman, girl
+	"\U0001f469\u200d\U0001f469\u200d\U0001f467\u200d\U0001f466", // family: woman, woman, girl, boy
+	"\U0001f469\u200d\U0001f469\u200d\U0001f466\u200d\U0001f466", // family: woman, woman, boy, boy
+	"\U0001f469\u200d\U0001f469\u200d\U0001f467\u200d\U0001f467", // family: woman, woman, girl, girl
+	"\U0001f468\u200d\U0001f466",                                 // family: man, boy
+	"\U0001f468\u200d\U0001f466\u200d\U0001f466",                 // family: man, boy, boy
+	"\U0001f468\u200d\U0001f467",                                 // family: man, girl
+	"\U0001f468\u200d\U0001f467\u200d\U0001f466",                 // family: man, girl, boy
+	"\U0001f468\u200d\U0001f467\u200d\U0001f467",                 // family: man, girl, girl
+	"\U0001f469\u200d\U0001f466",                                 // family: woman, boy
+	"\U0001f469\u200d\U0001f466\u200d\U0001f466",                 // family: woman, boy, boy
+	"\U0001f469\u200d\U0001f467",                                 // family: woman, girl
+	"\U0001f469\u200d\U0001f467\u200d\U0001f466",                 // family: woman, girl, boy
+	"\U0001f469\u200d\U0001f467\u200d\U0001f467",                 // family: woman, girl, girl
+	"\U0001f5e3\ufe0f", // speaking head
+	"\U0001f464",       // bust in silhouette
+	"\U0001f465",       // busts in silhouette
+	"\U0001fac2",       // people hugging
+	"\U0001f463",       // footprints
+}
+
+// Component are the emojis of the group Component without skin tones.
+var Component = []Emoji{
+	"\U0001f3fb", // light skin tone
+	"\U0001f3fc", // medium-light skin tone
+	"\U0001f3fd", // medium skin tone
+	"\U0001f3fe", // medium-dark skin tone
+	"\U0001f3ff", // dark skin tone
+	"\U0001f9b0", // red hair
+	"\U0001f9b1", // curly hair
+	"\U0001f9b3", // white hair
+	"\U0001f9b2", // bald
+}
+
+// AnimalsAndNature are the emojis of the group Animals & Nature without skin tones.
+var AnimalsAndNature = []Emoji{
+	"\U0001f435",                   // monkey face
+	"\U0001f412",                   // monkey
+	"\U0001f98d",                   // gorilla
+	"\U0001f9a7",                   // orangutan
+	"\U0001f436",                   // dog face
+	"\U0001f415",                   // dog
+	"\U0001f9ae",                   // guide dog
+	"\U0001f415\u200d\U0001f9ba",   // service dog
+	"\U0001f429",                   // poodle
+	"\U0001f43a",                   // wolf
+	"\U0001f98a",                   // fox
+	"\U0001f99d",                   // raccoon
+	"\U0001f431",                   // cat face
+	"\U0001f408",                   // cat
+	"\U0001f408\u200d\u2b1b",       // black cat
+	"\U0001f981",                   // lion
+	"\U0001f42f",                   // tiger face
+	"\U0001f405",                   // tiger
+	"\U0001f406",                   // leopard
+	"\U0001f434",                   // horse face
+	"\U0001f40e",                   // horse
+	"\U0001f984",                   // unicorn
+	"\U0001f993",                   // zebra
+	"\U0001f98c",                   // deer
+	"\U0001f9ac",                   // bison
+	"\U0001f42e",                   // cow face
+	"\U0001f402",                   // ox
+	"\U0001f403",                   // water buffalo
+	"\U0001f404",                   // cow
+	"\U0001f437",                   // pig face
+	"\U0001f416",                   // pig
+	"\U0001f417",                   // boar
+	"\U0001f43d",                   // pig nose
+	"\U0001f40f",                   // ram
+	"\U0001f411",                   // ewe
+	"\U0001f410",                   // goat
+	"\U0001f42a",                   // camel
+	"\U0001f42b",                   // two-hump camel
+	"\U0001f999",                   // llama
+	"\U0001f992",                   // giraffe
+	"\U0001f418",                   // elephant
+	"\U0001f9a3",                   // mammoth
+	"\U0001f98f",                   // rhinoceros
+	"\U0001f99b",                   // hippopotamus
+	"\U0001f42d",                   // mouse face
+	"\U0001f401",                   // mouse
+	"\U0001f400",                   // rat
+	"\U0001f439",                   // hamster
+	"\U0001f430",                   // rabbit face
+	"\U0001f407",                   // rabbit
+	"\U0001f43f\ufe0f",             // chipmunk
+	"\U0001f9ab",                   // beaver
+	"\U0001f994",                   // hedgehog
+	"\U0001f987",                   // bat
+	"\U0001f43b",                   // bear
+	"\U0001f43b\u200d\u2744\ufe0f", // polar bear
+	"\U0001f428",                   // koala
+	"\U0001f43c",                   // panda
+	"\U0001f9a5",                   // sloth
+	"\U0001f9a6",                   // otter
+	"\U0001f9a8",                   // skunk
+	"\U0001f998",                   // kangaroo
+	"\U0001f9a1",                   // badger
+	"\U0001f43e",                   // paw prints
+	"\U0001f983",                   // turkey
+	"\U0001f414",                   // chicken
+	"\U0001f413",                   // rooster
+	"\U0001f423",                   // hatching chick
+	"\U0001f424",                   // baby chick
+	"\U0001f425",                   // front-facing baby chick
+	"\U0001f426",                   // bird
+	"\U0001f427",                   // penguin
+	"\U0001f54a\ufe0f",             // dove
+	"\U0001f985",                   // eagle
+	"\U0001f986",                   // duck
+	"\U0001f9a2",                   // swan
+	"\U0001f989",                   // owl
+	"\U0001f9a4",                   // dodo
+	"\U0001fab6",                   // feather
+	"\U0001f9a9",                   // flamingo
+	"\U0001f99a",                   // peacock
+	"\U0001f99c",                   // parrot
+	"\U0001f438",                   // frog
+	"\U0001f40a",                   // crocodile
+	"\U0001f422",                   // turtle
+	"\U0001f98e",                   // lizard
+	"\U0001f40d",                   // snake
+	"\U0001f432",                   // dragon face
+	"\U0001f409",                   // dragon
+	"\U0001f995",                   // sauropod
+	"\U0001f996",                   // T-Rex
+	"\U0001f433",                   // spouting whale
+	"\U0001f40b",                   // whale
+	"\U0001f42c",                   // dolphin
+	"\U0001f9ad",                   // seal
+	"\U0001f41f",                   // fish
+	"\U0001f420",                   // tropical fish
+	"\U0001f421",                   // blowfish
+	"\U0001f988",                   // shark
+	"\U0001f419",                   // octopus
+	"\U0001f41a",                   // spiral shell
+	"\U0001fab8",                   // coral
+	"\U0001f40c",                   // snail
+	"\U0001f98b",                   // butterfly
+	"\U0001f41b",                   // bug
+	"\U0001f41c",                   // ant
+	"\U0001f41d",                   // honeybee
+	"\U0001fab2",                   // beetle
+	"\U0001f41e",                   // lady beetle
+	"\U0001f997",                   // cricket
+	"\U0001fab3",                   // cockroach
+	"\U0001f577\ufe0f",             // spider
+	"\U0001f578\ufe0f",             // spider web
+	"\U0001f982",                   // scorpion
+	"\U0001f99f",                   // mosquito
+	"\U0001fab0",                   // fly
+	"\U0001fab1",                   // worm
+	"\U0001f9a0",                   // microbe
+	"\U0001f490",                   // bouquet
+	"\U0001f338",                   // cherry blossom
+	"\U0001f4ae",                   // white flower
+	"\U0001fab7",                   // lotus
+	"\U0001f3f5\ufe0f",             // rosette
+	"\U0001f339",                   // rose
+	"\U0001f940",                   // wilted flower
+	"\U0001f33a",                   // hibiscus
+	"\U0001f33b",                   // sunflower
+	"\U0001f33c",                   // blossom
+	"\U0001f337",                   // tulip
+	"\U0001f331",                   // seedling
+	"\U0001fab4",                   // potted plant
+	"\U0001f332",                   // evergreen tree
+	"\U0001f333",                   // deciduous tree
+	"\U0001f334",                   // palm tree
+	"\U0001f335",                   // cactus
+	"\U0001f33e",                   // sheaf of rice
+	"\U0001f33f",                   // herb
+	"\u2618\ufe0f",                 // shamrock
+	"\U0001f340",                   // four leaf clover
+	"\U0001f341",                   // maple leaf
+	"\U0001f342",                   // fallen leaf
+	"\U0001f343",                   // leaf fluttering in wind
+	"\U0001fab9",                   // empty nest
+	"\U0001faba",                   // nest with eggs
+}
+
+// FoodAndDrink are the emojis of the group Food & Drink without skin tones.
+var FoodAndDrink = []Emoji{
+	"\U0001f347",       // grapes
+	"\U0001f348",       // melon
+	"\U0001f349",       // watermelon
+	"\U0001f34a",       // tangerine
+	"\U0001f34b",       // lemon
+	"\U0001f34c",       // banana
+	"\U0001f34d",       // pineapple
+	"\U0001f96d",       // mango
+	"\U0001f34e",       // red apple
+	"\U0001f34f",       // green apple
+	"\U0001f350",       // pear
+	"\U0001f351",       // peach
+	"\U0001f352",       // cherries
+	"\U0001f353",       // strawberry
+	"\U0001fad0",       // blueberries
+	"\U0001f95d",       // kiwi fruit
+	"\U0001f345",       // tomato
+	"\U0001fad2",       // olive
+	"\U0001f965",       // coconut
+	"\U0001f951",       // avocado
+	"\U0001f346",       // eggplant
+	"\U0001f954",       // potato
+	"\U0001f955",       // carrot
+	"\U0001f33d",       // ear of corn
+	"\U0001f336\ufe0f", // hot pepper
+	"\U0001fad1",       // bell pepper
+	"\U0001f952",       // cucumber
+	"\U0001f96c",       // leafy green
+	"\U0001f966",       // broccoli
+	"\U0001f9c4",       // garlic
+	"\U0001f9c5",       // onion
+	"\U0001f344",       // mushroom
+	"\U0001f95c",       // peanuts
+	"\U0001fad8",       // beans
+	"\U0001f330",       // chestnut
+	"\U0001f35e",       // bread
+	"\U0001f950",       // croissant
+	"\U0001f956",       // baguette bread
+	"\U0001fad3",       // flatbread
+	"\U0001f968",       // pretzel
+	"\U0001f96f",       // bagel
+	"\U0001f95e",       // pancakes
+	"\U0001f9c7",       // waffle
+	"\U0001f9c0",       // cheese wedge
+	"\U0001f356",       // meat on bone
+	"\U0001f357",       // poultry leg
+	"\U0001f969",       // cut of meat
+	"\U0001f953",       // bacon
+	"\U0001f354",       // hamburger
+	"\U0001f35f",       // french fries
+	"\U0001f355",       // pizza
+	"\U0001f32d",       // hot dog
+	"\U0001f96a",       // sandwich
+	"\U0001f32e",       // taco
+	"\U0001f32f",       // burrito
+	"\U0001fad4",       // tamale
+	"\U0001f959",       // stuffed flatbread
+	"\U0001f9c6",       // falafel
+	"\U0001f95a",       // egg
+	"\U0001f373",       // cooking
+	"\U0001f958",       // shallow pan of food
+	"\U0001f372",       // pot of food
+	"\U0001fad5",       // fondue
+	"\U0001f963",       // bowl with spoon
+	"\U0001f957",       // green salad
+	"\U0001f37f",       // popcorn
+	"\U0001f9c8",       // butter
+	"\U0001f9c2",       // salt
+	"\U0001f96b",       // canned food
+	"\U0001f371",       // bento box
+	"\U0001f358",       // rice cracker
+	"\U0001f359",       // rice ball
+	"\U0001f35a",       // cooked rice
+	"\U0001f35b",       // curry rice
+	"\U0001f35c",       // steaming bowl
+	"\U0001f35d",       // spaghetti
+	"\U0001f360",       // roasted sweet potato
+	"\U0001f362",       // oden
+	"\U0001f363",       // sushi
+	"\U0001f364",       // fried shrimp
+	"\U0001f365",       // fish cake with swirl
+	"\U0001f96e",       // moon cake
+	"\U0001f361",       // dango
+	"\U0001f95f",       // dumpling
+	"\U0001f960",       // fortune cookie
+	"\U0001f961",       // takeout box
+	"\U0001f980",       // crab
+	"\U0001f99e",       // lobster
+	"\U0001f990",       // shrimp
+	"\U0001f991",       // squid
+	"\U0001f9aa",       // oyster
+	"\U0001f366",       // soft ice cream
+	"\U0001f367",       // shaved ice
+	"\U0001f368",       // ice cream
+	"\U0001f369",       // doughnut
+	"\U0001f36a",       // cookie
+	"\U0001f382",       // birthday cake
+	"\U0001f370",       // shortcake
+	"\U0001f9c1",       // cupcake
+	"\U0001f967",       // pie
+	"\U0001f36b",       // chocolate bar
+	"\U0001f36c",       // candy
+	"\U0001f36d",       // lollipop
+	"\U0001f36e",       // custard
+	"\U0001f36f",       // honey pot
+	"\U0001f37c",       // baby bottle
+	"\U0001f95b",       // glass of milk
+	"\u2615",           // hot beverage
+	"\U0001fad6",       // teapot
+	"\U0001f375",       // teacup without handle
+	"\U0001f376",       // sake
+	"\U0001f37e",       // bottle with popping cork
+	"\U0001f377",       // wine glass
+	"\U0001f378",       // cocktail glass
+	"\U0001f379",       // tropical drink
+	"\U0001f37a",       // beer mug
+	"\U0001f37b",       // clinking beer mugs
+	"\U0001f942",       // clinking glasses
+	"\U0001f943",       // tumbler glass
+	"\U0001fad7",       // pouring liquid
+	"\U0001f964",       // cup with straw
+	"\U0001f9cb",       // bubble tea
+	"\U0001f9c3",       // beverage box
+	"\U0001f9c9",       // mate
+	"\U0001f9ca",       // ice
+	"\U0001f962",       // chopsticks
+	"\U0001f37d\ufe0f", // fork and knife with plate
+	"\U0001f374",       // fork and knife
+	"\U0001f944",       // spoon
+	"\U0001f52a",       // kitchen knife
+	"\U0001fad9",       // jar
+	"\U0001f3fa",       // amphora
+}
+
+// TravelAndPlaces are the emojis of the group Travel & Places without skin tones.
+var TravelAndPlaces = []Emoji{
+	"\U0001f30d",       // globe showing Europe-Africa
+	"\U0001f30e",       // globe showing Americas
+	"\U0001f30f",       // globe showing Asia-Australia
+	"\U0001f310",       // globe with meridians
+	"\U0001f5fa\ufe0f", // world map
+	"\U0001f5fe",       // map of Japan
+	"\U0001f9ed",       // compass
+	"\U0001f3d4\ufe0f", // snow-capped mountain
+	"\u26f0\ufe0f",     // mountain
+	"\U0001f30b",       // volcano
+	"\U0001f5fb",       // mount fuji
+	"\U0001f3d5\ufe0f", // camping
+	"\U0001f3d6\ufe0f", // beach with umbrella
+	"\U0001f3dc\ufe0f", // desert
+	"\U0001f3dd\ufe0f", // desert island
+	"\U0001f3de\ufe0f", // national park
+	"\U0001f3df\ufe0f", // stadium
+	"\U0001f3db\ufe0f", // classical building
+	"\U0001f3d7\ufe0f", // building construction
+	"\U0001f9f1",       // brick
+	"\U0001faa8",       // rock
+	"\U0001fab5",       // wood
+	"\U0001f6d6",       // hut
+	"\U0001f3d8\ufe0f", // houses
+	"\U0001f3da\ufe0f", // derelict house
+	"\U0001f3e0",       // house
+	"\U0001f3e1",       // house with garden
+	"\U0001f3e2",       // office building
+	"\U0001f3e3",       // Japanese post office
+	"\U0001f3e4",       // post office
+	"\U0001f3e5",       // hospital
+	"\U0001f3e6",       // bank
+	"\U0001f3e8",       // hotel
+	"\U0001f3e9",       // love hotel
+	"\U0001f3ea",       // convenience store
+	"\U0001f3eb",       // school
+	"\U0001f3ec",       // department store
+	"\U0001f3ed",       // factory
+	"\U0001f3ef",       // Japanese castle
+	"\U0001f3f0",       // castle
+	"\U0001f492",       // wedding
+	"\U0001f5fc",       // Tokyo tower
+	"\U0001f5fd",       // Statue of Liberty
+	"\u26ea",           // church
+	"\U0001f54c",       // mosque
+	"\U0001f6d5",       // hindu temple
+	"\U0001f54d",       // synagogue
+	"\u26e9\ufe0f",     // shinto shrine
+	"\U0001f54b",       // kaaba
+	"\u26f2",           // fountain
+	"\u26fa",           // tent
+	"\U0001f301",       // foggy
+	"\U0001f303",       // night with stars
+	"\U0001f3d9\ufe0f", // cityscape
+	"\U0001f304",       // sunrise over mountains
+	"\U0001f305",       // sunrise
+	"\U0001f306",       // cityscape at dusk
+	"\U0001f307",       // sunset
+	"\U0001f309",       // bridge at night
+	"\u2668\ufe0f",     // hot springs
+	"\U0001f3a0",       // carousel horse
+	"\U0001f6dd",       // playground slide
+	"\U0001f3a1",       // ferris wheel
+	"\U0001f3a2",       // roller coaster
+	"\U0001f488",       // barber pole
+	"\U0001f3aa",       // circus tent
+	"\U0001f682",       // locomotive
+	"\U0001f683",       // railway car
+	"\U0001f684",       // high-speed train
+	"\U0001f685",       // bullet train
+	"\U0001f686",       // train
+	"\U0001f687",       // metro
+	"\U0001f688",       // light rail
+	"\U0001f689",       // station
+	"\U0001f68a",       // tram
+	"\U0001f69d",       // monorail
+	"\U0001f69e",       // mountain railway
+	"\U0001f68b",       // tram car
+	"\U0001f68c",       // bus
+	"\U0001f68d",       // oncoming bus
+	"\U0001f68e",       // trolleybus
+	"\U0001f690",       // minibus
+	"\U0001f691",       // ambulance
+	"\U0001f692",       // fire engine
+	"\U0001f693",       // police car
+	"\U0001f694",       // oncoming police car
+	"\U0001f695",       // taxi
+	"\U0001f696",       // oncoming taxi
+	"\U0001f697",       // automobile
+	"\U0001f698",       // oncoming automobile
+	"\U0001f699",       // sport utility vehicle
+	"\U0001f6fb",       // pickup truck
+	"\U0001f69a",       // delivery truck
+	"\U0001f69b",       // articulated lorry
+	"\U0001f69c",       // tractor
+	"\U0001f3ce\ufe0f", // racing car
+	"\U0001f3cd\ufe0f", // motorcycle
+	"\U0001f6f5",       // motor scooter
+	"\U0001f9bd",       // manual wheelchair
+	"\U0001f9bc",       // motorized wheelchair
+	"\U0001f6fa",       // auto rickshaw
+	"\U0001f6b2",       // bicycle
+	"\U0001f6f4",       // kick scooter
+	"\U0001f6f9",       // skateboard
+	"\U0001f6fc",       // roller skate
+	"\U0001f68f",       // bus stop
+	"\U0001f6e3\ufe0f", // motorway
+	"\U0001f6e4\ufe0f", // railway track
+	"\U0001f6e2\ufe0f", // oil drum
+	"\u26fd",           // fuel pump
+	"\U0001f6de",       // wheel
+	"\U0001f6a8",       // police car light
+	"\U0001f6a5",       // horizontal traffic light
+	"\U0001f6a6",       // vertical traffic light
+	"\U0001f6d1",       // stop sign
+	"\U0001f6a7",       // construction
+	"\u2693",           // anchor
+	"\U0001f6df",       // ring buoy
+	"\u26f5",           // sailboat
+	"\U0001f6f6",       // canoe
+	"\U0001f6a4",       // speedboat
+	"\U0001f6f3\ufe0f", // passenger ship
+	"\u26f4\ufe0f",     // ferry
+	"\U0001f6e5\ufe0f", // motor boat
+	"\U0001f6a2",       // ship
+	"\u2708\ufe0f",     // airplane
+	"\U0001f6e9\ufe0f", // small airplane
+	"\U0001f6eb",       // airplane departure
+	"\U0001f6ec",       // airplane arrival
+	"\U0001fa82",       // parachute
+	"\U0001f4ba",       // seat
+	"\U0001f681",       // helicopter
+	"\U0001f69f",       // suspension railway
+	"\U0001f6a0",       // mountain cableway
+	"\U0001f6a1",       // aerial tramway
+	"\U0001f6f0\ufe0f", // satellite
+	"\U0001f680",       // rocket
+	"\U0001f6f8",       // flying saucer
+	"\U0001f6ce\ufe0f", // bellhop bell
+	"\U0001f9f3",       // luggage
+	"\u231b",           // hourglass done
+	"\u23f3",           // hourglass not done
+	"\u231a",           // watch
+	"\u23f0",           // alarm clock
+	"\u23f1\ufe0f",     // stopwatch
+	"\u23f2\ufe0f",     // timer clock
+	"\U0001f570\ufe0f", // mantelpiece clock
+	"\U0001f55b",       // twelve o’clock
+	"\U0001f567",       // twelve-thirty
+	"\U0001f550",       // one o’clock
+	"\U0001f55c",       // one-thirty
+	"\U0001f551",       // two o’clock
+	"\U0001f55d",       // two-thirty
+	"\U0001f552",       // three o’clock
+	"\U0001f55e",       // three-thirty
+	"\U0001f553",       // four o’clock
+	"\U0001f55f",       // four-thirty
+	"\U0001f554",       // five o’clock
+	"\U0001f560",       // five-thirty
+	"\U0001f555",       // six o’clock
+	"\U0001f561",       // six-thirty
+	"\U0001f556",       // seven o’clock
+	"\U0001f562",       // seven-thirty
+	"\U0001f557",       // eight o’clock
+	"\U0001f563",       // eight-thirty
+	"\U0001f558",       // nine o’clock
+	"\U0001f564",       // nine-thirty
+	"\U0001f559",       // ten o’clock
+	"\U0001f565",       // ten-thirty
+	"\U0001f55a",       // eleven o’clock
+	"\U0001f566",       // eleven-thirty
+	"\U0001f311",       // new moon
+	"\U0001f312",       // waxing crescent moon
+	"\U0001f313",       // first quarter moon
+	"\U0001f314",       // waxing gibbous moon
+	"\U0001f315",       // full moon
+	"\U0001f316",       // waning gibbous moon
+	"\U0001f317",       // last quarter moon
+	"\U0001f318",       // waning crescent moon
+	"\U0001f319",       // crescent moon
+	"\U0001f31a",       // new moon face
+	"\U0001f31b",       // first quarter moon face
+	"\U0001f31c",       // last quarter moon face
+	"\U0001f321\ufe0f", // thermometer
+	"\u2600\ufe0f",     // sun
+	"\U0001f31d",       // full moon face
+	"\U0001f31e",       // sun with face
+	"\U0001fa90",       // ringed planet
+	"\u2b50",           // star
+	"\U0001f31f",       // glowing star
+	"\U0001f320",       // shooting star
+	"\U0001f30c",       // milky way
+	"\u2601\ufe0f",     // cloud
+	"\u26c5",           // sun behind cloud
+	"\u26c8\ufe0f",     // cloud with lightning and rain
+	"\U0001f324\ufe0f", // sun behind small cloud
+	"\U0001f325\ufe0f", // sun behind large cloud
+	"\U0001f326\ufe0f", // sun behind rain cloud
+	"\U0001f327\ufe0f", // cloud with rain
+	"\U0001f328\ufe0f", // cloud with snow
+	"\U0001f329\ufe0f", // cloud with lightning
+	"\U0001f32a\ufe0f", // tornado
+	"\U0001f32b\ufe0f", // fog
+	"\U0001f32c\ufe0f", // wind face
+	"\U0001f300",       // cyclone
+	"\U0001f308",       // rainbow
+	"\U0001f302",       // closed umbrella
+	"\u2602\ufe0f",     // umbrella
+	"\u2614",           // umbrella with rain drops
+	"\u26f1\ufe0f",     // umbrella on ground
+	"\u26a1",           // high voltage
+	"\u2744\ufe0f",     // snowflake
+	"\u2603\ufe0f",     // snowman
+	"\u26c4",           // snowman without snow
+	"\u2604\ufe0f",     // comet
+	"\U0001f525",       // fire
+	"\U0001f4a7",       // droplet
+	"\U0001f30a",       // water wave
+}
+
+// Activities are the emojis of the group Activities without skin tones.
+var Activities = []Emoji{
+	"\U0001f383",       // jack-o-lantern
+	"\U0001f384",       // Christmas tree
+	"\U0001f386",       // fireworks
+	"\U0001f387",       // sparkler
+	"\U0001f9e8",       // firecracker
+	"\u2728",           // sparkles
+	"\U0001f388",       // balloon
+	"\U0001f389",       // party popper
+	"\U0001f38a",       // confetti ball
+	"\U0001f38b",       // tanabata tree
+	"\U0001f38d",       // pine decoration
+	"\U0001f38e",       // Japanese dolls
+	"\U0001f38f",       // carp streamer
+	"\U0001f390",       // wind chime
+	"\U0001f391",       // moon viewing ceremony
+	"\U0001f9e7",       // red envelope
+	"\U0001f380",       // ribbon
+	"\U0001f381",       // wrapped gift
+	"\U0001f397\ufe0f", // reminder ribbon
+	"\U0001f39f\ufe0f", // admission tickets
+	"\U0001f3ab",       // ticket
+	"\U0001f396\ufe0f", // military medal
+	"\U0001f3c6",       // trophy
+	"\U0001f3c5",       // sports medal
+	"\U0001f947",       // 1st place medal
+	"\U0001f948",       // 2nd place medal
+	"\U0001f949",       // 3rd place medal
+	"\u26bd",           // soccer ball
+	"\u26be",           // baseball
+	"\U0001f94e",       // softball
+	"\U0001f3c0",       // basketball
+	"\U0001f3d0",       // volleyball
+	"\U0001f3c8",       // american football
+	"\U0001f3c9",       // rugby football
+	"\U0001f3be",       // tennis
+	"\U0001f94f",       // flying disc
+	"\U0001f3b3",       // bowling
+	"\U0001f3cf",       // cricket game
+	"\U0001f3d1",       // field hockey
+	"\U0001f3d2",       // ice hockey
+	"\U0001f94d",       // lacrosse
+	"\U0001f3d3",       // ping pong
+	"\U0001f3f8",       // badminton
+	"\U0001f94a",       // boxing glove
+	"\U0001f94b",       // martial arts uniform
+	"\U0001f945",       // goal net
+	"\u26f3",           // flag in hole
+	"\u26f8\ufe0f",     // ice skate
+	"\U0001f3a3",       // fishing pole
+	"\U0001f93f",       // diving mask
+	"\U0001f3bd",       // running shirt
+	"\U0001f3bf",       // skis
+	"\U0001f6f7",       // sled
+	"\U0001f94c",       // curling stone
+	"\U0001f3af",       // bullseye
+	"\U0001fa80",       // yo-yo
+	"\U0001fa81",       // kite
+	"\U0001f3b1",       // pool 8 ball
+	"\U0001f52e",       // crystal ball
+	"\U0001fa84",       // magic wand
+	"\U0001f9ff",       // nazar amulet
+	"\U0001faac",       // hamsa
+	"\U0001f3ae",       // video game
+	"\U0001f579\ufe0f", // joystick
+	"\U0001f3b0",       // slot machine
+	"\U0001f3b2",       // game die
+	"\U0001f9e9",       // puzzle piece
+	"\U0001f9f8",       // teddy bear
+	"\U0001fa85",       // piñata
+	"\U0001faa9",       // mirror ball
+	"\U0001fa86",       // nesting dolls
+	"\u2660\ufe0f",     // spade suit
+	"\u2665\ufe0f",     // heart suit
+	"\u2666\ufe0f",     // diamond suit
+	"\u2663\ufe0f",     // club suit
+	"\u265f\ufe0f",     // chess pawn
+	"\U0001f0cf",       // joker
+	"\U0001f004",       // mahjong red dragon
+	"\U0001f3b4",       // flower playing cards
+	"\U0001f3ad",       // performing arts
+	"\U0001f5bc\ufe0f", // framed picture
+	"\U0001f3a8",       // artist palette
+	"\U0001f9f5",       // thread
+	"\U0001faa1",       // sewing needle
+	"\U0001f9f6",       // yarn
+	"\U0001faa2",       // knot
+}
+
+// Objects are the emojis of the group Objects without skin tones.
+var Objects = []Emoji{
+	"\U0001f453",       // glasses
+	"\U0001f576\ufe0f", // sunglasses
+	"\U0001f97d",       // goggles
+	"\U0001f97c",       // lab coat
+	"\U0001f9ba",       // safety vest
+	"\U0001f454",       // necktie
+	"\U0001f455",       // t-shirt
+	"\U0001f456",       // jeans
+	"\U0001f9e3",       // scarf
+	"\U0001f9e4",       // gloves
+	"\U0001f9e5",       // coat
+	"\U0001f9e6",       // socks
+	"\U0001f457",       // dress
+	"\U0001f458",       // kimono
+	"\U0001f97b",       // sari
+	"\U0001fa71",       // one-piece swimsuit
+	"\U0001fa72",       // briefs
+	"\U0001fa73",       // shorts
+	"\U0001f459",       // bikini
+	"\U0001f45a",       // woman’s clothes
+	"\U0001f45b",       // purse
+	"\U0001f45c",       // handbag
+	"\U0001f45d",       // clutch bag
+	"\U0001f6cd\ufe0f", // shopping bags
+	"\U0001f392",       // backpack
+	"\U0001fa74",       // thong sandal
+	"\U0001f45e",       // man’s shoe
+	"\U0001f45f",       // running shoe
+	"\U0001f97e",       // hiking boot
+	"\U0001f97f",       // flat shoe
+	"\U0001f460",       // high-heeled shoe
+	"\U0001f461",       // woman’s sandal
+	"\U0001fa70",       // ballet shoes
+	"\U0001f462",       // woman’s boot
+	"\U0001f451",       // crown
+	"\U0001f452",       // woman’s hat
+	"\U0001f3a9",       // top hat
+	"\U0001f393",       // graduation cap
+	"\U0001f9e2",       // billed cap
+	"\U0001fa96",       // military helmet
+	"\u26d1\ufe0f",     // rescue worker’s helmet
+	"\U0001f4ff",       // prayer beads
+	"\U0001f484",       // lipstick
+	"\U0001f48d",       // ring
+	"\U0001f48e",       // gem stone
+	"\U0001f507",       // muted speaker
+	"\U0001f508",       // speaker low volume
+	"\U0001f509",       // speaker medium volume
+	"\U0001f50a",       // speaker high volume
+	"\U0001f4e2",       // loudspeaker
+	"\U0001f4e3",       // megaphone
+	"\U0001f4ef",       // postal horn
+	"\U0001f514",       // bell
+	"\U0001f515",       // bell with slash
+	"\U0001f3bc",       // musical score
+	"\U0001f3b5",       // musical note
+	"\U0001f3b6",       // musical notes
+	"\U0001f399\ufe0f", // studio microphone
+	"\U0001f39a\ufe0f", // level slider
+	"\U0001f39b\ufe0f", // control knobs
+	"\U0001f3a4",       // microphone
+	"\U0001f3a7",       // headphone
+	"\U0001f4fb",       // radio
+	"\U0001f3b7",       // saxophone
+	"\U0001fa97",       // accordion
+	"\U0001f3b8",       // guitar
+	"\U0001f3b9",       // musical keyboard
+	"\U0001f3ba",       // trumpet
+	"\U0001f3bb",       // violin
+	"\U0001fa95",       // banjo
+	"\U0001f941",       // drum
+	"\U0001fa98",       // long drum
+	"\U0001f4f1",       // mobile phone
+	"\U0001f4f2",       // mobile phone with arrow
+	"\u260e\ufe0f",     // telephone
+	"\U0001f4de",       // telephone receiver
+	"\U0001f4df",       // pager
+	"\U0001f4e0",       // fax machine
+	"\U0001f50b",       // battery
+	"\U0001faab",       // low battery
+	"\U0001f50c",       // electric plug
+	"\U0001f4bb",       // laptop
+	"\U0001f5a5\ufe0f", // desktop computer
+	"\U0001f5a8\ufe0f", // printer
+	"\u2328\ufe0f",     // keyboard
+	"\U0001f5b1\ufe0f", // computer mouse
+	"\U0001f5b2\ufe0f", // trackball
+	"\U0001f4bd",       // computer disk
+	"\U0001f4be",       // floppy disk
+	"\U0001f4bf",       // optical disk
+	"\U0001f4c0",       // dvd
+	"\U0001f9ee",       // abacus
+	"\U0001f3a5",       // movie camera
+	"\U0001f39e\ufe0f", // film frames
+	"\U0001f4fd\ufe0f", // film projector
+	"\U0001f3ac",       // clapper board
+	"\U0001f4fa",       // television
+	"\U0001f4f7",       // camera
+	"\U0001f4f8",       // camera with flash
+	"\U0001f4f9",       // video camera
+	"\U0001f4fc",       // videocassette
+	"\U0001f50d",       // magnifying glass tilted left
+	"\U0001f50e",       // magnifying glass tilted right
+	"\U0001f56f\ufe0f", // candle
+	"\U0001f4a1",       // light bulb
+	"\U0001f526",       // flashlight
+	"\U0001f3ee",       // red paper lantern
+	"\U0001fa94",       // diya lamp
+	"\U0001f4d4",       // notebook with decorative cover
+	"\U0001f4d5",       // closed book
+	"\U0001f4d6",       // open book
+	"\U0001f4d7",       // green book
+	"\U0001f4d8",       // blue book
+	"\U0001f4d9",       // orange book
+	"\U0001f4da",       // books
+	"\U0001f4d3",       // notebook
+	"\U0001f4d2",       // ledger
+	"\U0001f4c3",       // page with curl
+	"\U0001f4dc",       // scroll
+	"\U0001f4c4",       // page facing up
+	"\U0001f4f0",       // newspaper
+	"\U0001f5de\ufe0f", // rolled-up newspaper
+	"\U0001f4d1",       // bookmark tabs
+	"\U0001f516",       // bookmark
+	"\U0001f3f7\ufe0f", // label
+	"\U0001f4b0",       // money bag
+	"\U0001fa99",       // coin
+	"\U0001f4b4",       // yen banknote
+	"\U0001f4b5",       // dollar banknote
+	"\U0001f4b6",       // euro banknote
+	"\U0001f4b7",       // pound banknote
+	"\U0001f4b8",       // money with wings
+	"\U0001f4b3",       // credit card
+	"\U0001f9fe",       // receipt
+	"\U0001f4b9",       // chart increasing with yen
+	"\u2709\ufe0f",     // envelope
+	"\U0001f4e7",       // e-mail
+	"\U0001f4e8",       // incoming envelope
+	"\U0001f4e9",       // envelope with arrow
+	"\U0001f4e4",       // outbox tray
+	"\U0001f4e5",       // inbox tray
+	"\U0001f4e6",       // package
+	"\U0001f4eb",       // closed mailbox with raised flag
+	"\U0001f4ea",       // closed mailbox with lowered flag
+	"\U0001f4ec",       // open mailbox with raised flag
+	"\U0001f4ed",       // open mailbox with lowered flag
+	"\U0001f4ee",       // postbox
+	"\U0001f5f3\ufe0f", // ballot box with ballot
+	"\u270f\ufe0f",     // pencil
+	"\u2712\ufe0f",     // black nib
+	"\U0001f58b\ufe0f", // fountain pen
+	"\U0001f58a\ufe0f", // pen
+	"\U0001f58c\ufe0f", // paintbrush
+	"\U0001f58d\ufe0f", // crayon
+	"\U0001f4dd",       // memo
+	"\U0001f4bc",       // briefcase
+	"\U0001f4c1",       // file folder
+	"\U0001f4c2",       // open file folder
+	"\U0001f5c2\ufe0f", // card index dividers
+	"\U0001f4c5",       // calendar
+	"\U0001f4c6",       // tear-off calendar
+	"\U0001f5d2\ufe0f", // spiral notepad
+	"\U0001f5d3\ufe0f", // spiral calendar
+	"\U0001f4c7",       // card index
+	"\U0001f4c8",       // chart increasing
+	"\U0001f4c9",       // chart decreasing
+	"\U0001f4ca",       // bar chart
+	"\U0001f4cb",       // clipboard
+	"\U0001f4cc",       // pushpin
+	"\U0001f4cd",       // round pushpin
+	"\U0001f4ce",       // paperclip
+	"\U0001f587\ufe0f", // linked paperclips
+	"\U0001f4cf",       // straight ruler
+	"\U0001f4d0",       // triangular ruler
+	"\u2702\ufe0f",     // scissors
+	"\U0001f5c3\ufe0f", // card file box
+	"\U0001f5c4\ufe0f", // file cabinet
+	"\U0001f5d1\ufe0f", // wastebasket
+	"\U0001f512",       // locked
+	"\U0001f513",       // unlocked
+	"\U0001f50f",       // locked with pen
+	"\U0001f510",       // locked with key
+	"\U0001f511",       // key
+	"\U0001f5dd\ufe0f", // old key
+	"\U0001f528",       // hammer
+	"\U0001fa93",       // axe
+	"\u26cf\ufe0f",     // pick
+	"\u2692\ufe0f",     // hammer and pick
+	"\U0001f6e0\ufe0f", // hammer and wrench
+	"\U0001f5e1\ufe0f", // dagger
+	"\u2694\ufe0f",     // crossed swords
+	"\U0001f52b",       // water pistol
+	"\U0001fa83",       // boomerang
+	"\U0001f3f9",       // bow and arrow
+	"\U0001f6e1\ufe0f", // shield
+	"\U0001fa9a",       // carpentry saw
+	"\U0001f527",       // wrench
+	"\U0001fa9b",       // screwdriver
+	"\U0001f529",       // nut and bolt
+	"\u2699\ufe0f",     // gear
+	"\U0001f5dc\ufe0f", // clamp
+	"\u2696\ufe0f",     // balance scale
+	"\U0001f9af",       // white cane
+	"\U0001f517",       // link
+	"\u26d3\ufe0f",     // chains
+	"\U0001fa9d",       // hook
+	"\U0001f9f0",       // toolbox
+	"\U0001f9f2",       // magnet
+	"\U0001fa9c",       // ladder
+	"\u2697\ufe0f",     // alembic
+	"\U0001f9ea",       // test tube
+	"\U0001f9eb",       // petri dish
+	"\U0001f9ec",       // dna
+	"\U0001f52c",       // microscope
+	"\U0001f52d",       // telescope
+	"\U0001f4e1",       // satellite antenna
+	"\U0001f489",       // syringe
+	"\U0001fa78",       // drop of blood
+	"\U0001f48a",       // pill
+	"\U0001fa79",       // adhesive bandage
+	"\U0001fa7c",       // crutch
+	"\U0001fa7a",       // stethoscope
+	"\U0001fa7b",       // x-ray
+	"\U0001f6aa",       // door
+	"\U0001f6d7",       // elevator
+	"\U0001fa9e",       // mirror
+	"\U0001fa9f",       // window
+	"\U0001f6cf\ufe0f", // bed
+	"\U0001f6cb\ufe0f", // couch and lamp
+	"\U0001fa91",       // chair
+	"\U0001f6bd",       // toilet
+	"\U0001faa0",       // plunger
+	"\U0001f6bf",       // shower
+	"\U0001f6c1",       // bathtub
+	"\U0001faa4",       // mouse trap
+	"\U0001fa92",       // razor
+	"\U0001f9f4",       // lotion bottle
+	"\U0001f9f7",       // safety pin
+	"\U0001f9f9",       // broom
+	"\U0001f9fa",       // basket
+	"\U0001f9fb",       // roll of paper
+	"\U0001faa3",       // bucket
+	"\U0001f9fc",       // soap
+	"\U0001fae7",       // bubbles
+	"\U0001faa5",       // toothbrush
+	"\U0001f9fd",       // sponge
+	"\U0001f9ef",       // fire extinguisher
+	"\U0001f6d2",       // shopping cart
+	"\U0001f6ac",       // cigarette
+	"\u26b0\ufe0f",     // coffin
+	"\U0001faa6",       // headstone
+	"\u26b1\ufe0f",     // funeral urn
+	"\U0001f5ff",       // moai
+	"\U0001faa7",       // placard
+	"\U0001faaa",       // identification card
+}
+
+// Symbols are the emojis of the group Symbols without skin tones.
+var Symbols = []Emoji{
+	"\U0001f3e7",       // ATM sign
+	"\U0001f6ae",       // litter in bin sign
+	"\U0001f6b0",       // potable water
+	"\u267f",           // wheelchair symbol
+	"\U0001f6b9",       // men’s room
+	"\U0001f6ba",       // women’s room
+	"\U0001f6bb",       // restroom
+	"\U0001f6bc",       // baby symbol
+	"\U0001f6be",       // water closet
+	"\U0001f6c2",       // passport control
+	"\U0001f6c3",       // customs
+	"\U0001f6c4",       // baggage claim
+	"\U0001f6c5",       // left luggage
+	"\u26a0\ufe0f",     // warning
+	"\U0001f6b8",       // children crossing
+	"\u26d4",           // no entry
+	"\U0001f6ab",       // prohibited
+	"\U0001f6b3",       // no bicycles
+	"\U0001f6ad",       // no smoking
+	"\U0001f6af",       // no littering
+	"\U0001f6b1",       // non-potable water
+	"\U0001f6b7",       // no pedestrians
+	"\U0001f4f5",       // no mobile phones
+	"\U0001f51e",       // no one under eighteen
+	"\u2622\ufe0f",     // radioactive
+	"\u2623\ufe0f",     // biohazard
+	"\u2b06\ufe0f",     // up arrow
+	"\u2197\ufe0f",     // up-right arrow
+	"\u27a1\ufe0f",     // right arrow
+	"\u2198\ufe0f",     // down-right arrow
+	"\u2b07\ufe0f",     // down arrow
+	"\u2199\ufe0f",     // down-left arrow
+	"\u2b05\ufe0f",     // left arrow
+	"\u2196\ufe0f",     // up-left arrow
+	"\u2195\ufe0f",     // up-down arrow
+	"\u2194\ufe0f",     // left-right arrow
+	"\u21a9\ufe0f",     // right arrow curving left
+	"\u21aa\ufe0f",     // left arrow curving right
+	"\u2934\ufe0f",     // right arrow curving up
+	"\u2935\ufe0f",     // right arrow curving down
+	"\U0001f503",       // clockwise vertical arrows
+	"\U0001f504",       // counterclockwise arrows button
+	"\U0001f519",       // BACK arrow
+	"\U0001f51a",       // END arrow
+	"\U0001f51b",       // ON! arrow
+	"\U0001f51c",       // SOON arrow
+	"\U0001f51d",       // TOP arrow
+	"\U0001f6d0",       // place of worship
+	"\u269b\ufe0f",     // atom symbol
+	"\U0001f549\ufe0f", // om
+	"\u2721\ufe0f",     // star of David
+	"\u2638\ufe0f",     // wheel of dharma
+	"\u262f\ufe0f",     // yin yang
+	"\u271d\ufe0f",     // latin cross
+	"\u2626\ufe0f",     // orthodox cross
+	"\u262a\ufe0f",     // star and crescent
+	"\u262e\ufe0f",     // peace symbol
+	"\U0001f54e",       // menorah
+	"\U0001f52f",       // dotted six-pointed star
+	"\u2648",           // Aries
+	"\u2649",           // Taurus
+	"\u264a",           // Gemini
+	"\u264b",           // Cancer
+	"\u264c",           // Leo
+	"\u264d",           // Virgo
+	"\u264e",           // Libra
+	"\u264f",           // Scorpio
+	"\u2650",           // Sagittarius
+	"\u2651",           // Capricorn
+	"\u2652",           // Aquarius
+	"\u2653",           // Pisces
+	"\u26ce",           // Ophiuchus
+	"\U0001f500",       // shuffle tracks button
+	"\U0001f501",       // repeat button
+	"\U0001f502",       // repeat single button
+	"\u25b6\ufe0f",     // play button
+	"\u23e9",           // fast-forward button
+	"\u23ed\ufe0f",     // next track button
+	"\u23ef\ufe0f",     // play or pause button
+	"\u25c0\ufe0f",     // reverse button
+	"\u23ea",           // fast reverse button
+	"\u23ee\ufe0f",     // last track button
+	"\U0001f53c",       // upwards button
+	"\u23eb",           // fast up button
+	"\U0001f53d",       // downwards button
+	"\u23ec",           // fast down button
+	"\u23f8\ufe0f",     // pause button
+	"\u23f9\ufe0f",     // stop button
+	"\u23fa\ufe0f",     // record button
+	"\u23cf\ufe0f",     // eject button
+	"\U0001f3a6",       // cinema
+	"\U0001f505",       // dim button
+	"\U0001f506",       // bright button
+	"\U0001f4f6",       // antenna bars
+	"\U0001f4f3",       // vibration mode
+	"\U0001f4f4",       // mobile phone off
+	"\u2640\ufe0f",     // female sign
+	"\u2642\ufe0f",     // male sign
+	"\u26a7\ufe0f",     // transgender symbol
+	"\u2716\ufe0f",     // multiply
+	"\u2795",           // plus
+	"\u2796",           // minus
+	"\u2797",           // divide
+	"\U0001f7f0",       // heavy equals sign
+	"\u267e\ufe0f",     // infinity
+	"\u203c\ufe0f",     // double exclamation mark
+	"\u2049\ufe0f",     // exclamation question mark
+	"\u2753",           // red question mark
+	"\u2754",           // white question mark
+	"\u2755",           // white exclamation mark
+	"\u2757",           // red exclamation mark
+	"\u3030\ufe0f",     // wavy dash
+	"\U0001f4b1",       // currency exchange
+	"\U0001f4b2",       // heavy dollar sign
+	"\u2695\ufe0f",     // medical symbol
+	"\u267b\ufe0f",     // recycling symbol
+	"\u269c\ufe0f",     // fleur-de-lis
+	"\U0001f531",       // trident emblem
+	"\U0001f4db",       // name badge
+	"\U0001f530",       // Japanese symbol for beginner
+	"\u2b55",           // hollow red circle
+	"\u2705",           // check mark button
+	"\u2611\ufe0f",     // check box with check
+	"\u2714\ufe0f",     // check mark
+	"\u274c",           // cross mark
+	"\u274e",           // cross mark button
+	"\u27b0",           // curly loop
+	"\u27bf",           // double curly loop
+	"\u303d\ufe0f",     // part alternation mark
+	"\u2733\ufe0f",     // eight-spoked asterisk
+	"\u2734\ufe0f",     // eight-pointed star
+	"\u2747\ufe0f",     // sparkle
+	"\u00a9\ufe0f",     // copyright
+	"\u00ae\ufe0f",     // registered
+	"\u2122\ufe0f",     // trade mark
+	"#\ufe0f\u20e3",    // keycap: #
+	"*\ufe0f\u20e3",    // keycap: *
+	"0\ufe0f\u20e3",    // keycap: 0
+	"1\ufe0f\u20e3",    // keycap: 1
+	"2\ufe0f\u20e3",    // keycap: 2
+	"3\ufe0f\u20e3",    // keycap: 3
+	"4\ufe0f\u20e3",    // keycap: 4
+	"5\ufe0f\u20e3",    // keycap: 5
+	"6\ufe0f\u20e3",    // keycap: 6
+	"7\ufe0f\u20e3",    // keycap: 7
+	"8\ufe0f\u20e3",    // keycap: 8
+	"9\ufe0f\u20e3",    // keycap: 9
+	"\U0001f51f",       // keycap: 10
+	"\U0001f520",       // input latin uppercase
+	"\U0001f521",       // input latin lowercase
+	"\U0001f522",       // input numbers
+	"\U0001f523",       // input symbols
+	"\U0001f524",       // input latin letters
+	"\U0001f170\ufe0f", // A button (blood type)
+	"\U0001f18e",       // AB button (blood type)
+	"\U0001f171\ufe0f", // B button (blood type)
+	"\U0001f191",       // CL button
+	"\U0001f192",       // COOL button
+	"\U0001f193",       // FREE button
+	"\u2139\ufe0f",     // information
+	"\U0001f194",       // ID button
+	"\u24c2\ufe0f",     // circled M
+	"\U0001f195",       // NEW button
+	"\U0001f196",       // NG button
+	"\U0001f17e\ufe0f", // O button (blood type)
+	"\U0001f197",       // OK button
+	"\U0001f17f\ufe0f", // P button
+	"\U0001f198",       // SOS button
+	"\U0001f199",       // UP! button
+	"\U0001f19a",       // VS button
+	"\U0001f201",       // Japanese “here” button
+	"\U0001f202\ufe0f", // Japanese “service charge” button
+	"\U0001f237\ufe0f", // Japanese “monthly amount” button
+	"\U0001f236",       // Japanese “not free of charge” button
+	"\U0001f22f",       // Japanese “reserved” button
+	"\U0001f250",       // Japanese “bargain” button
+	"\U0001f239",       // Japanese “discount” button
+	"\U0001f21a",       // Japanese “free of charge” button
+	"\U0001f232",       // Japanese “prohibited” button
+	"\U0001f251",       // Japanese “acceptable” button
+	"\U0001f238",       // Japanese “application” button
+	"\U0001f234",       // Japanese “passing grade” button
+	"\U0001f233",       // Japanese “vacancy” button
+	"\u3297\ufe0f",     // Japanese “congratulations” button
+	"\u3299\ufe0f",     // Japanese “secret” button
+	"\U0001f23a",       // Japanese “open for business” button
+	"\U0001f235",       // Japanese “no vacancy” button
+	"\U0001f534",       // red circle
+	"\U0001f7e0",       // orange circle
+	"\U0001f7e1",       // yellow circle
+	"\U0001f7e2",       // green circle
+	"\U0001f535",       // blue circle
+	"\U0001f7e3",       // purple circle
+	"\U0001f7e4",       // brown circle
+	"\u26ab",           // black circle
+	"\u26aa",           // white circle
+	"\U0001f7e5",       // red square
+	"\U0001f7e7",       // orange square
+	"\U0001f7e8",       // yellow square
+	"\U0001f7e9",       // green square
+	"\U0001f7e6",       // blue square
+	"\U0001f7ea",       // purple square
+	"\U0001f7eb",       // brown square
+	"\u2b1b",           // black large square
+	"\u2b1c",           // white large square
+	"\u25fc\ufe0f",     // black medium square
+	"\u25fb\ufe0f",     // white medium square
+	"\u25fe",           // black medium-small square
+	"\u25fd",           // white medium-small square
+	"\u25aa\ufe0f",     // black small square
+	"\u25ab\ufe0f",     // white small square
+	"\U0001f536",       // large orange diamond
+	"\U0001f537",       // large blue diamond
+	"\U0001f538",       // small orange diamond
+	"\U0001f539",       // small blue diamond
+	"\U0001f53a",       // red triangle pointed up
+	"\U0001f53b",       // red triangle pointed down
+	"\U0001f4a0",       // diamond with a dot
+	"\U0001f518",       // radio button
+	"\U0001f533",       // white square button
+	"\U0001f532",       // black square button
+}
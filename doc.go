@@ -2,3 +2,5 @@
 Package emoji makes working with emojis easier.
 */
 package emoji
+
+//go:generate go run ./internal/generator
@@ -0,0 +1,94 @@
+package emoji
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// fallbackEmojis are older emojis with similar meanings to newer ones.
+var fallbackEmojis = map[string]string{
+	MxClaus.String():                          SantaClaus.String(),
+	FaceHoldingBackTears.String():             PleadingFace.String(),
+	HeartHands.String():                       RedHeart.String(),
+	PeopleHugging.String():                    SmilingFaceWithOpenHands.String(),
+	FaceWithOpenEyesAndHandOverMouth.String(): FaceWithHandOverMouth.String(),
+	FaceWithPeekingEye.String():               SeeNoEvilMonkey.String(),
+	FaceWithDiagonalMouth.String():            ConfusedFace.String(),
+	DottedLineFace.String():                   FaceWithoutMouth.String(),
+	BubbleTea.String():                        CupWithStraw.String(),
+	YawningFace.String():                      SleepingFace.String(),
+	"\U0001faf1\u200d\U0001faf2":              Handshake.String(), // handshake with different skin tones
+}
+
+// Downgrade rewrites the emojis introduced after the maxVersion Emoji version in the s string
+// with older equivalents, e.g. for clients that can't render newer emojis. An emoji is changed with
+// a similar older emoji (🧑‍🎄 to 🎅), its version without skin tones, or its leading zero width
+// joined components (❤️‍🔥 to ❤️) if they are supported. Otherwise it's changed with its alias,
+// or removed if it has no alias. Unknown emojis are kept.
+func Downgrade(s string, maxVersion Version) string {
+	return ReplaceEmojiFunc(s, func(m Match) string {
+		v := Since(m.Code)
+		if v.IsZero() || !v.After(maxVersion) {
+			return m.Code
+		}
+
+		if code, ok := downgradeEmoji(m.Code, maxVersion); ok {
+			return code
+		}
+
+		if m.Alias != "" {
+			return m.Alias
+		}
+		alias, _ := FindReverse(withoutTones(m.Code))
+		return alias
+	})
+}
+
+// downgradeEmoji returns an older equivalent of the emoji that is supported in the max version.
+func downgradeEmoji(code string, max Version) (string, bool) {
+	supported := func(code string) bool {
+		v := Since(code)
+		return !v.IsZero() && !v.After(max)
+	}
+	if supported(code) {
+		return code, true
+	}
+
+	// fallbacks of skin toned emojis are looked up without the tones
+	if fallback, ok := fallbackEmojis[toneRegex.ReplaceAllString(code, "")]; ok {
+		return downgradeEmoji(fallback, max)
+	}
+
+	if plain := withoutTones(code); plain != code && !Since(plain).IsZero() {
+		return downgradeEmoji(plain, max)
+	}
+
+	parts := strings.Split(code, string(zeroWidthJoiner))
+	for n := len(parts) - 1; n > 0; n-- {
+		if prefix := strings.Join(parts[:n], string(zeroWidthJoiner)); !Since(prefix).IsZero() {
+			return downgradeEmoji(prefix, max)
+		}
+	}
+
+	return "", false
+}
+
+// withoutTones removes the skin tones of the emoji. Tones are changed with variation selectors
+// where the emoji needs them, e.g. 🕵🏽‍♂️ is 🕵️‍♂️.
+func withoutTones(code string) string {
+	if plain := toneRegex.ReplaceAllString(code, ""); !Since(plain).IsZero() {
+		return plain
+	}
+
+	var output strings.Builder
+	for i := 0; i < len(code); {
+		r, size := utf8.DecodeRuneInString(code[i:])
+		if isToneRune(r) {
+			r = emojiPresentation
+		}
+		output.WriteRune(r)
+		i += size
+	}
+
+	return output.String()
+}
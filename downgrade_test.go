@@ -0,0 +1,34 @@
+package emoji
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestDowngrade(t *testing.T) {
+	tt := []struct {
+		input    string
+		version  Version
+		expected string
+	}{
+		{input: "merry 🧑‍🎄!", version: Version{12, 0}, expected: "merry 🎅!"},
+		{input: "🧑🏽‍🎄", version: Version{12, 0}, expected: "🎅"},
+		{input: "🧑‍🎄", version: Version{13, 0}, expected: "🧑‍🎄"},
+		{input: "❤️‍🔥", version: Version{13, 0}, expected: "❤️"},
+		{input: "🐻‍❄️", version: Version{12, 0}, expected: "🐻"},
+		{input: "🕵🏽‍♂️", version: Version{3, 0}, expected: "🕵️"},
+		{input: "🤝🏽", version: Version{13, 0}, expected: "🤝"},
+		{input: "🫱🏻‍🫲🏼", version: Version{13, 0}, expected: "🤝"},
+		{input: "🥹", version: Version{13, 0}, expected: "🥺"},
+		{input: "I 🫠", version: Version{13, 0}, expected: "I :melting_face:"},
+		{input: "👍🏽 ok", version: Version{1, 0}, expected: "👍🏽 ok"},
+	}
+
+	for i, tc := range tt {
+		t.Run(fmt.Sprintf("test #%d", i), func(t *testing.T) {
+			if got := Downgrade(tc.input, tc.version); got != tc.expected {
+				t.Fatalf("test case %v fail: got: %q, expected: %q", i+1, got, tc.expected)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"unicode"
 	"unicode/utf8"
 )
 
@@ -124,6 +125,15 @@ func CountryFlag(code string) (Emoji, error) {
 	return Emoji(flag), nil
 }
 
+// Keycap returns a keycap emoji from given digit, `#` or `*`.
+func Keycap(r rune) (Emoji, error) {
+	if !isKeycapBase(r) {
+		return "", fmt.Errorf("not valid keycap: %q", r)
+	}
+
+	return Emoji([]rune{r, emojiPresentation, combiningEnclosingKey}), nil
+}
+
 // countryCodeLetter shifts given letter byte as flagBaseIndex.
 func countryCodeLetter(l byte) string {
 	return string(rune(l) + flagBaseIndex)
@@ -131,127 +141,57 @@ func countryCodeLetter(l byte) string {
 
 // ContainsEmoji checks whether a given string contains any emojis.
 func ContainsEmoji(s string) bool {
-	in := s
-	var cRunes []rune
-
-	for len(in) > 0 {
-		r, size := utf8.DecodeRuneInString(in)
-		cRunes = append(cRunes, r)
-		c := string(cRunes)
-		_, ok1 := reverseEmojiMap[c]
-		_, ok2 := reverseEmojiMap[in]
-		if ok1 || ok2 {
-			// Found alias
-			if NumberMap[c] && !numRegex.MatchString(in) {
-				in = in[size:]
-				continue // false alarm, found regular digit
-			}
-			cRunes = nil
-			return true
-		}
+	start, _ := nextEmoji(s, 0)
 
-		if numRegex.MatchString(in) {
-			return true
-		}
+	return start >= 0
+}
 
-		if s := RunesToHexKey([]rune{r}); len(s) >= 4 {
-			in = in[size:]
+// IsOnlyEmoji checks whether a given string consists of at most max emojis and whitespace.
+// It's useful to display short emoji-only messages at large size. If max is not positive, any number of emojis is allowed.
+func IsOnlyEmoji(s string, max int) bool {
+	count := 0
+	for i := 0; i < len(s); {
+		if n := emojiLen(s[i:]); n > 0 {
+			count++
+			if max > 0 && count > max {
+				return false
+			}
+			i += n
 			continue
 		}
-		// Flush cRunes if any
-		if len(cRunes) > 0 {
-			cRunes = nil
+
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if !unicode.IsSpace(r) {
+			return false
 		}
-		in = in[size:]
+		i += size
 	}
 
-	return false
+	return count > 0
 }
 
 // RemoveEmojis removes all emojis from the s string and returns a new string.
 func RemoveEmojis(in string) string {
-	var cRunes []rune
-	var output strings.Builder
-	// var potentialNumEmoji []rune
+	out := ReplaceEmojiFunc(in, func(Match) string {
+		return ""
+	})
 
-	for len(in) > 0 {
-		in = numRegex.ReplaceAllString(in, "")
-		if numRegex.MatchString(in) {
-			continue
-		}
-		r, size := utf8.DecodeRuneInString(in)
-		cRunes = append(cRunes, r)
-		c := string(cRunes)
-		_, ok1 := reverseEmojiMap[c]
-		_, ok2 := reverseEmojiMap[in]
-		if (ok1 || ok2) && !NumberMap[c] {
-			// Found alias
-			output.WriteString("")
-			cRunes = nil
-		}
-
-		if s := RunesToHexKey([]rune{r}); len(s) >= 4 {
-			in = in[size:]
-			continue
-		}
-		// Flush cRunes if any
-		if len(cRunes) > 0 {
-			output.WriteString(string(cRunes))
-			cRunes = nil
-		}
-		in = in[size:]
-	}
-	return strings.TrimSpace(output.String())
+	return strings.TrimSpace(out)
 }
 
-// FindAll finds all emojis in given string and return as an array of strings. If there are no emojis it returns a nil-slice.
+// FindAll finds all emojis in given string and return as an array of strings. If there are no emojis it returns an empty slice.
 func FindAll(in string) []string {
-	var emojis []string = make([]string, 0)
-	var cRunes []rune
-
-	potentialNums := numRegex.FindAllString(in, -1)
-	placeholder := "-"
-	prevWasJoiner := false
-	in = numRegex.ReplaceAllString(in, placeholder)
-
-	for len(in) > 0 {
-		r, size := utf8.DecodeRuneInString(in)
-		cRunes = append(cRunes, r)
-		c := string(cRunes)
-		_, ok1 := reverseEmojiMap[c]
-		_, ok2 := reverseEmojiMap[in]
-		if (ok1 || ok2) && !NumberMap[c] && !isSkinTone(c) && !prevWasJoiner {
-			// Found alias
-			emojis = append(emojis, c)
-			cRunes = nil
-		}
-		if c == placeholder {
-			numEmoji := potentialNums[0] // get the first match
-			emojis = append(emojis, numEmoji)
-			potentialNums = potentialNums[1:]
-			continue
-		}
-		if isSkinTone(c) {
-			emojis[len(emojis)-1] += c
-			cRunes = nil
-		}
-		if isJoiner(c) || prevWasJoiner {
-			emojis[len(emojis)-1] += c
-			cRunes = nil
-			prevWasJoiner = !prevWasJoiner
-			in = in[size:]
-			continue
-		}
-		if s := RunesToHexKey([]rune{r}); len(s) >= 4 {
-			in = in[size:]
-			continue
-		}
-		// Flush cRunes if any
-		if len(cRunes) > 0 {
-			cRunes = nil
+	emojis := make([]string, 0)
+
+	for i := 0; i < len(in); {
+		start, end := nextEmoji(in, i)
+		if start < 0 {
+			break
 		}
-		in = in[size:]
+		emojis = append(emojis, in[start:end])
+		i = end
 	}
+
 	return emojis
 }
 
@@ -307,7 +247,3 @@ func matchToneToInternal(in string) (Tone, error) {
 		return "", ErrInvalidTone
 	}
 }
-
-func isJoiner(in string) bool {
-	return joinerRegex.MatchString(in)
-}
@@ -14,7 +14,6 @@ func TestEmoji(t *testing.T) {
 		{input: EyeInSpeechBubble, expected: "\U0001F441\uFE0F\u200D\U0001F5E8\uFE0F"},
 		{input: ManGenie, expected: "\U0001F9DE\u200D\u2642\uFE0F"},
 		{input: Badger, expected: "\U0001F9A1"},
-		{input: FlagForTurkey, expected: "\U0001F1F9\U0001F1F7"},
 	}
 
 	for i, tc := range tt {
@@ -72,10 +71,10 @@ func TestCountryFlag(t *testing.T) {
 		input    string
 		expected Emoji
 	}{
-		{input: "tr", expected: FlagForTurkey},
-		{input: "TR", expected: FlagForTurkey},
-		{input: "us", expected: FlagForUnitedStates},
-		{input: "gb", expected: FlagForUnitedKingdom},
+		{input: "tr", expected: "\U0001F1F9\U0001F1F7"},
+		{input: "TR", expected: "\U0001F1F9\U0001F1F7"},
+		{input: "us", expected: "\U0001F1FA\U0001F1F8"},
+		{input: "gb", expected: "\U0001F1EC\U0001F1E7"},
 	}
 
 	for i, tc := range tt {
@@ -107,6 +106,30 @@ func TestCountryFlagError(t *testing.T) {
 	}
 }
 
+func TestKeycap(t *testing.T) {
+	tt := []struct {
+		input    rune
+		expected string
+		fail     bool
+	}{
+		{input: '7', expected: "7\ufe0f\u20e3"},
+		{input: '#', expected: "#\ufe0f\u20e3"},
+		{input: '*', expected: "*\ufe0f\u20e3"},
+		{input: 'a', fail: true},
+		{input: '-', fail: true},
+	}
+
+	for i, tc := range tt {
+		got, err := Keycap(tc.input)
+		if (err != nil) != tc.fail {
+			t.Fatalf("test case %v fail: %v", i+1, err)
+		}
+		if got.String() != tc.expected {
+			t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got, tc.expected)
+		}
+	}
+}
+
 func TestNewEmojiTone(t *testing.T) {
 	tt := []struct {
 		input    []string
@@ -195,6 +218,16 @@ func TestContainsEmoji(t *testing.T) {
 			inputStr: "for you 👩🏾‍❤️‍👨🏿",
 			want:     true,
 		},
+		{
+			name:     "keycap code point names in text",
+			inputStr: "Room 20E3, FE0F - 7",
+			want:     false,
+		},
+		{
+			name:     "keycap without variation selector",
+			inputStr: "press #\u20e3",
+			want:     true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -205,6 +238,89 @@ func TestContainsEmoji(t *testing.T) {
 	}
 }
 
+func TestIsOnlyEmoji(t *testing.T) {
+	tests := []struct {
+		name     string
+		inputStr string
+		max      int
+		want     bool
+	}{
+		{
+			name:     "empty input string",
+			inputStr: "",
+			max:      3,
+			want:     false,
+		},
+		{
+			name:     "whitespace only",
+			inputStr: " \n ",
+			max:      3,
+			want:     false,
+		},
+		{
+			name:     "single emoji",
+			inputStr: "🥰",
+			max:      1,
+			want:     true,
+		},
+		{
+			name:     "emojis with whitespace",
+			inputStr: " 🥰 🎉\n",
+			max:      3,
+			want:     true,
+		},
+		{
+			name:     "too many emojis",
+			inputStr: "🥰🎉🍕🍣",
+			max:      3,
+			want:     false,
+		},
+		{
+			name:     "no limit",
+			inputStr: "🥰🎉🍕🍣",
+			max:      0,
+			want:     true,
+		},
+		{
+			name:     "emoji and text",
+			inputStr: "🥰 hi",
+			max:      3,
+			want:     false,
+		},
+		{
+			name:     "keycaps and flags",
+			inputStr: "1️⃣ #️⃣ 🇹🇷",
+			max:      3,
+			want:     true,
+		},
+		{
+			name:     "digits are not keycaps",
+			inputStr: "1 #",
+			max:      3,
+			want:     false,
+		},
+		{
+			name:     "zwj sequences and tones count once",
+			inputStr: "👩🏽‍❤️‍💋‍👨🏿👍🏿",
+			max:      2,
+			want:     true,
+		},
+		{
+			name:     "lone regional indicator",
+			inputStr: "\U0001F1F9",
+			max:      3,
+			want:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsOnlyEmoji(tt.inputStr, tt.max); got != tt.want {
+				t.Errorf("IsOnlyEmoji() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestRemoveEmojis(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -246,6 +362,11 @@ func TestRemoveEmojis(t *testing.T) {
 			inputStr: "#️⃣string ❤️ 😏🕺🏿hey1️⃣🕓3#👩🏾‍❤️‍👨🏿",
 			want:     "string  hey3#",
 		},
+		{
+			name:     "dashes and keycap code point names are kept",
+			inputStr: "Room 20E3 - 1️⃣-2",
+			want:     "Room 20E3 - -2",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -282,6 +403,11 @@ func TestFindAllEmojis(t *testing.T) {
 			inputStr: "#️⃣string te\U0001FAB7st 👩🏽‍❤️‍💋‍👨🏿",
 			want:     []string{"#️⃣", "\U0001FAB7", "👩🏽‍❤️‍💋‍👨🏿"},
 		},
+		{
+			name:     "dashes are not keycaps",
+			inputStr: "1-2 - 3️⃣ 20E3",
+			want:     []string{"3️⃣"},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
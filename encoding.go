@@ -0,0 +1,225 @@
+package emoji
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// Format defines a textual encoding of emojis.
+type Format int
+
+// Encoding formats
+const (
+	HTMLEntity Format = iota // &#x1F44D;
+	JSEscape                 // \uD83D\uDC4D, for JavaScript and JSON
+	GoEscape                 // \U0001F44D, for Go and Python
+	CSSEscape                // \01F44D
+	CodePoint                // U+1F44D
+)
+
+// Encode encodes all emojis in the s string with the given format.
+// The rest of the string is left as it is.
+func Encode(s string, f Format) string {
+	return ReplaceEmojiFunc(s, func(m Match) string {
+		var output strings.Builder
+		for i, r := range []rune(m.Code) {
+			if f == CodePoint && i > 0 {
+				output.WriteByte(' ')
+			}
+			output.WriteString(encodeRune(r, f))
+		}
+		return output.String()
+	})
+}
+
+func encodeRune(r rune, f Format) string {
+	switch f {
+	case HTMLEntity:
+		return fmt.Sprintf("&#x%X;", r)
+	case JSEscape:
+		if r1, r2 := utf16.EncodeRune(r); r1 != utf8.RuneError {
+			return fmt.Sprintf(`\u%04X\u%04X`, r1, r2)
+		}
+		return fmt.Sprintf(`\u%04X`, r)
+	case GoEscape:
+		if r > 0xFFFF {
+			return fmt.Sprintf(`\U%08X`, r)
+		}
+		return fmt.Sprintf(`\u%04X`, r)
+	case CSSEscape:
+		return fmt.Sprintf(`\%06X`, r)
+	case CodePoint:
+		return fmt.Sprintf("U+%04X", r)
+	default:
+		return string(r)
+	}
+}
+
+// escape is an encoded rune found in a string.
+type escape struct {
+	r    rune
+	text string
+}
+
+// Decode decodes all emojis in the s string that are encoded with the given format.
+// Encoded runes that are not part of an emoji are left as they are.
+func Decode(s string, f Format) string {
+	var output strings.Builder
+	output.Grow(len(s))
+
+	for i := 0; i < len(s); {
+		escapes := decodeEscapes(s[i:], f)
+		if len(escapes) == 0 {
+			_, size := utf8.DecodeRuneInString(s[i:])
+			output.WriteString(s[i : i+size])
+			i += size
+			continue
+		}
+
+		for j := 0; j < len(escapes); {
+			var decoded strings.Builder
+			for _, e := range escapes[j:] {
+				decoded.WriteRune(e.r)
+			}
+
+			code := decoded.String()
+			if n := emojiLen(code); n > 0 {
+				// keep the separator between two emojis
+				if j > 0 && escapes[j].text[0] == ' ' {
+					output.WriteByte(' ')
+				}
+				output.WriteString(code[:n])
+				j += utf8.RuneCountInString(code[:n])
+				continue
+			}
+			output.WriteString(escapes[j].text)
+			j++
+		}
+
+		for _, e := range escapes {
+			i += len(e.text)
+		}
+	}
+
+	return output.String()
+}
+
+// decodeEscapes returns the consecutive encoded runes at the beginning of s.
+func decodeEscapes(s string, f Format) []escape {
+	var escapes []escape
+	n := 0
+	for n < len(s) {
+		sep := 0
+		// code points are separated with spaces
+		if f == CodePoint && len(escapes) > 0 && s[n] == ' ' {
+			sep = 1
+		}
+
+		r, size := decodeEscape(s[n+sep:], f)
+		if size == 0 || !utf8.ValidRune(r) {
+			break
+		}
+		escapes = append(escapes, escape{r: r, text: s[n : n+sep+size]})
+		n += sep + size
+	}
+
+	return escapes
+}
+
+// decodeEscape returns the encoded rune at the beginning of s and its length in bytes.
+// It returns 0 length if s doesn't start with an encoded rune.
+func decodeEscape(s string, f Format) (rune, int) {
+	switch f {
+	case HTMLEntity:
+		if strings.HasPrefix(s, "&#x") || strings.HasPrefix(s, "&#X") {
+			r, n := parseHex(s[3:], 1, 6)
+			if n > 0 && strings.HasPrefix(s[3+n:], ";") {
+				return r, 3 + n + 1
+			}
+		} else if strings.HasPrefix(s, "&#") {
+			end := strings.IndexByte(s, ';')
+			if end > 2 {
+				if v, err := strconv.ParseUint(s[2:end], 10, 21); err == nil {
+					return rune(v), end + 1
+				}
+			}
+		}
+	case JSEscape:
+		r, n := parseEscape(s, `\u`, 4)
+		if n > 0 && utf16.IsSurrogate(r) {
+			r2, n2 := parseEscape(s[n:], `\u`, 4)
+			if r := utf16.DecodeRune(r, r2); n2 > 0 && r != utf8.RuneError {
+				return r, n + n2
+			}
+			return 0, 0
+		}
+		return r, n
+	case GoEscape:
+		if r, n := parseEscape(s, `\U`, 8); n > 0 {
+			return r, n
+		}
+		return parseEscape(s, `\u`, 4)
+	case CSSEscape:
+		if !strings.HasPrefix(s, `\`) {
+			break
+		}
+		r, n := parseHex(s[1:], 1, 6)
+		if n == 0 {
+			break
+		}
+		n++
+		// a whitespace ends escapes shorter than 6 digits
+		if n < 7 && strings.HasPrefix(s[n:], " ") {
+			n++
+		}
+		return r, n
+	case CodePoint:
+		if strings.HasPrefix(s, "U+") {
+			if r, n := parseHex(s[2:], 4, 6); n > 0 {
+				return r, 2 + n
+			}
+		}
+	}
+
+	return 0, 0
+}
+
+// parseEscape parses an escape with the given prefix and exactly digits hex digits.
+func parseEscape(s, prefix string, digits int) (rune, int) {
+	if !strings.HasPrefix(s, prefix) {
+		return 0, 0
+	}
+
+	r, n := parseHex(s[len(prefix):], digits, digits)
+	if n == 0 {
+		return 0, 0
+	}
+
+	return r, len(prefix) + n
+}
+
+// parseHex parses between min and max hex digits at the beginning of s.
+// It returns 0 length if there are not enough digits.
+func parseHex(s string, min, max int) (rune, int) {
+	n := 0
+	for n < len(s) && n < max && isHexDigit(s[n]) {
+		n++
+	}
+	if n < min {
+		return 0, 0
+	}
+
+	v, err := strconv.ParseUint(s[:n], 16, 32)
+	if err != nil {
+		return 0, 0
+	}
+
+	return rune(v), n
+}
+
+func isHexDigit(c byte) bool {
+	return ('0' <= c && c <= '9') || ('a' <= c && c <= 'f') || ('A' <= c && c <= 'F')
+}
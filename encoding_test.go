@@ -0,0 +1,61 @@
+package emoji
+
+import "testing"
+
+func TestEncode(t *testing.T) {
+	tests := []struct {
+		name   string
+		in     string
+		format Format
+		want   string
+	}{
+		{name: "html", in: "ok 👍 & ❤️", format: HTMLEntity, want: "ok &#x1F44D; & &#x2764;&#xFE0F;"},
+		{name: "javascript", in: "ok 👍", format: JSEscape, want: `ok \uD83D\uDC4D`},
+		{name: "go", in: "ok 👍 ❤️", format: GoEscape, want: `ok \U0001F44D \u2764\uFE0F`},
+		{name: "css", in: "ok 👍", format: CSSEscape, want: `ok \01F44D`},
+		{name: "code point", in: "ok 👍🏽!", format: CodePoint, want: "ok U+1F44D U+1F3FD!"},
+		{name: "text is untouched", in: "é © 1 #", format: HTMLEntity, want: "é © 1 #"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Encode(tt.in, tt.format); got != tt.want {
+				t.Errorf("Encode() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		name   string
+		in     string
+		format Format
+		want   string
+	}{
+		{name: "html", in: "ok &#x1F44D; &amp; &#x2764;&#xFE0F;", format: HTMLEntity, want: "ok 👍 &amp; ❤️"},
+		{name: "html decimal", in: "ok &#128077;", format: HTMLEntity, want: "ok 👍"},
+		{name: "html non emoji entity", in: "&#x41;&#x1F44D;&#233;", format: HTMLEntity, want: "&#x41;👍&#233;"},
+		{name: "javascript", in: `ok \uD83D\uDC4D \u0041`, format: JSEscape, want: `ok 👍 \u0041`},
+		{name: "javascript lone surrogate", in: `\uD83D!`, format: JSEscape, want: `\uD83D!`},
+		{name: "go", in: `ok \U0001F44D \u2764\uFE0F`, format: GoEscape, want: "ok 👍 ❤️"},
+		{name: "css", in: `ok \01F44D \1F44D b`, format: CSSEscape, want: "ok 👍 👍b"},
+		{name: "code point", in: "ok U+1F44D U+1F3FD, U+0041", format: CodePoint, want: "ok 👍🏽, U+0041"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Decode(tt.in, tt.format); got != tt.want {
+				t.Errorf("Decode() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEncodeDecode(t *testing.T) {
+	const message = "👩🏽‍❤️‍💋‍👨🏿 and 7️⃣ 🇹🇷 te\U0001FAB7st"
+
+	for _, format := range []Format{HTMLEntity, JSEscape, GoEscape, CSSEscape, CodePoint} {
+		if got := Decode(Encode(message, format), format); got != message {
+			t.Fatalf("format %v fail: got: %v, expected: %v", format, got, message)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+//go:build !emoji_noflags && !emoji_minimal
+// +build !emoji_noflags,!emoji_minimal
+
+package emoji
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestFlagEmoji(t *testing.T) {
+	tt := []struct {
+		input    Emoji
+		expected string
+	}{
+		{input: FlagForTurkey, expected: "\U0001F1F9\U0001F1F7"},
+		{input: FlagForUnitedStates, expected: "\U0001F1FA\U0001F1F8"},
+		{input: FlagForEngland, expected: "\U0001F3F4\U000E0067\U000E0062\U000E0065\U000E006E\U000E0067\U000E007F"},
+	}
+
+	for i, tc := range tt {
+		got := tc.input.String()
+		if got != tc.expected {
+			t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got, tc.expected)
+		}
+	}
+}
+
+func TestReplaceFlag(t *testing.T) {
+	tt := []struct {
+		input    string
+		expected string
+	}{
+		{
+			input:    "I am :man_technologist: from :flag_for_turkey:. Tests are :thumbs_up:",
+			expected: fmt.Sprintf("I am %v from %v. Tests are %v", ManTechnologist, FlagForTurkey, ThumbsUp),
+		},
+		{
+			input:    "flag testing :flag-tr: :flag_for_united_kingdom: done",
+			expected: fmt.Sprintf("flag testing %v %v done", FlagForTurkey, FlagForUnitedKingdom),
+		},
+	}
+
+	for i, tc := range tt {
+		t.Run(fmt.Sprintf("test #%d", i), func(t *testing.T) {
+			if got := Replace(tc.input); got != tc.expected {
+				t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got, tc.expected)
+			}
+			if got := Sprint(tc.input); got != tc.expected {
+				t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestFlagLen(t *testing.T) {
+	in := FlagForEngland.String() + "!"
+	if got := emojiLen(in); got != 28 {
+		t.Errorf("emojiLen() = %v, want %v", got, 28)
+	}
+}
+
+func TestGetStatsFlag(t *testing.T) {
+	want := Stats{
+		Total:     2,
+		Distinct:  2,
+		Groups:    map[string]int{"Flags": 1, "People & Body": 1},
+		Tones:     map[Tone]int{Dark: 1, Medium: 1},
+		Ratio:     1,
+		EmojiOnly: true,
+	}
+	if got := GetStats(" 🇹🇷 👩🏿‍🤝‍👨🏽 "); !reflect.DeepEqual(got, want) {
+		t.Errorf("GetStats() = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,48 @@
+package emoji
+
+import (
+	"fmt"
+)
+
+// Group is a group of emojis in emoji-test.txt, e.g. GroupFoodAndDrink.
+type Group int
+
+// String returns the name of the group, e.g. "Food & Drink".
+func (g Group) String() string {
+	if g < 0 || int(g) >= len(groupNames) {
+		return fmt.Sprintf("Group(%d)", int(g))
+	}
+
+	return groupNames[g]
+}
+
+// Emojis returns the emojis of the group in the order of emoji-test.txt, e.g. FoodAndDrink for GroupFoodAndDrink.
+// Emojis with skin tones have the default tone. Flags are left out with the emoji_noflags build tag.
+func (g Group) Emojis() []Emoji {
+	if g < 0 || int(g) >= len(groupEmojis) {
+		return nil
+	}
+
+	return groupEmojis[g]
+}
+
+// Subgroup is a subgroup of emojis in emoji-test.txt, e.g. SubgroupFoodFruit.
+type Subgroup int
+
+// String returns the name of the subgroup, e.g. "food-fruit".
+func (s Subgroup) String() string {
+	if s < 0 || int(s) >= len(subgroupNames) {
+		return fmt.Sprintf("Subgroup(%d)", int(s))
+	}
+
+	return subgroupNames[s]
+}
+
+// Group returns the group of the subgroup, e.g. GroupFoodAndDrink for SubgroupFoodFruit.
+func (s Subgroup) Group() Group {
+	if s < 0 || int(s) >= len(subgroupGroups) {
+		return -1
+	}
+
+	return subgroupGroups[s]
+}
@@ -0,0 +1,73 @@
+package emoji
+
+import (
+	"testing"
+)
+
+func TestGroupString(t *testing.T) {
+	tests := []struct {
+		group Group
+		want  string
+	}{
+		{group: GroupSmileysAndEmotion, want: "Smileys & Emotion"},
+		{group: GroupFoodAndDrink, want: "Food & Drink"},
+		{group: GroupFlags, want: "Flags"},
+		{group: Group(-1), want: "Group(-1)"},
+		{group: GroupFlags + 1, want: "Group(10)"},
+	}
+	for _, tt := range tests {
+		if got := tt.group.String(); got != tt.want {
+			t.Errorf("Group(%d).String() = %q, want %q", int(tt.group), got, tt.want)
+		}
+	}
+}
+
+func TestSubgroup(t *testing.T) {
+	tests := []struct {
+		subgroup Subgroup
+		name     string
+		group    Group
+	}{
+		{subgroup: SubgroupFaceSmiling, name: "face-smiling", group: GroupSmileysAndEmotion},
+		{subgroup: SubgroupFoodFruit, name: "food-fruit", group: GroupFoodAndDrink},
+		{subgroup: SubgroupSkyAndWeather, name: "sky & weather", group: GroupTravelAndPlaces},
+		{subgroup: SubgroupSubdivisionFlag, name: "subdivision-flag", group: GroupFlags},
+		{subgroup: Subgroup(-1), name: "Subgroup(-1)", group: -1},
+	}
+	for _, tt := range tests {
+		if got := tt.subgroup.String(); got != tt.name {
+			t.Errorf("Subgroup(%d).String() = %q, want %q", int(tt.subgroup), got, tt.name)
+		}
+		if got := tt.subgroup.Group(); got != tt.group {
+			t.Errorf("%v.Group() = %v, want %v", tt.subgroup, got, tt.group)
+		}
+	}
+}
+
+func TestGroupEmojis(t *testing.T) {
+	if got := GroupFoodAndDrink.Emojis(); len(got) != len(FoodAndDrink) || got[0] != Grapes {
+		t.Errorf("GroupFoodAndDrink.Emojis() = %v, want FoodAndDrink", got)
+	}
+	if got := PeopleAndBody[0]; got.String() != WavingHand.String() {
+		t.Errorf("PeopleAndBody[0] = %q, want %q", got, WavingHand.String())
+	}
+	if got := Group(-1).Emojis(); got != nil {
+		t.Errorf("Group(-1).Emojis() = %v, want nil", got)
+	}
+
+	seen := make(map[Emoji]bool)
+	for g := GroupSmileysAndEmotion; g <= GroupFlags; g++ {
+		if len(g.Emojis()) == 0 && (g != GroupFlags || len(Flags) > 0) {
+			t.Errorf("%v has no emojis", g)
+		}
+		for _, e := range g.Emojis() {
+			if seen[e] {
+				t.Errorf("%q is in more than one group", e)
+			}
+			seen[e] = true
+			if grp, _ := emojiGroups.find(e.String()); grp != g.String() {
+				t.Errorf("%q is in %v, want %v", e, g, grp)
+			}
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package emoji
+
+import (
+	"fmt"
+	"html"
+	"strings"
+)
+
+// SelectorMode defines how the variation selector U+FE0F is written in image names.
+type SelectorMode int
+
+// Variation selector modes
+const (
+	StripSelector     SelectorMode = iota // 2764.svg
+	KeepSelector                          // 2764-fe0f.svg
+	KeepSelectorInZWJ                     // 2764.svg, but 2764-fe0f-200d-1f525.svg
+)
+
+// ImageStyle defines the file naming convention of an emoji image set.
+type ImageStyle struct {
+	// Prefix is written before the code points, e.g. "emoji_u".
+	Prefix string
+	// Separator is written between the code points, e.g. "-".
+	Separator string
+	// Extension is written after the code points, e.g. ".svg".
+	Extension string
+	// Digits is the minimum number of hex digits of a code point.
+	Digits int
+	// Selector defines how U+FE0F is written.
+	Selector SelectorMode
+}
+
+// Common image sets
+var (
+	// TwemojiStyle names images as Twemoji does, e.g. 1f44d-1f3fd.svg
+	TwemojiStyle = ImageStyle{Separator: "-", Extension: ".svg", Selector: KeepSelectorInZWJ}
+	// NotoStyle names images as Noto Emoji does, e.g. emoji_u1f44d_1f3fd.png
+	NotoStyle = ImageStyle{Prefix: "emoji_u", Separator: "_", Extension: ".png", Digits: 4, Selector: StripSelector}
+)
+
+// ImageName returns the image file name of the emoji code in the given style.
+func ImageName(code string, style ImageStyle) string {
+	keepSelector := style.Selector == KeepSelector ||
+		(style.Selector == KeepSelectorInZWJ && strings.ContainsRune(code, zeroWidthJoiner))
+
+	parts := make([]string, 0, len(code))
+	for _, r := range code {
+		if r == emojiPresentation && !keepSelector {
+			continue
+		}
+		parts = append(parts, fmt.Sprintf("%0*x", style.Digits, r))
+	}
+
+	return style.Prefix + strings.Join(parts, style.Separator) + style.Extension
+}
+
+// ImageRenderer defines how emojis are rendered as images.
+type ImageRenderer struct {
+	// BaseURL is written before the image names, e.g. "https://example.com/emoji/".
+	BaseURL string
+	// Style is the naming convention of the images.
+	Style ImageStyle
+	// Class is the class attribute of the images. It's omitted if empty.
+	Class string
+}
+
+// RenderHTML replaces all emojis in the s string with <img> tags.
+// Alternative texts and titles are the aliases of the emojis. The rest of s is not escaped.
+func RenderHTML(s string, r ImageRenderer) string {
+	return ReplaceEmojiFunc(s, func(m Match) string {
+		return r.render(m)
+	})
+}
+
+func (r ImageRenderer) render(m Match) string {
+	alias := m.Alias
+	if alias == "" {
+		alias, _ = FindReverse(toneRegex.ReplaceAllString(m.Code, ""))
+	}
+	if alias == "" {
+		alias = m.Code
+	}
+
+	var tag strings.Builder
+	tag.WriteString(`<img src="`)
+	tag.WriteString(html.EscapeString(r.BaseURL + ImageName(m.Code, r.Style)))
+	tag.WriteString(`" alt="`)
+	tag.WriteString(html.EscapeString(alias))
+	tag.WriteString(`" title="`)
+	tag.WriteString(html.EscapeString(alias))
+	tag.WriteString(`"`)
+	if r.Class != "" {
+		tag.WriteString(` class="`)
+		tag.WriteString(html.EscapeString(r.Class))
+		tag.WriteString(`"`)
+	}
+	tag.WriteString(`>`)
+
+	return tag.String()
+}
@@ -0,0 +1,68 @@
+package emoji
+
+import "testing"
+
+func TestImageName(t *testing.T) {
+	tests := []struct {
+		name  string
+		code  string
+		style ImageStyle
+		want  string
+	}{
+		{name: "twemoji", code: "👍🏽", style: TwemojiStyle, want: "1f44d-1f3fd.svg"},
+		{name: "twemoji without selector", code: "❤️", style: TwemojiStyle, want: "2764.svg"},
+		{name: "twemoji zwj sequence", code: "❤️‍🔥", style: TwemojiStyle, want: "2764-fe0f-200d-1f525.svg"},
+		{name: "twemoji keycap", code: "#️⃣", style: TwemojiStyle, want: "23-20e3.svg"},
+		{name: "noto", code: "👍🏽", style: NotoStyle, want: "emoji_u1f44d_1f3fd.png"},
+		{name: "noto zwj sequence", code: "❤️‍🔥", style: NotoStyle, want: "emoji_u2764_200d_1f525.png"},
+		{name: "noto keycap", code: "#️⃣", style: NotoStyle, want: "emoji_u0023_20e3.png"},
+		{
+			name:  "custom style with selector",
+			code:  "❤️",
+			style: ImageStyle{Separator: "-", Extension: ".png", Selector: KeepSelector},
+			want:  "2764-fe0f.png",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ImageName(tt.code, tt.style); got != tt.want {
+				t.Errorf("ImageName() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderHTML(t *testing.T) {
+	tests := []struct {
+		name     string
+		in       string
+		renderer ImageRenderer
+		want     string
+	}{
+		{
+			name:     "no emoji",
+			in:       "hello <b>world</b>",
+			renderer: ImageRenderer{Style: TwemojiStyle},
+			want:     "hello <b>world</b>",
+		},
+		{
+			name:     "emoji with alias",
+			in:       "I ❤️ you",
+			renderer: ImageRenderer{BaseURL: "/emoji/", Style: TwemojiStyle},
+			want:     `I <img src="/emoji/2764.svg" alt=":red_heart:" title=":red_heart:"> you`,
+		},
+		{
+			name:     "emoji with tone and class",
+			in:       "👍🏿",
+			renderer: ImageRenderer{BaseURL: "/noto/", Style: NotoStyle, Class: "emoji"},
+			want:     `<img src="/noto/emoji_u1f44d_1f3ff.png" alt=":thumbsup:" title=":thumbsup:" class="emoji">`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RenderHTML(tt.in, tt.renderer); got != tt.want {
+				t.Errorf("RenderHTML() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package emoji
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// popularEmojis are the most frequently used emojis, most popular first.
+// Source: https://home.unicode.org/emoji/emoji-frequency/
+var popularEmojis = []fmt.Stringer{
+	FaceWithTearsOfJoy, RedHeart, RollingOnTheFloorLaughing, ThumbsUp, LoudlyCryingFace,
+	FoldedHands, FaceBlowingAKiss, SmilingFaceWithHearts, SmilingFaceWithHeartEyes, SmilingFaceWithSmilingEyes,
+	PartyPopper, BeamingFaceWithSmilingEyes, TwoHearts, PleadingFace, GrinningFaceWithSweat,
+	Fire, SmilingFace, PersonFacepalming, PersonShrugging, FaceWithRollingEyes,
+	GrinningSquintingFace, SmilingFaceWithOpenHands, WinkingFace, ThinkingFace, ClappingHands,
+	SlightlySmilingFace, SmilingFaceWithSunglasses, OkHand, PurpleHeart, FlexedBiceps,
+	Sparkles, Eyes, SparklingHeart, HundredPoints, Rose,
+	PartyingFace, BrokenHeart, FaceSavoringFood, SmirkingFace, CryingFace,
+	BackhandIndexPointingRight, GrowingHeart, SmilingFaceWithHalo, CheckMarkButton,
+}
+
+// Completion is an emoji alias completing a prefix.
+type Completion struct {
+	// Alias is the completed alias, e.g. ":thumbs_up:".
+	Alias string
+	// Code is the code of the emoji.
+	Code string
+}
+
+// Index is a prefix index of emoji aliases for autocompletion.
+// It's safe for concurrent use.
+type Index struct {
+	entries    []indexEntry
+	popularity map[string]int
+}
+
+// indexEntry is a word of an alias, or the whole alias name.
+type indexEntry struct {
+	key   string
+	alias string
+	code  string
+}
+
+// NewIndex returns an index of all emoji aliases.
+// Aliases added later with AppendAlias are indexed only by a new Index.
+func NewIndex() *Index {
+	idx := &Index{
+		entries:    make([]indexEntry, 0, emojiMap.len()*3),
+		popularity: popularityRanks(),
+	}
+
+	emojiMap.each(func(alias, code string) {
+		name := aliasName(alias)
+		idx.entries = append(idx.entries, indexEntry{key: name, alias: alias, code: code})
+
+		// the first word is a prefix of the name
+		tokens := aliasTokens(name)
+		for i := 1; i < len(tokens); i++ {
+			idx.entries = append(idx.entries, indexEntry{key: tokens[i], alias: alias, code: code})
+		}
+	})
+
+	sort.Slice(idx.entries, func(i, j int) bool {
+		a, b := idx.entries[i], idx.entries[j]
+		if a.key != b.key {
+			return a.key < b.key
+		}
+		return a.alias < b.alias
+	})
+
+	return idx
+}
+
+// popularityRanks maps the codes of popular emojis to their ranks, 0 being the most popular.
+func popularityRanks() map[string]int {
+	ranks := make(map[string]int, len(popularEmojis))
+	for i, e := range popularEmojis {
+		if _, ok := ranks[e.String()]; !ok {
+			ranks[e.String()] = i
+		}
+	}
+
+	return ranks
+}
+
+// Complete returns the aliases having a word that starts with the prefix, e.g. ":thumbs_up:" for ":up".
+// Aliases having the prefix as a whole word come first, then popular emojis,
+// then aliases starting with the prefix. A limit <= 0 means no limit.
+func (idx *Index) Complete(prefix string, limit int) []Completion {
+	completions := make([]Completion, 0)
+
+	prefix = aliasName(prefix)
+	if prefix == "" {
+		return completions
+	}
+
+	type candidate struct {
+		Completion
+		exact, popularity int
+		leading           bool
+	}
+
+	var candidates []candidate
+	seen := make(map[string]bool)
+	start := sort.Search(len(idx.entries), func(i int) bool {
+		return idx.entries[i].key >= prefix
+	})
+	for _, e := range idx.entries[start:] {
+		if !strings.HasPrefix(e.key, prefix) {
+			break
+		}
+		if seen[e.alias] {
+			continue
+		}
+		seen[e.alias] = true
+
+		c := candidate{
+			Completion: Completion{Alias: e.alias, Code: e.code},
+			exact:      1,
+			popularity: len(popularEmojis),
+			leading:    strings.HasPrefix(aliasName(e.alias), prefix),
+		}
+		for _, t := range aliasTokens(aliasName(e.alias)) {
+			if t == prefix {
+				c.exact = 0
+				break
+			}
+		}
+		if p, ok := idx.popularity[e.code]; ok {
+			c.popularity = p
+		}
+		candidates = append(candidates, c)
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		switch {
+		case a.exact != b.exact:
+			return a.exact < b.exact
+		case a.popularity != b.popularity:
+			return a.popularity < b.popularity
+		case a.leading != b.leading:
+			return a.leading
+		case len(a.Alias) != len(b.Alias):
+			return len(a.Alias) < len(b.Alias)
+		}
+		return a.Alias < b.Alias
+	})
+
+	for _, c := range candidates {
+		if limit > 0 && len(completions) == limit {
+			break
+		}
+		completions = append(completions, c.Completion)
+	}
+
+	return completions
+}
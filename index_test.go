@@ -0,0 +1,47 @@
+package emoji
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestIndexComplete(t *testing.T) {
+	idx := NewIndex()
+
+	tt := []struct {
+		prefix   string
+		limit    int
+		expected []string
+	}{
+		{prefix: ":pizz", limit: 5, expected: []string{":pizza:"}},
+		{prefix: ":up", limit: 2, expected: []string{":thumbs_up:", ":up:"}},
+		{prefix: ":thumbs_u", limit: 5, expected: []string{":thumbs_up:"}},
+		{prefix: "HEART", limit: 2, expected: []string{":heart:", ":red_heart:"}},
+		{prefix: ":e-m", limit: 0, expected: []string{":e-mail:"}},
+		{prefix: ":", limit: 5, expected: []string{}},
+		{prefix: ":not_exist", limit: 5, expected: []string{}},
+	}
+
+	for i, tc := range tt {
+		t.Run(fmt.Sprintf("test #%d", i), func(t *testing.T) {
+			got := idx.Complete(tc.prefix, tc.limit)
+			if len(got) != len(tc.expected) {
+				t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got, tc.expected)
+			}
+			for j, c := range got {
+				if c.Alias != tc.expected[j] || !isCode(c.Alias, c.Code) {
+					t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got, tc.expected)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkIndexComplete(b *testing.B) {
+	idx := NewIndex()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		idx.Complete(":th", 10)
+	}
+}
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+)
+
+// cldrRelease is the CLDR release of the annotations. CLDR 40 has the annotations of Emoji 14.0.
+const cldrRelease = "40"
+
+// cldrURL returns the URL of the CLDR annotations of the language in the directory of annotations.
+func cldrURL(dir, lang string) string {
+	return fmt.Sprintf("https://raw.githubusercontent.com/unicode-org/cldr/release-%v/common/%v/%v.xml", cldrRelease, dir, lang)
+}
+
+type ldml struct {
+	Annotations []cldrAnnotation `xml:"annotations>annotation"`
+}
+
+type cldrAnnotation struct {
+	CP   string `xml:"cp,attr"`
+	Type string `xml:"type,attr"`
+	Text string `xml:",chardata"`
+}
+
+// annotationDirs are the directories of CLDR annotations. Derived annotations have the names of sequences,
+// e.g. of emojis with skin tones, flags and keycaps.
+var annotationDirs = []string{"annotations", "annotationsDerived"}
+
+// readAnnotations reads the names and the keywords of emojis from the CLDR annotations of the language.
+func (o options) readAnnotations(lang string, sums checksums) (map[string]string, map[string][]string, error) {
+	names := make(map[string]string)
+	keywords := make(map[string][]string)
+	for _, dir := range annotationDirs {
+		in := cldrInput(dir, lang)
+		b, err := o.read(in, "", sums)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		n, k, err := loadAnnotations(b)
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not parse %v: %v", in.name, err)
+		}
+		for code, name := range n {
+			if _, ok := names[code]; !ok {
+				names[code] = name
+			}
+		}
+		for code, kw := range k {
+			if _, ok := keywords[code]; !ok {
+				keywords[code] = kw
+			}
+		}
+	}
+
+	return names, keywords, nil
+}
+
+// loadAnnotations reads the names and the keywords of emojis from a CLDR annotations file.
+// They are keyed by the codes of emojis without variation selectors.
+func loadAnnotations(b []byte) (map[string]string, map[string][]string, error) {
+	var doc ldml
+	if err := xml.Unmarshal(b, &doc); err != nil {
+		return nil, nil, err
+	}
+
+	names := make(map[string]string)
+	keywords := make(map[string][]string)
+	for _, a := range doc.Annotations {
+		code := removeSelectors(a.CP)
+
+		// text-to-speech annotations are names, not keywords
+		if a.Type == "tts" {
+			names[code] = strings.TrimSpace(a.Text)
+			continue
+		}
+
+		for _, k := range strings.Split(a.Text, "|") {
+			if k = strings.TrimSpace(k); k != "" {
+				keywords[code] = append(keywords[code], k)
+			}
+		}
+	}
+
+	return names, keywords, nil
+}
+
+// generateAnnotations maps every emoji to its name and keywords.
+// Skin toned variations are not included.
+func generateAnnotations(emojis *groups, keywords map[string][]string) split {
+	var res, flags string
+	seen := make(map[string]bool)
+	for _, grp := range emojis.Groups {
+		for _, subgrp := range grp.Subgroups {
+			for _, c := range subgrp.Constants {
+				e := subgrp.Emojis[c][0]
+				if seen[e.Code] {
+					continue
+				}
+				seen[e.Code] = true
+
+				kw := "nil"
+				if k := keywords[removeSelectors(e.Code)]; len(k) > 0 {
+					kw = fmt.Sprintf("%#v", k)
+				}
+				a := fmt.Sprintf("%+q: {%q, %s},\n", e.Code, e.Name, kw)
+				if grp.Name == flagsGroup {
+					flags += a
+				} else {
+					res += a
+				}
+			}
+		}
+	}
+
+	return split{
+		data:  res,
+		flags: fmt.Sprintf("var flagAnnotations = map[string]annotation{\n%v}\n", flags),
+	}
+}
+
+func removeSelectors(code string) string {
+	return strings.ReplaceAll(code, "\ufe0f", "")
+}
@@ -0,0 +1,160 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Sources of aliases
+const (
+	unicodeSource = "unicode"
+	gemojiSource  = "gemoji"
+	customSource  = "custom"
+)
+
+// aliasPrecedence is the precedence of the sources of aliases. An alias of different emojis in
+// different sources is used for the emoji of the source with the highest precedence. Aliases of
+// different emojis in the same source, e.g. constant names with the same snake case, are ambiguous,
+// and the first one is used.
+var aliasPrecedence = map[string]int{
+	unicodeSource: 0,
+	gemojiSource:  1,
+	customSource:  2,
+}
+
+// reviewedCollisions are the known alias collisions with the codes they are used for.
+// Strict mode fails on other collisions, so new ones don't change aliases unnoticed.
+var reviewedCollisions = map[string]string{
+	":calendar:":    "\U0001f4c6",
+	":camel:":       "\U0001f42b",
+	":cat:":         "\U0001f431",
+	":cow:":         "\U0001f42e",
+	":dog:":         "\U0001f436",
+	":horse:":       "\U0001f434",
+	":kiss:":        "\U0001f48b",
+	":mouse:":       "\U0001f42d",
+	":pencil:":      "\U0001f4dd",
+	":pig:":         "\U0001f437",
+	":post_office:": "\U0001f3e3",
+	":rabbit:":      "\U0001f430",
+	":satellite:":   "\U0001f4e1",
+	":snowman:":     "⛄",
+	":sunglasses:":  "\U0001f60e",
+	":tiger:":       "\U0001f42f",
+	":train:":       "\U0001f68b",
+	":umbrella:":    "☔",
+	":whale:":       "\U0001f433",
+}
+
+// aliasCollision is an alias of different emojis in the sources of aliases.
+type aliasCollision struct {
+	Alias string `json:"alias"`
+	// Code is the code of the emoji the alias is used for.
+	Code string `json:"code"`
+	// Codes are the codes of the emoji by Sources in the order they were found.
+	Codes   []string `json:"codes"`
+	Sources []string `json:"sources"`
+}
+
+// ambiguous checks whether the collision is in a single source, so the precedence doesn't resolve it.
+func (c aliasCollision) ambiguous() bool {
+	for _, s := range c.Sources[1:] {
+		if s != c.Sources[0] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// reviewed checks whether the collision is known and resolved as reviewed.
+func (c aliasCollision) reviewed() bool {
+	code, ok := reviewedCollisions[c.Alias]
+	return ok && code == c.Code && !c.ambiguous()
+}
+
+func (c aliasCollision) String() string {
+	codes := make([]string, len(c.Codes))
+	for i, code := range c.Codes {
+		codes[i] = fmt.Sprintf("%+q (%v)", code, c.Sources[i])
+	}
+
+	return fmt.Sprintf("%v %v, uses %+q", c.Alias, strings.Join(codes, ", "), c.Code)
+}
+
+// aliasResolver adds the aliases of the sources, and resolves their collisions.
+type aliasResolver struct {
+	codes      map[string]string
+	sources    map[string]string
+	collisions map[string]*aliasCollision
+}
+
+func newAliasResolver() *aliasResolver {
+	return &aliasResolver{
+		codes:      make(map[string]string),
+		sources:    make(map[string]string),
+		collisions: make(map[string]*aliasCollision),
+	}
+}
+
+// add adds the alias of the code from the source. The alias is kept if it has a different code
+// from a source with the same or higher precedence. An alias of the same code in more sources
+// has the source with the highest precedence.
+func (r *aliasResolver) add(alias, code, source string) {
+	prev, ok := r.codes[alias]
+	if !ok {
+		r.codes[alias] = code
+		r.sources[alias] = source
+		return
+	}
+	if prev == code {
+		if aliasPrecedence[source] > aliasPrecedence[r.sources[alias]] {
+			r.sources[alias] = source
+		}
+		return
+	}
+
+	c, ok := r.collisions[alias]
+	if !ok {
+		c = &aliasCollision{Alias: alias, Codes: []string{prev}, Sources: []string{r.sources[alias]}}
+		r.collisions[alias] = c
+	}
+	c.Codes = append(c.Codes, code)
+	c.Sources = append(c.Sources, source)
+
+	if aliasPrecedence[source] > aliasPrecedence[r.sources[alias]] {
+		r.codes[alias] = code
+		r.sources[alias] = source
+	}
+	c.Code = r.codes[alias]
+}
+
+// sortedCollisions returns the collisions sorted by their aliases.
+func (r *aliasResolver) sortedCollisions() []aliasCollision {
+	collisions := make([]aliasCollision, 0, len(r.collisions))
+	for _, c := range r.collisions {
+		collisions = append(collisions, *c)
+	}
+	sort.Slice(collisions, func(i, j int) bool {
+		return collisions[i].Alias < collisions[j].Alias
+	})
+
+	return collisions
+}
+
+// checkCollisions returns an error listing the collisions which are ambiguous or not reviewed.
+func checkCollisions(collisions []aliasCollision) error {
+	var unreviewed []string
+	for _, c := range collisions {
+		if !c.reviewed() {
+			unreviewed = append(unreviewed, c.String())
+		}
+	}
+	if len(unreviewed) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%d alias collisions are not reviewed, add them to reviewedCollisions after checking them:\n  %v",
+		len(unreviewed), strings.Join(unreviewed, "\n  "))
+}
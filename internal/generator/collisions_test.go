@@ -0,0 +1,150 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestAliasResolver(t *testing.T) {
+	type add struct {
+		code   string
+		source string
+	}
+	tt := []struct {
+		adds      []add
+		code      string
+		source    string
+		collision *aliasCollision
+	}{
+		{
+			adds:   []add{{code: "a", source: unicodeSource}},
+			code:   "a",
+			source: unicodeSource,
+		},
+		{
+			adds:   []add{{code: "a", source: unicodeSource}, {code: "a", source: gemojiSource}},
+			code:   "a",
+			source: gemojiSource,
+		},
+		{
+			adds:   []add{{code: "a", source: unicodeSource}, {code: "b", source: gemojiSource}},
+			code:   "b",
+			source: gemojiSource,
+			collision: &aliasCollision{
+				Alias: ":x:", Code: "b", Codes: []string{"a", "b"}, Sources: []string{unicodeSource, gemojiSource},
+			},
+		},
+		{
+			adds:   []add{{code: "a", source: gemojiSource}, {code: "b", source: unicodeSource}},
+			code:   "a",
+			source: gemojiSource,
+			collision: &aliasCollision{
+				Alias: ":x:", Code: "a", Codes: []string{"a", "b"}, Sources: []string{gemojiSource, unicodeSource},
+			},
+		},
+		{
+			adds:   []add{{code: "a", source: unicodeSource}, {code: "b", source: unicodeSource}},
+			code:   "a",
+			source: unicodeSource,
+			collision: &aliasCollision{
+				Alias: ":x:", Code: "a", Codes: []string{"a", "b"}, Sources: []string{unicodeSource, unicodeSource},
+			},
+		},
+		{
+			adds:   []add{{code: "a", source: unicodeSource}, {code: "b", source: gemojiSource}, {code: "c", source: customSource}},
+			code:   "c",
+			source: customSource,
+			collision: &aliasCollision{
+				Alias: ":x:", Code: "c", Codes: []string{"a", "b", "c"}, Sources: []string{unicodeSource, gemojiSource, customSource},
+			},
+		},
+	}
+
+	for i, tc := range tt {
+		t.Run(fmt.Sprintf("test #%d", i), func(t *testing.T) {
+			r := newAliasResolver()
+			for _, a := range tc.adds {
+				r.add(":x:", a.code, a.source)
+			}
+
+			if r.codes[":x:"] != tc.code || r.sources[":x:"] != tc.source {
+				t.Fatalf("test case %v fail: got: %v (%v), expected: %v (%v)", i+1, r.codes[":x:"], r.sources[":x:"], tc.code, tc.source)
+			}
+			if got := r.collisions[":x:"]; !reflect.DeepEqual(got, tc.collision) {
+				t.Fatalf("test case %v fail: got: %+v, expected: %+v", i+1, got, tc.collision)
+			}
+		})
+	}
+}
+
+func TestGenerateAliases(t *testing.T) {
+	_, r := generateAliases(testGroups(t), testGemojis(t), nil)
+
+	for alias, code := range map[string]string{
+		":grinning_face:":               "\U0001f603",
+		":grinning:":                    "\U0001f600",
+		":grinning_face_with_big_eyes:": "\U0001f603",
+		":enraged_face:":                "\U0001f621",
+		":pout:":                        "\U0001f621",
+		":thumbs_up:":                   "\U0001f44d",
+		":+1:":                          "\U0001f44d",
+		":flag_for_germany:":            "\U0001f1e9\U0001f1ea",
+	} {
+		if got := r.codes[alias]; got != code {
+			t.Errorf("alias %v: got: %+q, expected: %+q", alias, got, code)
+		}
+	}
+
+	expected := []aliasCollision{{
+		Alias:   ":grinning_face:",
+		Code:    "\U0001f603",
+		Codes:   []string{"\U0001f600", "\U0001f603"},
+		Sources: []string{unicodeSource, gemojiSource},
+	}}
+	if got := r.sortedCollisions(); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("got: %+v, expected: %+v", got, expected)
+	}
+}
+
+func TestCheckCollisions(t *testing.T) {
+	tt := []struct {
+		collisions []aliasCollision
+		err        string
+	}{
+		{collisions: nil},
+		{
+			collisions: []aliasCollision{{
+				Alias: ":cat:", Code: "\U0001f431", Codes: []string{"\U0001f408", "\U0001f431"}, Sources: []string{unicodeSource, gemojiSource},
+			}},
+		},
+		{
+			collisions: []aliasCollision{{
+				Alias: ":cat:", Code: "\U0001f408", Codes: []string{"\U0001f431", "\U0001f408"}, Sources: []string{unicodeSource, gemojiSource},
+			}},
+			err: `1 alias collisions are not reviewed`,
+		},
+		{
+			collisions: []aliasCollision{{
+				Alias: ":cat:", Code: "\U0001f431", Codes: []string{"\U0001f431", "\U0001f408"}, Sources: []string{unicodeSource, unicodeSource},
+			}},
+			err: `:cat: "\U0001f431" (unicode), "\U0001f408" (unicode), uses "\U0001f431"`,
+		},
+		{
+			collisions: []aliasCollision{{
+				Alias: ":grinning_face:", Code: "\U0001f603", Codes: []string{"\U0001f600", "\U0001f603"}, Sources: []string{unicodeSource, gemojiSource},
+			}},
+			err: `:grinning_face: "\U0001f600" (unicode), "\U0001f603" (gemoji), uses "\U0001f603"`,
+		},
+	}
+
+	for i, tc := range tt {
+		t.Run(fmt.Sprintf("test #%d", i), func(t *testing.T) {
+			err := checkCollisions(tc.collisions)
+			if (err != nil) != (tc.err != "") || err != nil && !strings.Contains(err.Error(), tc.err) {
+				t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, err, tc.err)
+			}
+		})
+	}
+}
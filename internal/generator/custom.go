@@ -0,0 +1,139 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"strings"
+)
+
+const customEmojisTable = "internal/generator/custom_emojis.tsv"
+
+// customEmoji is an alias of an emoji which unicode and gemoji databases don't have.
+type customEmoji struct {
+	Alias string
+	Code  string
+	// Group is the group of the emoji in emoji-test.txt. It's optional, and checked if it's given.
+	Group string
+	// Keywords are added to the search keywords of the emoji.
+	Keywords []string
+	// line is the line number in the table.
+	line int
+}
+
+var customAliasRegex = regexp.MustCompile(`^:[a-z0-9_+\-]+:$`)
+
+// loadCustomEmojis reads the custom emojis table. Each line has an alias and the code points of its
+// emoji separated by a tab, optionally followed by the group of the emoji and its keywords separated by commas.
+func loadCustomEmojis(filename string) ([]customEmoji, error) {
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var custom []customEmoji
+	var lineErr error
+	n := 0
+	parseLine := func(line string) {
+		n++
+		line = strings.TrimRight(line, "\r\n")
+		if lineErr != nil || strings.TrimSpace(line) == "" || strings.HasPrefix(line, "#") {
+			return
+		}
+
+		parts := strings.Split(line, "\t")
+		if len(parts) < 2 || len(parts) > 4 {
+			lineErr = fmt.Errorf("%v:%d: not valid custom emoji line, want alias, code points, group and keywords: %q", filename, n, line)
+			return
+		}
+
+		code, err := parseCodePoints(parts[1])
+		if err != nil || code == "" {
+			lineErr = fmt.Errorf("%v:%d: not valid code points of %v: %q", filename, n, parts[0], parts[1])
+			return
+		}
+
+		c := customEmoji{Alias: parts[0], Code: code, line: n}
+		if len(parts) > 2 {
+			c.Group = strings.TrimSpace(parts[2])
+		}
+		if len(parts) > 3 {
+			for _, k := range strings.Split(parts[3], ",") {
+				if k = strings.TrimSpace(k); k != "" {
+					c.Keywords = append(c.Keywords, k)
+				}
+			}
+		}
+		custom = append(custom, c)
+	}
+
+	if err = readLines(b, parseLine); err != nil {
+		return nil, err
+	}
+	if lineErr != nil {
+		return nil, lineErr
+	}
+
+	return custom, nil
+}
+
+// validateCustomEmojis checks whether the aliases are valid and distinct, and their codes are emojis
+// of emoji-test.txt in the given groups.
+func validateCustomEmojis(filename string, custom []customEmoji, emojis *groups) error {
+	emojiGroups := make(map[string]string)
+	for _, grp := range emojis.Groups {
+		for _, subgrp := range grp.Subgroups {
+			for _, c := range subgrp.Constants {
+				for _, e := range subgrp.Emojis[c] {
+					emojiGroups[e.Code] = grp.Name
+				}
+			}
+		}
+	}
+
+	seen := make(map[string]int)
+	for _, c := range custom {
+		if !customAliasRegex.MatchString(c.Alias) {
+			return fmt.Errorf("%v:%d: not valid alias %q, e.g. :robot_face:", filename, c.line, c.Alias)
+		}
+		if line, ok := seen[c.Alias]; ok {
+			return fmt.Errorf("%v:%d: duplicate alias %v of line %d", filename, c.line, c.Alias, line)
+		}
+		seen[c.Alias] = c.line
+
+		grp, ok := emojiGroups[c.Code]
+		if !ok {
+			if _, ok := emojiGroups[c.Code+"\ufe0f"]; ok {
+				return fmt.Errorf("%v:%d: %v is not fully qualified, add FE0F to its code points", filename, c.line, c.Alias)
+			}
+			return fmt.Errorf("%v:%d: code of %v is not an emoji: %+q", filename, c.line, c.Alias, c.Code)
+		}
+		if c.Group != "" && c.Group != grp {
+			return fmt.Errorf("%v:%d: %v is in group %q, not %q", filename, c.line, c.Alias, grp, c.Group)
+		}
+	}
+
+	return nil
+}
+
+// addCustomKeywords adds the keywords of the custom emojis to the keywords of emojis without duplicates.
+func addCustomKeywords(keywords map[string][]string, custom []customEmoji) {
+	for _, c := range custom {
+		code := removeSelectors(c.Code)
+		for _, k := range c.Keywords {
+			if !containsString(keywords[code], k) {
+				keywords[code] = append(keywords[code], k)
+			}
+		}
+	}
+}
+
+func containsString(s []string, v string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLoadCustomEmojis(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "custom_emojis.tsv")
+	table := "# alias\tcode points\tgroup\tkeywords\n" +
+		"\n" +
+		":robot_face:\t1F916\n" +
+		":smiling:\t263A FE0F\tSmileys & Emotion\tsmile, , happy\n"
+	if err := ioutil.WriteFile(filename, []byte(table), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := loadCustomEmojis(filename)
+	if err != nil {
+		t.Fatalf("loadCustomEmojis() fail: %v", err)
+	}
+
+	expected := []customEmoji{
+		{Alias: ":robot_face:", Code: "\U0001f916", line: 3},
+		{Alias: ":smiling:", Code: "☺️", Group: "Smileys & Emotion", Keywords: []string{"smile", "happy"}, line: 4},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Fatalf("got: %+v, expected: %+v", got, expected)
+	}
+
+	if err := ioutil.WriteFile(filename, []byte(":robot_face:\n:smiling:\t263A FE0F\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := loadCustomEmojis(filename); err == nil || !strings.Contains(err.Error(), ":1:") {
+		t.Fatalf("expected an error for line 1, got: %v", err)
+	}
+}
+
+func TestValidateCustomEmojis(t *testing.T) {
+	emojis, err := parseEmojis([]byte(testEmojiTest + `
+# group: Smileys & Emotion
+
+# subgroup: face-affection
+263A FE0F                                              ; fully-qualified     # ☺️ E0.6 smiling face
+263A                                                   ; unqualified         # ☺ E0.6 smiling face
+`))
+	if err != nil {
+		t.Fatalf("could not parse emoji-test.txt: %v", err)
+	}
+
+	tt := []struct {
+		custom []customEmoji
+		err    string
+	}{
+		{
+			custom: []customEmoji{
+				{Alias: ":grin:", Code: "\U0001f600", line: 1},
+				{Alias: ":like:", Code: "\U0001f44d\U0001f3fb", Group: "People & Body", line: 2},
+				{Alias: ":smiling:", Code: "☺️", Group: "Smileys & Emotion", line: 3},
+			},
+		},
+		{
+			custom: []customEmoji{{Alias: "grin", Code: "\U0001f600", line: 1}},
+			err:    `custom.tsv:1: not valid alias "grin"`,
+		},
+		{
+			custom: []customEmoji{{Alias: ":Grin:", Code: "\U0001f600", line: 1}},
+			err:    `custom.tsv:1: not valid alias ":Grin:"`,
+		},
+		{
+			custom: []customEmoji{
+				{Alias: ":grin:", Code: "\U0001f600", line: 1},
+				{Alias: ":grin:", Code: "\U0001f603", line: 2},
+			},
+			err: `custom.tsv:2: duplicate alias :grin: of line 1`,
+		},
+		{
+			custom: []customEmoji{{Alias: ":robot_face:", Code: "\U0001f916", line: 1}},
+			err:    `custom.tsv:1: code of :robot_face: is not an emoji`,
+		},
+		{
+			custom: []customEmoji{{Alias: ":smiling:", Code: "☺", line: 1}},
+			err:    `custom.tsv:1: :smiling: is not fully qualified, add FE0F`,
+		},
+		{
+			custom: []customEmoji{{Alias: ":grin:", Code: "\U0001f600", Group: "Flags", line: 1}},
+			err:    `custom.tsv:1: :grin: is in group "Smileys & Emotion", not "Flags"`,
+		},
+	}
+
+	for i, tc := range tt {
+		t.Run(fmt.Sprintf("test #%d", i), func(t *testing.T) {
+			err := validateCustomEmojis("custom.tsv", tc.custom, emojis)
+			if (err != nil) != (tc.err != "") || err != nil && !strings.Contains(err.Error(), tc.err) {
+				t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, err, tc.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,324 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// diffReport lists the changes of the generated data.
+type diffReport struct {
+	AddedEmojis      []constantDiff   `json:"added_emojis"`
+	RemovedEmojis    []constantDiff   `json:"removed_emojis"`
+	RenamedConstants []renameDiff     `json:"renamed_constants"`
+	ChangedCodes     []codeDiff       `json:"changed_codes"`
+	AddedAliases     []aliasDiff      `json:"added_aliases"`
+	RemovedAliases   []aliasDiff      `json:"removed_aliases"`
+	ChangedAliases   []aliasChange    `json:"changed_aliases"`
+	AliasCollisions  []aliasCollision `json:"alias_collisions"`
+}
+
+// constantDiff is an added or removed constant with its value, e.g. "\U0001f600".
+type constantDiff struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// renameDiff is a constant whose name changed, but value didn't.
+type renameDiff struct {
+	Old   string `json:"old"`
+	New   string `json:"new"`
+	Value string `json:"value"`
+}
+
+// codeDiff is a constant whose value changed.
+type codeDiff struct {
+	Name string `json:"name"`
+	Old  string `json:"old"`
+	New  string `json:"new"`
+}
+
+// aliasDiff is an added or removed alias with its code.
+type aliasDiff struct {
+	Alias string `json:"alias"`
+	Code  string `json:"code"`
+}
+
+// aliasChange is an alias whose code changed.
+type aliasChange struct {
+	Alias string `json:"alias"`
+	Old   string `json:"old"`
+	New   string `json:"new"`
+}
+
+// diff compares the generated constants and aliases with the current ones.
+func diff(constants split, aliases map[string]string, collisions []aliasCollision) (*diffReport, error) {
+	oldConstants, err := readGenerated(constantsFile, readConstants)
+	if err != nil {
+		return nil, err
+	}
+	oldAliases, err := readGenerated(aliasesFile, readTable)
+	if err != nil {
+		return nil, err
+	}
+	newConstants, err := readConstants([]byte("package emoji\n" + constants.data + "\n" + constants.flags))
+	if err != nil {
+		return nil, fmt.Errorf("could not read generated constants: %v", err)
+	}
+
+	return compare(oldConstants, newConstants, oldAliases, aliases, collisions), nil
+}
+
+// readGenerated reads the current generated file and its flags file with read, so the current data doesn't
+// depend on the build of the generator. Missing files are empty.
+func readGenerated(filename string, read func(src []byte) (map[string]string, error)) (map[string]string, error) {
+	data := make(map[string]string)
+	for _, filename := range []string{filename, flagsFile(filename)} {
+		src, err := ioutil.ReadFile(filename)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		d, err := read(src)
+		if err != nil {
+			return nil, fmt.Errorf("could not read current data of %v: %v", filename, err)
+		}
+		for k, v := range d {
+			data[k] = v
+		}
+	}
+
+	return data, nil
+}
+
+// compare compares the old constants and aliases with the new ones.
+func compare(oldConstants, newConstants, oldAliases, aliases map[string]string, collisions []aliasCollision) *diffReport {
+	r := &diffReport{AliasCollisions: collisions}
+
+	added := make(map[string]string)
+	for _, name := range sortedKeys(newConstants) {
+		value := newConstants[name]
+		old, ok := oldConstants[name]
+		switch {
+		case !ok:
+			added[value] = name
+		case old != value:
+			r.ChangedCodes = append(r.ChangedCodes, codeDiff{Name: name, Old: old, New: value})
+		}
+	}
+	for _, name := range sortedKeys(oldConstants) {
+		value := oldConstants[name]
+		if _, ok := newConstants[name]; ok {
+			continue
+		}
+		if newName, ok := added[value]; ok {
+			r.RenamedConstants = append(r.RenamedConstants, renameDiff{Old: name, New: newName, Value: value})
+			delete(added, value)
+			continue
+		}
+		r.RemovedEmojis = append(r.RemovedEmojis, constantDiff{Name: name, Value: value})
+	}
+	for _, name := range sortedKeys(newConstants) {
+		if value := newConstants[name]; added[value] == name {
+			r.AddedEmojis = append(r.AddedEmojis, constantDiff{Name: name, Value: value})
+		}
+	}
+
+	for _, alias := range sortedKeys(aliases) {
+		code := aliases[alias]
+		old, ok := oldAliases[alias]
+		switch {
+		case !ok:
+			r.AddedAliases = append(r.AddedAliases, aliasDiff{Alias: alias, Code: code})
+		case old != code:
+			r.ChangedAliases = append(r.ChangedAliases, aliasChange{Alias: alias, Old: old, New: code})
+		}
+	}
+	for _, alias := range sortedKeys(oldAliases) {
+		if _, ok := aliases[alias]; !ok {
+			r.RemovedAliases = append(r.RemovedAliases, aliasDiff{Alias: alias, Code: oldAliases[alias]})
+		}
+	}
+
+	return r
+}
+
+// readConstants returns the values of the emoji constants in the Go source by their names,
+// e.g. "\U0001f600" or newEmojiWithTone("\U0001f44d@").
+func readConstants(src []byte) (map[string]string, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "", src, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	constants := make(map[string]string)
+	for _, decl := range f.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.VAR {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			vs := spec.(*ast.ValueSpec)
+			if typ, ok := vs.Type.(*ast.Ident); !ok || (typ.Name != "Emoji" && typ.Name != "EmojiWithTone") {
+				continue
+			}
+			for i, name := range vs.Names {
+				if i >= len(vs.Values) {
+					continue
+				}
+				var value bytes.Buffer
+				if err := format.Node(&value, fset, vs.Values[i]); err != nil {
+					return nil, err
+				}
+				constants[name.Name] = value.String()
+			}
+		}
+	}
+
+	return constants, nil
+}
+
+// readTable returns the entries of the tables packed into the string constants of the Go source,
+// e.g. the codes of the emojis by their aliases in map.go.
+func readTable(src []byte) (map[string]string, error) {
+	f, err := parser.ParseFile(token.NewFileSet(), "", src, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make(map[string]string)
+	for _, decl := range f.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			for _, value := range spec.(*ast.ValueSpec).Values {
+				data, err := stringValue(value)
+				if err != nil {
+					return nil, err
+				}
+				parts := strings.Split(data, "\x00")
+				if len(parts)%2 != 1 || parts[len(parts)-1] != "" {
+					return nil, fmt.Errorf("not valid table: %.40q", data)
+				}
+				for i := 0; i+1 < len(parts); i += 2 {
+					entries[parts[i]] = parts[i+1]
+				}
+			}
+		}
+	}
+
+	return entries, nil
+}
+
+// stringValue returns the value of a string literal or a concatenation of them.
+func stringValue(expr ast.Expr) (string, error) {
+	switch e := expr.(type) {
+	case *ast.BasicLit:
+		if e.Kind == token.STRING {
+			return strconv.Unquote(e.Value)
+		}
+	case *ast.BinaryExpr:
+		if e.Op == token.ADD {
+			x, err := stringValue(e.X)
+			if err != nil {
+				return "", err
+			}
+			y, err := stringValue(e.Y)
+			return x + y, err
+		}
+	case *ast.ParenExpr:
+		return stringValue(e.X)
+	}
+
+	return "", fmt.Errorf("not a string constant: %T", expr)
+}
+
+// writeText writes the report in a human readable format.
+func (r *diffReport) writeText(w io.Writer) error {
+	var b bytes.Buffer
+	section := func(title string, n int) bool {
+		if n > 0 {
+			fmt.Fprintf(&b, "%v (%d):\n", title, n)
+		}
+		return n > 0
+	}
+
+	if section("Added emojis", len(r.AddedEmojis)) {
+		for _, c := range r.AddedEmojis {
+			fmt.Fprintf(&b, "  + %v = %v\n", c.Name, c.Value)
+		}
+	}
+	if section("Removed emojis", len(r.RemovedEmojis)) {
+		for _, c := range r.RemovedEmojis {
+			fmt.Fprintf(&b, "  - %v = %v\n", c.Name, c.Value)
+		}
+	}
+	if section("Renamed constants", len(r.RenamedConstants)) {
+		for _, c := range r.RenamedConstants {
+			fmt.Fprintf(&b, "  ~ %v -> %v = %v\n", c.Old, c.New, c.Value)
+		}
+	}
+	if section("Changed codes", len(r.ChangedCodes)) {
+		for _, c := range r.ChangedCodes {
+			fmt.Fprintf(&b, "  ~ %v: %v -> %v\n", c.Name, c.Old, c.New)
+		}
+	}
+	if section("Added aliases", len(r.AddedAliases)) {
+		for _, a := range r.AddedAliases {
+			fmt.Fprintf(&b, "  + %v = %+q\n", a.Alias, a.Code)
+		}
+	}
+	if section("Removed aliases", len(r.RemovedAliases)) {
+		for _, a := range r.RemovedAliases {
+			fmt.Fprintf(&b, "  - %v = %+q\n", a.Alias, a.Code)
+		}
+	}
+	if section("Changed aliases", len(r.ChangedAliases)) {
+		for _, a := range r.ChangedAliases {
+			fmt.Fprintf(&b, "  ~ %v: %+q -> %+q\n", a.Alias, a.Old, a.New)
+		}
+	}
+	if section("Alias collisions", len(r.AliasCollisions)) {
+		for _, c := range r.AliasCollisions {
+			fmt.Fprintf(&b, "  ! %v\n", c)
+		}
+	}
+	if b.Len() == 0 {
+		b.WriteString("No changes\n")
+	}
+
+	_, err := w.Write(b.Bytes())
+	return err
+}
+
+// writeJSON writes the report in JSON format.
+func (r *diffReport) writeJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,210 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	emojipkg "github.com/AkinAD/emoji"
+)
+
+func TestReadConstants(t *testing.T) {
+	src := `package emoji
+
+var (
+	GrinningFace Emoji         = "\U0001f600" // grinning face
+	ThumbsUp     EmojiWithTone = newEmojiWithTone("\U0001f44d@") // thumbs up
+	Unknown                    = "x"
+)
+
+const GroupFlags Group = 9
+
+var Flags = []Emoji{"\U0001f1e9\U0001f1ea"}
+`
+	got, err := readConstants([]byte(src))
+	if err != nil {
+		t.Fatalf("readConstants() fail: %v", err)
+	}
+
+	expected := map[string]string{
+		"GrinningFace": `"\U0001f600"`,
+		"ThumbsUp":     `newEmojiWithTone("\U0001f44d@")`,
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Fatalf("got: %v, expected: %v", got, expected)
+	}
+}
+
+func TestGeneratedConstants(t *testing.T) {
+	constants, err := generateConstants(testGroups(t))
+	if err != nil {
+		t.Fatalf("generateConstants() fail: %v", err)
+	}
+
+	got, err := readConstants([]byte("package emoji\n" + constants.data + "\n" + constants.flags))
+	if err != nil {
+		t.Fatalf("could not read generated constants: %v", err)
+	}
+
+	expected := map[string]string{
+		"GrinningFace":            `"\U0001f600"`,
+		"GrinningFaceWithBigEyes": `"\U0001f603"`,
+		"EnragedFace":             `"\U0001f621"`,
+		"ThumbsUp":                `newEmojiWithTone("\U0001f44d@")`,
+		"FlagForGermany":          `"\U0001f1e9\U0001f1ea"`,
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Fatalf("got: %v, expected: %v", got, expected)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	tt := []struct {
+		oldConstants map[string]string
+		newConstants map[string]string
+		oldAliases   map[string]string
+		aliases      map[string]string
+		expected     *diffReport
+	}{
+		{
+			oldConstants: map[string]string{"GrinningFace": `"\U0001f600"`},
+			newConstants: map[string]string{"GrinningFace": `"\U0001f600"`},
+			oldAliases:   map[string]string{":grinning:": "\U0001f600"},
+			aliases:      map[string]string{":grinning:": "\U0001f600"},
+			expected:     &diffReport{},
+		},
+		{
+			oldConstants: map[string]string{"PoutingFace": `"\U0001f621"`, "GrinningFace": `"\U0001f600"`},
+			newConstants: map[string]string{"EnragedFace": `"\U0001f621"`, "GrinningFace": `"\U0001f600"`},
+			expected: &diffReport{
+				RenamedConstants: []renameDiff{{Old: "PoutingFace", New: "EnragedFace", Value: `"\U0001f621"`}},
+			},
+		},
+		{
+			oldConstants: map[string]string{"Melon": `"\U0001f348"`, "ThumbsUp": `"\U0001f44d"`},
+			newConstants: map[string]string{"Grapes": `"\U0001f347"`, "ThumbsUp": `newEmojiWithTone("\U0001f44d@")`},
+			expected: &diffReport{
+				AddedEmojis:   []constantDiff{{Name: "Grapes", Value: `"\U0001f347"`}},
+				RemovedEmojis: []constantDiff{{Name: "Melon", Value: `"\U0001f348"`}},
+				ChangedCodes:  []codeDiff{{Name: "ThumbsUp", Old: `"\U0001f44d"`, New: `newEmojiWithTone("\U0001f44d@")`}},
+			},
+		},
+		{
+			oldAliases: map[string]string{":pout:": "\U0001f621", ":rage:": "\U0001f621", ":melon:": "\U0001f348"},
+			aliases:    map[string]string{":pout:": "\U0001f621", ":rage:": "\U0001f620", ":grapes:": "\U0001f347"},
+			expected: &diffReport{
+				AddedAliases:   []aliasDiff{{Alias: ":grapes:", Code: "\U0001f347"}},
+				RemovedAliases: []aliasDiff{{Alias: ":melon:", Code: "\U0001f348"}},
+				ChangedAliases: []aliasChange{{Alias: ":rage:", Old: "\U0001f621", New: "\U0001f620"}},
+			},
+		},
+	}
+
+	for i, tc := range tt {
+		t.Run(fmt.Sprintf("test #%d", i), func(t *testing.T) {
+			got := compare(tc.oldConstants, tc.newConstants, tc.oldAliases, tc.aliases, nil)
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Fatalf("test case %v fail: got: %+v, expected: %+v", i+1, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestCompareGenerated(t *testing.T) {
+	constants, err := generateConstants(testGroups(t))
+	if err != nil {
+		t.Fatalf("generateConstants() fail: %v", err)
+	}
+	newConstants, err := readConstants([]byte("package emoji\n" + constants.data + "\n" + constants.flags))
+	if err != nil {
+		t.Fatalf("could not read generated constants: %v", err)
+	}
+
+	old := `package emoji
+
+var (
+	GrinningFace            Emoji         = "\U0001f600"
+	GrinningFaceWithBigEyes Emoji         = "\U0001f603"
+	PoutingFace             Emoji         = "\U0001f621"
+	ThumbsUp                EmojiWithTone = newEmojiWithTone("\U0001f44d@")
+	FlagForGermany          Emoji         = "\U0001f1e9\U0001f1ea"
+)
+`
+	oldConstants, err := readConstants([]byte(old))
+	if err != nil {
+		t.Fatalf("could not read old constants: %v", err)
+	}
+
+	got := compare(oldConstants, newConstants, nil, nil, nil)
+	expected := &diffReport{
+		RenamedConstants: []renameDiff{{Old: "PoutingFace", New: "EnragedFace", Value: `"\U0001f621"`}},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Fatalf("got: %+v, expected: %+v", got, expected)
+	}
+}
+
+func TestReadTable(t *testing.T) {
+	src := `package emoji
+
+var emojiMap = &table{parts: []string{aliasData, aliasFlagData}}
+
+const aliasData = "" +
+	":grinning:\x00\U0001f600\x00" +
+	":thumbs_up:\x00\U0001f44d\x00"
+
+const aliasFlagData = ":de:\x00\U0001f1e9\U0001f1ea\x00"
+`
+	got, err := readTable([]byte(src))
+	if err != nil {
+		t.Fatalf("readTable() fail: %v", err)
+	}
+
+	expected := map[string]string{
+		":grinning:":  "\U0001f600",
+		":thumbs_up:": "\U0001f44d",
+		":de:":        "\U0001f1e9\U0001f1ea",
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Fatalf("got: %v, expected: %v", got, expected)
+	}
+
+	if _, err := readTable([]byte("package emoji\n\nconst aliasData = \":grinning:\\x00\"\n")); err == nil {
+		t.Fatal("expected an error for a table without a value")
+	}
+}
+
+func TestReadTableOfPackage(t *testing.T) {
+	if len(emojipkg.Flags) == 0 {
+		t.Skip("flags are not built")
+	}
+
+	for _, tc := range []struct {
+		filename string
+		expected map[string]string
+	}{
+		{filename: aliasesFile, expected: emojipkg.Map()},
+		{filename: reversedFile, expected: emojipkg.ReversedMap()},
+	} {
+		got := make(map[string]string)
+		for _, filename := range []string{tc.filename, flagsFile(tc.filename)} {
+			src, err := ioutil.ReadFile(filepath.Join("..", "..", filename))
+			if err != nil {
+				t.Fatal(err)
+			}
+			table, err := readTable(src)
+			if err != nil {
+				t.Fatalf("readTable(%v) fail: %v", filename, err)
+			}
+			for k, v := range table {
+				got[k] = v
+			}
+		}
+
+		if !reflect.DeepEqual(got, tc.expected) {
+			t.Fatalf("%v: got %d entries, expected %d entries of the package", tc.filename, len(got), len(tc.expected))
+		}
+	}
+}
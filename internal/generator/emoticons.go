@@ -0,0 +1,66 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+)
+
+const emoticonsTable = "internal/generator/emoticons.tsv"
+
+type emoticon struct {
+	Text  string
+	Alias string
+}
+
+// loadEmoticons reads the emoticons table.
+// Each line has an emoticon and the alias of its emoji separated by a tab.
+func loadEmoticons() ([]emoticon, error) {
+	b, err := ioutil.ReadFile(emoticonsTable)
+	if err != nil {
+		return nil, err
+	}
+
+	var emoticons []emoticon
+	var lineErr error
+	parseLine := func(line string) {
+		line = strings.TrimRight(line, "\r\n")
+		if lineErr != nil || line == "" || strings.HasPrefix(line, "#") {
+			return
+		}
+
+		parts := strings.Split(line, "\t")
+		if len(parts) != 2 {
+			lineErr = fmt.Errorf("not valid emoticon line: %q", line)
+			return
+		}
+		emoticons = append(emoticons, emoticon{Text: parts[0], Alias: parts[1]})
+	}
+
+	if err = readLines(b, parseLine); err != nil {
+		return nil, err
+	}
+
+	return emoticons, lineErr
+}
+
+// generateEmoticons maps emoticons to their emojis and emojis to their first emoticons.
+func generateEmoticons(emoticons []emoticon, emojiMap map[string]string) (string, error) {
+	var forward, reversed string
+	seen := make(map[string]bool)
+
+	for _, e := range emoticons {
+		code, ok := emojiMap[e.Alias]
+		if !ok {
+			return "", fmt.Errorf("unknown alias of emoticon %q: %v", e.Text, e.Alias)
+		}
+
+		forward += fmt.Sprintf("%q: %+q,\n", e.Text, code)
+		if !seen[code] {
+			seen[code] = true
+			reversed += fmt.Sprintf("%+q: %q,\n", code, e.Text)
+		}
+	}
+
+	return fmt.Sprintf("emoticonMap = map[string]string{\n%s}\n\nreverseEmoticonMap = map[string]string{\n%s}\n", forward, reversed), nil
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// flagsGroup is the group of the emojis which are left out with the emoji_noflags build tag.
+const flagsGroup = "Flags"
+
+// split is generated data split into the data of the flags and the rest, which are saved to different files.
+// The data of the flags is the declarations of the file of the flags.
+type split struct {
+	data  string
+	flags string
+}
+
+// flagsFile returns the file of the flags of the generated file, e.g. map_flags.go for map.go.
+func flagsFile(filename string) string {
+	return strings.TrimSuffix(filename, ".go") + "_flags.go"
+}
+
+// flagCodes returns the codes of the emojis of the flags group.
+func flagCodes(emojis *groups) map[string]bool {
+	codes := make(map[string]bool)
+	for _, grp := range emojis.Groups {
+		if grp.Name != flagsGroup {
+			continue
+		}
+		for _, subgrp := range grp.Subgroups {
+			for _, c := range subgrp.Constants {
+				for _, e := range subgrp.Emojis[c] {
+					codes[e.Code] = true
+				}
+			}
+		}
+	}
+
+	return codes
+}
+
+// packTables packs the entries of the map into the table of the flags named by the constant and the table
+// of the rest. isFlag checks whether an entry is of a flag.
+func packTables(m map[string]string, constant string, isFlag func(key, value string) bool) split {
+	rest := make(map[string]string)
+	flags := make(map[string]string)
+	for k, v := range m {
+		if isFlag(k, v) {
+			flags[k] = v
+		} else {
+			rest[k] = v
+		}
+	}
+
+	return split{
+		data:  packTable(rest),
+		flags: fmt.Sprintf("const %v = %v\n", constant, packTable(flags)),
+	}
+}
+
+// packTable returns the data of the table of the map, which has an entry of a key and its value per line
+// sorted by the keys.
+func packTable(m map[string]string) string {
+	if len(m) == 0 {
+		return `""`
+	}
+
+	entries := make([]string, 0, len(m))
+	for _, k := range sortedKeys(m) {
+		entries = append(entries, fmt.Sprintf("%+q", k+"\x00"+m[k]+"\x00"))
+	}
+
+	return "\"\" +\n" + strings.Join(entries, " +\n")
+}
+
+// saveSplit writes the generated file, and the file of its flags.
+func (out *output) saveSplit(filename, url string, s split) error {
+	if err := out.save(filename, url, s.data); err != nil {
+		return err
+	}
+
+	return out.saveTemplate(flagsFile(filename), "flags.go", templateData{Link: url, Data: s.flags})
+}
@@ -1,25 +1,27 @@
 package main
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"fmt"
+)
 
-const gemojiURL = "https://raw.githubusercontent.com/github/gemoji/master/db/emoji.json"
+// gemojiVersion is the release of gemoji of the aliases.
+const gemojiVersion = "v4.1.0"
+
+const gemojiURL = "https://raw.githubusercontent.com/github/gemoji/" + gemojiVersion + "/db/emoji.json"
 
 type gemoji struct {
 	Emoji   string   `json:"emoji"`
 	Aliases []string `json:"aliases"`
 }
 
-func fetchGemojis() (map[string]string, error) {
-	b, err := fetchData(gemojiURL)
-	if err != nil {
-		return nil, err
-	}
-
+// parseGemojis parses the aliases of gemoji's emoji.json.
+func parseGemojis(b []byte) (map[string]string, error) {
 	var gemojis []gemoji
 	r := make(map[string]string)
 
-	if err = json.Unmarshal(b, &gemojis); err != nil {
-		return nil, err
+	if err := json.Unmarshal(b, &gemojis); err != nil {
+		return nil, fmt.Errorf("could not parse gemoji: %v", err)
 	}
 
 	for _, gemoji := range gemojis {
@@ -0,0 +1,179 @@
+package main
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// inputSums is the file of the checksums of the inputs.
+const inputSums = "internal/generator/inputs.sum"
+
+// testdataDir is the directory of the vendored inputs.
+const testdataDir = "internal/generator/testdata"
+
+// input is a data file the generator reads from a data directory or a local file, or downloads.
+type input struct {
+	// name is the path of the input in data directories, e.g. "emoji/14.0/emoji-test.txt".
+	name string
+	// url is the pinned URL of the input.
+	url string
+}
+
+var gemojiInput = input{name: "gemoji/" + gemojiVersion + "/emoji.json", url: gemojiURL}
+
+// emojiTestInput returns the input of emoji-test.txt of the Emoji version.
+func emojiTestInput(version string) input {
+	return input{name: fmt.Sprintf("emoji/%v/emoji-test.txt", version), url: emojiListURL(version)}
+}
+
+// cldrInput returns the input of the CLDR annotations of the language in the directory of annotations,
+// "annotations" or "annotationsDerived".
+func cldrInput(dir, lang string) input {
+	return input{name: fmt.Sprintf("cldr/%v/%v/%v.xml", cldrRelease, dir, lang), url: cldrURL(dir, lang)}
+}
+
+// options are the command line options of the generator.
+type options struct {
+	version    string
+	dataDir    string
+	emojiTest  string
+	gemoji     string
+	custom     string
+	sums       string
+	updateSums bool
+	vendor     bool
+	diff       bool
+	json       bool
+	strict     bool
+	check      bool
+}
+
+// read returns the content of the input from the file, the data directory or its URL in that order.
+// The content must match the checksum of the input unless the checksums are being updated.
+// Inputs are always downloaded into the data directory to vendor them.
+func (o options) read(in input, file string, sums checksums) ([]byte, error) {
+	if o.vendor {
+		file = ""
+	} else if file == "" && o.dataDir != "" {
+		file = filepath.Join(o.dataDir, filepath.FromSlash(in.name))
+	}
+
+	var b []byte
+	var err error
+	if file != "" {
+		if b, err = ioutil.ReadFile(file); os.IsNotExist(err) {
+			return nil, fmt.Errorf("could not read %v: %v, run with -vendor to download it", in.name, err)
+		} else if err != nil {
+			return nil, fmt.Errorf("could not read %v: %v", in.name, err)
+		}
+	} else {
+		if b, err = fetchData(in.url); err != nil {
+			return nil, fmt.Errorf("could not download %v: %v", in.url, err)
+		}
+	}
+
+	if o.vendor {
+		if err = writeInput(filepath.Join(o.dataDir, filepath.FromSlash(in.name)), b); err != nil {
+			return nil, fmt.Errorf("could not vendor %v: %v", in.name, err)
+		}
+	}
+
+	sum := fmt.Sprintf("sha256:%x", sha256.Sum256(b))
+	if o.updateSums || o.vendor {
+		sums[in.url] = sum
+		return b, nil
+	}
+
+	want, ok := sums[in.url]
+	switch {
+	case !ok:
+		return nil, fmt.Errorf("no checksum of %v in %v, run with -vendor or -update-sums to pin it", in.url, o.sums)
+	case want != sum:
+		return nil, fmt.Errorf("checksum mismatch of %v: got %v, want %v", in.name, sum, want)
+	}
+
+	return b, nil
+}
+
+// writeInput writes the content of an input to the file, creating its directory.
+func writeInput(filename string, b []byte) error {
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(filename, b, 0644)
+}
+
+var versionRegex = regexp.MustCompile(`^\d+\.\d+$`)
+
+// validate checks whether the options are valid.
+func (o options) validate() error {
+	if !versionRegex.MatchString(o.version) {
+		return fmt.Errorf("not valid emoji version: %q, e.g. %v", o.version, defaultEmojiVersion)
+	}
+	if o.vendor && o.dataDir == "" {
+		return fmt.Errorf("-vendor needs a data directory")
+	}
+
+	return nil
+}
+
+// checksums are the checksums of the inputs by their URLs.
+type checksums map[string]string
+
+// loadSums reads the checksums file. Each line has a URL and its checksum separated by a space.
+// A missing file has no checksums.
+func loadSums(filename string) (checksums, error) {
+	sums := make(checksums)
+	b, err := ioutil.ReadFile(filename)
+	if os.IsNotExist(err) {
+		return sums, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var lineErr error
+	parseLine := func(line string) {
+		line = strings.TrimSpace(line)
+		if lineErr != nil || line == "" || strings.HasPrefix(line, "#") {
+			return
+		}
+
+		parts := strings.Fields(line)
+		if len(parts) != 2 {
+			lineErr = fmt.Errorf("not valid checksum line in %v: %q", filename, line)
+			return
+		}
+		sums[parts[0]] = parts[1]
+	}
+
+	if err = readLines(b, parseLine); err != nil {
+		return nil, err
+	}
+
+	return sums, lineErr
+}
+
+// save writes the checksums file.
+func (c checksums) save(filename string) error {
+	urls := make([]string, 0, len(c))
+	for url := range c {
+		urls = append(urls, url)
+	}
+	sort.Strings(urls)
+
+	var b strings.Builder
+	b.WriteString("# Checksums of the generator inputs, updated with -update-sums.\n")
+	for _, url := range urls {
+		fmt.Fprintf(&b, "%v %v\n", url, c[url])
+	}
+
+	return ioutil.WriteFile(filename, []byte(b.String()), 0644)
+}
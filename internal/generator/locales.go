@@ -0,0 +1,64 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// locales are the languages of localized names and aliases.
+var locales = []string{"de", "ja", "pt"}
+
+func localeFile(lang string) string {
+	return fmt.Sprintf("locale_%v.go", lang)
+}
+
+// generateLocale maps emojis, including skin toned variations, to their localized names, and aliases made of
+// the names to emojis. Skin toned variations have no aliases. If emojis have the same alias, the first one gets it.
+func generateLocale(emojis *groups, names map[string]string) string {
+	var nameLines, aliasLines string
+	seen := make(map[string]bool)
+	for _, grp := range emojis.Groups {
+		for _, subgrp := range grp.Subgroups {
+			for _, c := range subgrp.Constants {
+				for i, e := range subgrp.Emojis[c] {
+					name, ok := names[removeSelectors(e.Code)]
+					if !ok || seen[e.Code] {
+						continue
+					}
+					seen[e.Code] = true
+					nameLines += fmt.Sprintf("%+q: %q,\n", e.Code, name)
+
+					alias := localizedAlias(name)
+					if i > 0 || alias == "" || seen[alias] {
+						continue
+					}
+					seen[alias] = true
+					aliasLines += fmt.Sprintf("%q: %+q,\n", alias, e.Code)
+				}
+			}
+		}
+	}
+
+	return fmt.Sprintf("names: map[string]string{\n%s},\naliases: map[string]string{\n%s},\n", nameLines, aliasLines)
+}
+
+// localizedAlias makes an alias of a localized emoji name, e.g. ":lachelndes_gesicht:" of "lächelndes Gesicht".
+// Latin letters are changed with their basic versions, letters of other scripts are kept.
+func localizedAlias(name string) string {
+	for o, n := range changes {
+		if r, size := utf8.DecodeRuneInString(o); size == len(o) && unicode.IsLetter(r) {
+			name = strings.ReplaceAll(name, o, n)
+		}
+	}
+
+	words := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if len(words) == 0 {
+		return ""
+	}
+
+	return makeAlias(strings.Join(words, "_"))
+}
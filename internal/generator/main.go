@@ -3,166 +3,439 @@ package main
 import (
 	"bufio"
 	"bytes"
+	"flag"
 	"fmt"
 	"go/format"
 	"io"
 	"io/ioutil"
 	"net/http"
 	"os"
-	"sort"
 	"strings"
 	"text/template"
 	"time"
 )
 
 const (
-	constantsFile = "constants.go"
-	aliasesFile   = "map.go"
-	reversedFile  = "reversed_map.go"
+	constantsFile   = "constants.go"
+	aliasesFile     = "map.go"
+	reversedFile    = "reversed_map.go"
+	groupsFile      = "groups.go"
+	emoticonsFile   = "emoticons.go"
+	annotationsFile = "annotations.go"
+	versionsFile    = "versions.go"
 )
 
-// customEmojis is the list of emojis which unicode and gemoji databases don't have.
-var customEmojis = map[string]string{
-	":robot_face:": "\U0001f916", // slack
+func main() {
+	var o options
+	flag.StringVar(&o.version, "version", defaultEmojiVersion, "the Emoji `version` of the data, e.g. 15.1")
+	flag.StringVar(&o.dataDir, "data", testdataDir, "read the inputs from the `dir`ectory, or download them if it's empty")
+	flag.StringVar(&o.emojiTest, "emoji-test", "", "read emoji-test.txt from the `file` instead of downloading it")
+	flag.StringVar(&o.gemoji, "gemoji", "", "read gemoji's emoji.json from the `file` instead of downloading it")
+	flag.StringVar(&o.custom, "custom", customEmojisTable, "the `file` of the custom emojis")
+	flag.StringVar(&o.sums, "sums", inputSums, "the `file` of the checksums of the inputs")
+	flag.BoolVar(&o.updateSums, "update-sums", false, "record the checksums of the inputs instead of verifying them")
+	flag.BoolVar(&o.vendor, "vendor", false, "download the inputs into the data directory and record their checksums")
+	flag.BoolVar(&o.diff, "diff", false, "print the changes of the data instead of generating files")
+	flag.BoolVar(&o.json, "json", false, "print the changes in JSON format")
+	flag.BoolVar(&o.check, "check", false, "fail if the generated files are stale instead of writing them")
+	flag.BoolVar(&o.strict, "strict", false, "fail on alias collisions which are not reviewed")
+	flag.Parse()
+
+	if err := run(o); err != nil {
+		fmt.Fprintf(os.Stderr, "generator: %v\n", err)
+		os.Exit(1)
+	}
 }
 
-func main() {
-	emojis, err := fetchEmojis()
+// run generates all files. Nothing is written if an input is not valid.
+func run(o options) error {
+	if err := o.validate(); err != nil {
+		return err
+	}
+
+	sums, err := loadSums(o.sums)
+	if err != nil {
+		return err
+	}
+
+	emojiTest := emojiTestInput(o.version)
+	b, err := o.read(emojiTest, o.emojiTest, sums)
+	if err != nil {
+		return err
+	}
+	emojis, err := parseEmojis(b)
+	if err != nil {
+		return fmt.Errorf("could not parse %v: %v", emojiTest.name, err)
+	}
+
+	b, err = o.read(gemojiInput, o.gemoji, sums)
+	if err != nil {
+		return err
+	}
+	gemojis, err := parseGemojis(b)
 	if err != nil {
-		panic(err)
+		return err
+	}
+
+	constants, err := generateConstants(emojis)
+	if err != nil {
+		return err
 	}
+	emojiGroups := generateGroups(emojis)
+	flags := flagCodes(emojis)
+	versions := generateVersions(emojis, flags)
 
-	gemojis, err := fetchGemojis()
+	custom, err := loadCustomEmojis(o.custom)
 	if err != nil {
-		panic(err)
+		return err
+	}
+	if err = validateCustomEmojis(o.custom, custom, emojis); err != nil {
+		return err
+	}
+
+	_, keywords, err := o.readAnnotations("en", sums)
+	if err != nil {
+		return err
+	}
+	addCustomKeywords(keywords, custom)
+	annotations := generateAnnotations(emojis, keywords)
+
+	localeData := make(map[string]string, len(locales))
+	for _, lang := range locales {
+		names, _, err := o.readAnnotations(lang, sums)
+		if err != nil {
+			return err
+		}
+		localeData[lang] = generateLocale(emojis, names)
+	}
+
+	// all inputs are read, so the checksums are recorded even if only the changes are printed
+	if o.updateSums || o.vendor {
+		if err = sums.save(o.sums); err != nil {
+			return err
+		}
+	}
+
+	aliases, resolver := generateAliases(emojis, gemojis, custom)
+	fullEmojiMap, collisions := resolver.codes, resolver.sortedCollisions()
+	if err = checkCollisions(collisions); err != nil {
+		if o.strict {
+			return err
+		}
+		fmt.Fprintf(os.Stderr, "generator: warning: %v\n", err)
+	}
+
+	if o.diff {
+		report, err := diff(constants, fullEmojiMap, collisions)
+		if err != nil {
+			return err
+		}
+		if o.json {
+			return report.writeJSON(os.Stdout)
+		}
+		return report.writeText(os.Stdout)
+	}
+
+	current, err := readGenerated(reversedFile, readTable)
+	if err != nil {
+		return err
+	}
+	reversed := generateReversedMap(fullEmojiMap, resolver.sources, current, flags)
+
+	emoticons, err := loadEmoticons()
+	if err != nil {
+		return err
+	}
+
+	emoticonMap, err := generateEmoticons(emoticons, fullEmojiMap)
+	if err != nil {
+		return err
+	}
+
+	out := &output{check: o.check}
+	if err = out.saveSplit(constantsFile, emojiTest.url, constants); err != nil {
+		return err
+	}
+
+	if err = out.saveSplit(groupsFile, emojiTest.url, emojiGroups); err != nil {
+		return err
+	}
+
+	if err = out.saveSplit(versionsFile, emojiTest.url, versions); err != nil {
+		return err
+	}
+
+	if err = out.saveSplit(annotationsFile, cldrURL("annotations", "en"), annotations); err != nil {
+		return err
+	}
+
+	for _, lang := range locales {
+		d := templateData{Link: cldrURL("annotations", lang), Data: localeData[lang], Lang: lang}
+		if err = out.saveTemplate(localeFile(lang), "locale.go", d); err != nil {
+			return err
+		}
+	}
+
+	if err = out.saveSplit(aliasesFile, gemojiURL, aliases); err != nil {
+		return err
+	}
+	if err = out.saveSplit(reversedFile, gemojiURL, reversed); err != nil {
+		return err
+	}
+	if err = out.save(emoticonsFile, emoticonsTable, emoticonMap); err != nil {
+		return err
 	}
 
-	constants := generateConstants(emojis)
-	aliases, fullEmojiMap := generateAliases(emojis, gemojis)
+	if len(out.stale) > 0 {
+		return fmt.Errorf("generated files are stale, run go generate: %v", strings.Join(out.stale, ", "))
+	}
 
-	reversed := generateReversedMap(fullEmojiMap)
+	return nil
+}
 
-	if err = save(constantsFile, emojiListURL, constants); err != nil {
-		panic(err)
+// generateConstants generates the constants of the emojis, and the constants of the groups and subgroups
+// with the emojis of each group. The constants of flags are declared separately.
+func generateConstants(emojis *groups) (split, error) {
+	var res, flags string
+	var slices, flagSlices string
+	names := make(map[string]bool)
+	for _, grp := range emojis.Groups {
+		var s, list string
+		s += fmt.Sprintf("\n// GROUP: %v\n", grp.Name)
+		for _, subgrp := range grp.Subgroups {
+			s += fmt.Sprintf("// SUBGROUP: %v\n", subgrp.Name)
+			for _, c := range subgrp.Constants {
+				constant, err := emojiConstant(subgrp.Emojis[c])
+				if err != nil {
+					return split{}, err
+				}
+				s += constant
+				basic := subgrp.Emojis[c][0]
+				list += fmt.Sprintf("%+q, // %s\n", basic.Code, basic.Name)
+				names[c] = true
+			}
+		}
+
+		name := constantName(grp.Name)
+		slice := fmt.Sprintf("\n// %v are the emojis of the group %v without skin tones.\nvar %v = []Emoji{\n%v}\n",
+			name, grp.Name, name, list)
+		if grp.Name == flagsGroup {
+			flags += s
+			flagSlices += slice
+		} else {
+			res += s
+			slices += slice
+		}
 	}
 
-	if err = save(aliasesFile, gemojiURL, aliases); err != nil {
-		panic(err)
+	grps, err := generateGroupConstants(emojis, names)
+	if err != nil {
+		return split{}, err
 	}
-	if err = save(reversedFile, gemojiURL, reversed); err != nil {
-		panic(err)
+
+	return split{
+		data:  fmt.Sprintf("var (%v)\n%v%v", res, grps, slices),
+		flags: fmt.Sprintf("var (%v)\n%v", flags, flagSlices),
+	}, nil
+}
+
+// generateGroupConstants generates the constants of the groups and subgroups, and their names.
+// The names of the variables of the emojis of the groups must not be used by the emojis.
+func generateGroupConstants(emojis *groups, names map[string]bool) (string, error) {
+	var grps, subgrps, grpNames, subgrpNames, subgrpGroups, grpEmojis string
+	seen := make(map[string]string)
+	for i, grp := range emojis.Groups {
+		name := constantName(grp.Name)
+		if names[name] {
+			return "", fmt.Errorf("emojis of group %v conflict with the emoji %v", grp.Name, name)
+		}
+		constant := "Group" + name
+		if other, ok := seen[constant]; ok {
+			return "", fmt.Errorf("groups %q and %q have the same constant %v", other, grp.Name, constant)
+		}
+		seen[constant] = grp.Name
+
+		typ := ""
+		if i == 0 {
+			typ = " Group = iota"
+		}
+		grps += fmt.Sprintf("%v%v // %v\n", constant, typ, grp.Name)
+		grpNames += fmt.Sprintf("%v: %+q,\n", constant, grp.Name)
+		grpEmojis += fmt.Sprintf("%v: %v,\n", constant, name)
+
+		for _, subgrp := range grp.Subgroups {
+			subconstant := "Subgroup" + constantName(subgrp.Name)
+			if other, ok := seen[subconstant]; ok {
+				return "", fmt.Errorf("subgroups %q and %q have the same constant %v", other, subgrp.Name, subconstant)
+			}
+			seen[subconstant] = subgrp.Name
+
+			typ := ""
+			if subgrps == "" {
+				typ = " Subgroup = iota"
+			}
+			subgrps += fmt.Sprintf("%v%v // %v\n", subconstant, typ, subgrp.Name)
+			subgrpNames += fmt.Sprintf("%v: %+q,\n", subconstant, subgrp.Name)
+			subgrpGroups += fmt.Sprintf("%v: %v,\n", subconstant, constant)
+		}
 	}
+
+	return fmt.Sprintf(`
+// Groups of the emojis.
+const (
+%v)
+
+// Subgroups of the emojis.
+const (
+%v)
+
+var groupNames = [...]string{
+%v}
+
+var subgroupNames = [...]string{
+%v}
+
+var subgroupGroups = [...]Group{
+%v}
+
+var groupEmojis = [...][]Emoji{
+%v}
+`, grps, subgrps, grpNames, subgrpNames, subgrpGroups, grpEmojis), nil
 }
 
-func generateConstants(emojis *groups) string {
-	var res string
+// generateGroups maps every emoji to its group name.
+// Skin toned variations are added with their tones removed.
+func generateGroups(emojis *groups) split {
+	res := make(map[string]string)
 	for _, grp := range emojis.Groups {
-		res += fmt.Sprintf("\n// GROUP: %v\n", grp.Name)
 		for _, subgrp := range grp.Subgroups {
-			res += fmt.Sprintf("// SUBGROUP: %v\n", subgrp.Name)
 			for _, c := range subgrp.Constants {
-				res += emojiConstant(subgrp.Emojis[c])
+				for i, e := range subgrp.Emojis[c] {
+					code := e.Code
+					if i > 0 {
+						code = removeTones(code)
+					}
+					if _, ok := res[code]; !ok {
+						res[code] = grp.Name
+					}
+				}
 			}
 		}
 	}
 
-	return res
+	return packTables(res, "groupFlagData", func(_, grp string) bool {
+		return grp == flagsGroup
+	})
 }
 
-func emojiConstant(emojis []emoji) string {
+// generateVersions maps every emoji, including skin toned variations, to the Emoji version that introduced it.
+func generateVersions(emojis *groups, flags map[string]bool) split {
+	res := make(map[string]string)
+	for _, grp := range emojis.Groups {
+		for _, subgrp := range grp.Subgroups {
+			for _, c := range subgrp.Constants {
+				for _, e := range subgrp.Emojis[c] {
+					res[e.Code] = e.Version
+				}
+			}
+		}
+	}
+
+	return packTables(res, "versionFlagData", func(code, _ string) bool {
+		return flags[code]
+	})
+}
+
+func emojiConstant(emojis []emoji) (string, error) {
 	basic := emojis[0]
 	switch len(emojis) {
 	case 1:
-		return fmt.Sprintf("%s Emoji = %+q // %s\n", basic.Constant, basic.Code, basic.Name)
+		return fmt.Sprintf("%s Emoji = %+q // %s\n", basic.Constant, basic.Code, basic.Name), nil
 	case 6:
 		oneTonedCode := replaceTones(emojis[1].Code)
 		defaultTone := defaultTone(basic.Code, oneTonedCode)
 
 		if defaultTone != "" {
 			return fmt.Sprintf("%s EmojiWithTone = newEmojiWithTone(%+q).withDefaultTone(%+q) // %s\n",
-				basic.Constant, oneTonedCode, defaultTone, basic.Name)
+				basic.Constant, oneTonedCode, defaultTone, basic.Name), nil
 		}
 
 		return fmt.Sprintf("%s EmojiWithTone = newEmojiWithTone(%+q) // %s\n",
-			basic.Constant, oneTonedCode, basic.Name)
+			basic.Constant, oneTonedCode, basic.Name), nil
 	case 20:
 		oneTonedCode := replaceTones(emojis[1].Code)
 		twoTonedCode := replaceTones(emojis[2].Code)
 
 		return fmt.Sprintf("%s EmojiWithTone = newEmojiWithTone(%+q, %+q) // %s\n",
-			basic.Constant, oneTonedCode, twoTonedCode, basic.Name)
+			basic.Constant, oneTonedCode, twoTonedCode, basic.Name), nil
 	case 26:
 		oneTonedCode := replaceTones(emojis[1].Code)
 		twoTonedCode := replaceTones(emojis[2].Code)
 
 		return fmt.Sprintf("%s EmojiWithTone = newEmojiWithTone(%+q, %+q) // %s\n",
-			basic.Constant, oneTonedCode, twoTonedCode, basic.Name)
+			basic.Constant, oneTonedCode, twoTonedCode, basic.Name), nil
 
 	default:
-		panic(fmt.Errorf("not expected emoji count for a constant: %v", len(emojis)))
+		return "", fmt.Errorf("not expected emoji count for constant %v: %v", basic.Constant, len(emojis))
 	}
 }
 
-func generateAliases(emojis *groups, gemojis map[string]string) (string, map[string]string) {
-	var aliases []string
-	var emojiMap = make(map[string]string)
-
+// generateAliases generates the aliases of the emojis from the constant names, gemoji and the custom emojis.
+// Collisions of the aliases are resolved by aliasPrecedence.
+func generateAliases(emojis *groups, gemojis map[string]string, custom []customEmoji) (split, *aliasResolver) {
+	r := newAliasResolver()
 	for _, grp := range emojis.Groups {
 		for _, subgrp := range grp.Subgroups {
 			for _, c := range subgrp.Constants {
 				emoji := subgrp.Emojis[c][0]
-				alias := makeAlias(snakeCase(emoji.Constant))
-				aliases = append(aliases, alias)
-				emojiMap[alias] = emoji.Code
+				r.add(makeAlias(snakeCase(emoji.Constant)), emoji.Code, unicodeSource)
 			}
 		}
 	}
 
 	// add gemoji aliases
-	{
-		for alias, code := range gemojis {
-			_, ok := emojiMap[alias]
-			if !ok {
-				aliases = append(aliases, alias)
-			}
-			emojiMap[alias] = code
-		}
+	for _, alias := range sortedKeys(gemojis) {
+		r.add(alias, gemojis[alias], gemojiSource)
 	}
 
 	// add custom emoji aliases
-	{
-		for alias, code := range customEmojis {
-			_, ok := emojiMap[alias]
-			if !ok {
-				aliases = append(aliases, alias)
-			}
-			emojiMap[alias] = code
-		}
+	for _, c := range custom {
+		r.add(c.Alias, c.Code, customSource)
 	}
 
-	var r string
-	sort.Strings(aliases)
-	for _, alias := range aliases {
-		r += fmt.Sprintf("%q: %+q,\n", alias, emojiMap[alias])
-	}
+	flags := flagCodes(emojis)
+	return packTables(r.codes, "aliasFlagData", func(_, code string) bool {
+		return flags[code]
+	}), r
+}
+
+// templateData is the data of generated file templates.
+type templateData struct {
+	Link string
+	Date string
+	Data string
+	Lang string
+}
+
+// output writes the generated files, or compares them with the existing files in check mode.
+type output struct {
+	check bool
+	// stale are the existing files which are different from the generated ones in check mode.
+	stale []string
+}
 
-	return r, emojiMap
+func (out *output) save(filename, url, data string) error {
+	return out.saveTemplate(filename, filename, templateData{Link: url, Data: data})
 }
-func save(filename, url, data string) error {
-	tmpl, err := template.ParseFiles(fmt.Sprintf("internal/generator/%v.tmpl", filename))
+
+// saveTemplate writes the file generated from the template with the given name.
+func (out *output) saveTemplate(filename, tmplName string, d templateData) error {
+	tmpl, err := template.ParseFiles(fmt.Sprintf("internal/generator/%v.tmpl", tmplName))
 	if err != nil {
 		return err
 	}
 
-	d := struct {
-		Link string
-		Date string
-		Data string
-	}{
-		Link: url,
-		Date: time.Now().Format(time.RFC3339),
-		Data: data,
-	}
+	d.Date = time.Now().Format(time.RFC3339)
 
 	var w bytes.Buffer
 	if err = tmpl.Execute(&w, d); err != nil {
@@ -174,6 +447,14 @@ func save(filename, url, data string) error {
 		return fmt.Errorf("could not format file: %v", err)
 	}
 
+	if out.check {
+		existing, err := ioutil.ReadFile(filename)
+		if err != nil || withoutDate(existing) != withoutDate(content) {
+			out.stale = append(out.stale, filename)
+		}
+		return nil
+	}
+
 	file, err := os.Create(filename)
 	if err != nil {
 		return fmt.Errorf("could not create file: %v", err)
@@ -186,6 +467,18 @@ func save(filename, url, data string) error {
 	return nil
 }
 
+// withoutDate removes the generation date of the generated file, which changes on every run.
+func withoutDate(b []byte) string {
+	lines := strings.Split(string(b), "\n")
+	for i, line := range lines {
+		if strings.HasPrefix(line, "// Create at: ") {
+			return strings.Join(append(lines[:i:i], lines[i+1:]...), "\n")
+		}
+	}
+
+	return string(b)
+}
+
 func fetchData(url string) ([]byte, error) {
 	resp, err := http.Get(url)
 	if err != nil {
@@ -227,29 +520,44 @@ func readLines(b []byte, fn func(string)) error {
 	return nil
 }
 
-func generateReversedMap(gemojis map[string]string) string {
-	m := reverseMap(gemojis)
-	custom := reverseMap(customEmojis)
-	for k, v := range custom {
-		m[k] = v
+// generateReversedMap maps every emoji to one of its aliases. The current alias of an emoji is kept as long as
+// it's still an alias of the emoji, so regenerating doesn't change Deparse. Other emojis get their shortest
+// alias of gemoji, or their custom alias, or the alias of their constant name.
+func generateReversedMap(aliases, sources, current map[string]string, flags map[string]bool) split {
+	reversed := make(map[string]string)
+	for _, alias := range sortedKeys(aliases) {
+		code := aliases[alias]
+		prev, ok := reversed[code]
+		if !ok || preferredAlias(alias, prev, sources) {
+			reversed[code] = alias
+		}
 	}
-	var r string
-	for k, v := range m {
-		r += fmt.Sprintf("%+q: %+q,\n", k, v)
-
+	for code, alias := range current {
+		if _, ok := reversed[code]; ok && aliases[alias] == code {
+			reversed[code] = alias
+		}
 	}
-	return r
 
+	return packTables(reversed, "reverseFlagData", func(code, _ string) bool {
+		return flags[code]
+	})
 }
 
-func reverseMap(m map[string]string) map[string]string {
-	n := make(map[string]string)
-	for k, v := range m {
-		n[v] = k
-		if strings.Contains(v, "\u20e3") { // it is an emoji number e.g 7️⃣ or 4️⃣
-			alternateName := v[0:1] // select just the number
-			n[alternateName] = k
-		}
+// reverseAliasPrecedence is the precedence of the sources of aliases in the reversed map.
+var reverseAliasPrecedence = map[string]int{
+	unicodeSource: 0,
+	customSource:  1,
+	gemojiSource:  2,
+}
+
+// preferredAlias checks whether the alias is preferred over the other alias of the same emoji.
+func preferredAlias(alias, other string, sources map[string]string) bool {
+	if p, o := reverseAliasPrecedence[sources[alias]], reverseAliasPrecedence[sources[other]]; p != o {
+		return p > o
 	}
-	return n
+	if len(alias) != len(other) {
+		return len(alias) < len(other)
+	}
+
+	return alias < other
 }
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"testing"
+)
+
+// testEmojiTest is an excerpt of emoji-test.txt with a renamed emoji, an emoji with skin tones and a flag.
+const testEmojiTest = `# emoji-test.txt
+# Version: 14.0
+
+# group: Smileys & Emotion
+
+# subgroup: face-smiling
+1F600                                                  ; fully-qualified     # 😀 E1.0 grinning face
+1F603                                                  ; fully-qualified     # 😃 E0.6 grinning face with big eyes
+
+# subgroup: face-negative
+1F621                                                  ; fully-qualified     # 😡 E0.6 enraged face
+
+# group: People & Body
+
+# subgroup: hand-fingers-closed
+1F44D                                                  ; fully-qualified     # 👍 E0.6 thumbs up
+1F44D 1F3FB                                            ; fully-qualified     # 👍🏻 E1.0 thumbs up: light skin tone
+1F44D 1F3FC                                            ; fully-qualified     # 👍🏼 E1.0 thumbs up: medium-light skin tone
+1F44D 1F3FD                                            ; fully-qualified     # 👍🏽 E1.0 thumbs up: medium skin tone
+1F44D 1F3FE                                            ; fully-qualified     # 👍🏾 E1.0 thumbs up: medium-dark skin tone
+1F44D 1F3FF                                            ; fully-qualified     # 👍🏿 E1.0 thumbs up: dark skin tone
+
+# group: Flags
+
+# subgroup: country-flag
+1F1E9 1F1EA                                            ; fully-qualified     # 🇩🇪 E2.0 flag: Germany
+`
+
+// testGemoji is in the format of gemoji's emoji.json. The alias of 😃 collides with the constant name of 😀.
+const testGemoji = `[
+	{"emoji": "😀", "aliases": ["grinning"]},
+	{"emoji": "😡", "aliases": ["rage", "pout"]},
+	{"emoji": "👍", "aliases": ["+1", "thumbsup"]},
+	{"emoji": "🇩🇪", "aliases": ["de"]},
+	{"emoji": "😃", "aliases": ["grinning_face"]}
+]`
+
+// testGroups returns the emojis of testEmojiTest.
+func testGroups(t *testing.T) *groups {
+	t.Helper()
+
+	emojis, err := parseEmojis([]byte(testEmojiTest))
+	if err != nil {
+		t.Fatalf("could not parse emoji-test.txt: %v", err)
+	}
+
+	return emojis
+}
+
+// testGemojis returns the aliases of testGemoji.
+func testGemojis(t *testing.T) map[string]string {
+	t.Helper()
+
+	gemojis, err := parseGemojis([]byte(testGemoji))
+	if err != nil {
+		t.Fatalf("could not parse gemoji: %v", err)
+	}
+
+	return gemojis
+}
@@ -106,6 +106,11 @@ func clean(str string) string {
 	return str
 }
 
+// constantName converts the name to the name of a Go constant, e.g. "Smileys & Emotion" to SmileysAndEmotion.
+func constantName(name string) string {
+	return removeSpaces(strings.Title(strings.ToLower(clean(name))))
+}
+
 // removeSpaces removes consecutive whitespaces.
 func removeSpaces(str string) string {
 	return whitespaceRegex.ReplaceAllString(str, "")
@@ -9,41 +9,68 @@ import (
 	emojipkg "github.com/AkinAD/emoji"
 )
 
-const emojiListURL = "https://unicode.org/Public/emoji/14.0/emoji-test.txt"
+// defaultEmojiVersion is the Emoji version of the generated data.
+const defaultEmojiVersion = "14.0"
+
+// emojiListURL returns the URL of emoji-test.txt of the Emoji version, e.g. "15.1".
+func emojiListURL(version string) string {
+	return fmt.Sprintf("https://unicode.org/Public/emoji/%v/emoji-test.txt", version)
+}
 
 var (
-	emojiRegex = regexp.MustCompile(`^(?m)(?P<code>[A-Z\d ]+[A-Z\d])\s+;\s+(fully-qualified|component)\s+#\s+.+\s+E\d+\.\d+ (?P<name>.+)$`)
+	emojiRegex = regexp.MustCompile(`^(?m)(?P<code>[A-Z\d ]+[A-Z\d])\s+;\s+(fully-qualified|component)\s+#\s+.+\s+E(?P<version>\d+\.\d+) (?P<name>.+)$`)
 	toneRegex  = regexp.MustCompile(`:\s.*tone,?`)
 )
 
-func fetchEmojis() (*groups, error) {
+// parseEmojis parses the emojis of emoji-test.txt.
+func parseEmojis(b []byte) (*groups, error) {
 	var emojis groups
-	b, err := fetchData(emojiListURL)
-	if err != nil {
-		return nil, err
-	}
-
 	var grp *group
 	var subgrp *subgroup
+	var lineErr error
 
 	parseLine := func(line string) {
+		if lineErr != nil {
+			return
+		}
+
 		switch {
 		case strings.HasPrefix(line, "# group:"):
 			name := strings.TrimSpace(strings.ReplaceAll(line, "# group:", ""))
 			grp = emojis.Append(name)
 		case strings.HasPrefix(line, "# subgroup:"):
+			if grp == nil {
+				lineErr = fmt.Errorf("subgroup without group: %q", line)
+				return
+			}
 			name := strings.TrimSpace(strings.ReplaceAll(line, "# subgroup:", ""))
 			subgrp = grp.Append(name)
 		case !strings.HasPrefix(line, "#"):
-			if e := newEmoji(line); e != nil {
-				subgrp.Append(*e)
+			e, err := newEmoji(line)
+			if err != nil {
+				lineErr = err
+				return
+			}
+			if e == nil {
+				return
+			}
+			if subgrp == nil {
+				lineErr = fmt.Errorf("emoji without subgroup: %q", line)
+				return
 			}
+			subgrp.Append(*e)
 		}
 	}
 
-	if err = readLines(b, parseLine); err != nil {
+	if err := readLines(b, parseLine); err != nil {
 		return nil, err
 	}
+	if lineErr != nil {
+		return nil, lineErr
+	}
+	if len(emojis.Groups) == 0 {
+		return nil, fmt.Errorf("no emoji groups found, is it emoji-test.txt?")
+	}
 
 	return &emojis, nil
 }
@@ -93,6 +120,7 @@ type emoji struct {
 	Name     string
 	Constant string
 	Code     string
+	Version  string
 	Tones    []string
 }
 
@@ -100,25 +128,29 @@ func (e *emoji) String() string {
 	return fmt.Sprintf("name:%v, constant:%v, code:%v, tones: %v\n", e.Name, e.Constant, e.Code, e.Tones)
 }
 
-func newEmoji(line string) *emoji {
+func newEmoji(line string) (*emoji, error) {
 	matches := emojiRegex.FindStringSubmatch(line)
-	if len(matches) < 4 {
-		return nil
+	if len(matches) < 5 {
+		return nil, nil
 	}
 	code := matches[1]
-	name := matches[3]
+	version := matches[3]
+	name := matches[4]
 
 	e := emoji{
 		Name:     name,
 		Constant: name,
 		Code:     code,
+		Version:  version,
 		Tones:    []string{},
 	}
 	e.extractAttr()
 	e.generateConstant()
-	e.generateUnicode()
+	if err := e.generateUnicode(); err != nil {
+		return nil, err
+	}
 
-	return &e
+	return &e, nil
 }
 
 func (e *emoji) extractAttr() {
@@ -148,24 +180,31 @@ func (e *emoji) extractAttr() {
 }
 
 func (e *emoji) generateConstant() {
-	c := clean(e.Constant)
-	c = strings.Title(strings.ToLower(c))
-	c = removeSpaces(c)
+	e.Constant = constantName(e.Constant)
+}
 
-	e.Constant = c
+func (e *emoji) generateUnicode() error {
+	code, err := parseCodePoints(e.Code)
+	if err != nil {
+		return fmt.Errorf("unknown unicode of %v: %v", e.Name, err)
+	}
+
+	e.Code = code
+	return nil
 }
 
-func (e *emoji) generateUnicode() {
-	unicodes := []string{}
-	for _, v := range strings.Split(e.Code, " ") {
+// parseCodePoints returns the string of the code points separated by spaces, e.g. "1F44D 1F3FD".
+func parseCodePoints(s string) (string, error) {
+	var b strings.Builder
+	for _, v := range strings.Fields(s) {
 		u, err := strconv.ParseInt(v, 16, 32)
 		if err != nil {
-			panic(fmt.Errorf("unknown unicode: %v", v))
+			return "", fmt.Errorf("not valid code point: %v", v)
 		}
-		unicodes = append(unicodes, string(u))
+		b.WriteRune(rune(u))
 	}
 
-	e.Code = strings.Join(unicodes, "")
+	return b.String(), nil
 }
 
 func defaultTone(basic, toned string) string {
@@ -196,3 +235,7 @@ func replaceTones(code string) string {
 
 	return code
 }
+
+func removeTones(code string) string {
+	return strings.ReplaceAll(replaceTones(code), emojipkg.TonePlaceholder, "")
+}
@@ -0,0 +1,58 @@
+package emoji
+
+import (
+	"sort"
+	"strings"
+)
+
+// locale has the localized names and aliases of emojis.
+type locale struct {
+	names   map[string]string
+	aliases map[string]string
+}
+
+// locales are the built-in locales by their languages. Generated locale files register them,
+// which are left out with the emoji_nolocale build tag, or emoji_nolocale_<lang> for one language.
+var locales = make(map[string]*locale)
+
+// Locales returns the languages of the built-in locales, e.g. "de".
+func Locales() []string {
+	langs := make([]string, 0, len(locales))
+	for lang := range locales {
+		langs = append(langs, lang)
+	}
+	sort.Strings(langs)
+
+	return langs
+}
+
+// Name returns the name of the emoji in the language, e.g. "Daumen hoch" for 👍 in "de".
+// The language can have a region, e.g. "de-AT". It returns the English name if the language
+// or the localized name is not available, and an empty string for unknown emojis.
+func Name(code, lang string) string {
+	for _, c := range []string{code, toneRegex.ReplaceAllString(code, "")} {
+		if l := findLocale(lang); l != nil {
+			if name, ok := l.names[c]; ok {
+				return name
+			}
+		}
+		if a, ok := annotationOf(c); ok {
+			return a.name
+		}
+	}
+
+	return ""
+}
+
+// findLocale returns the locale of the language, or nil if there is no such locale.
+func findLocale(lang string) *locale {
+	lang = strings.ToLower(lang)
+	if l, ok := locales[lang]; ok {
+		return l
+	}
+	if i := strings.IndexAny(lang, "-_"); i > 0 {
+		return locales[lang[:i]]
+	}
+
+	return nil
+}
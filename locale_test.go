@@ -0,0 +1,62 @@
+package emoji
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestName(t *testing.T) {
+	tt := []struct {
+		code     string
+		lang     string
+		expected string
+	}{
+		{code: "👍", lang: "de", expected: "Daumen hoch"},
+		{code: "👍🏽", lang: "de-AT", expected: "Daumen hoch"},
+		{code: "❤️", lang: "pt_BR", expected: "coração vermelho"},
+		{code: "🍕", lang: "ja", expected: "ピザ"},
+		{code: "👍", lang: "xx", expected: "thumbs up"},
+		{code: "👍", lang: "", expected: "thumbs up"},
+		{code: "a", lang: "de", expected: ""},
+	}
+
+	for i, tc := range tt {
+		t.Run(fmt.Sprintf("test #%d", i), func(t *testing.T) {
+			if tc.lang != "" && tc.lang != "xx" && findLocale(tc.lang) == nil {
+				t.Skipf("locale %v is not built", tc.lang)
+			}
+			if tc.expected != "" && len(emojiAnnotations) == 0 {
+				t.Skip("names are not built")
+			}
+
+			if got := Name(tc.code, tc.lang); got != tc.expected {
+				t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestReplacerWithLocale(t *testing.T) {
+	tt := []struct {
+		lang     string
+		input    string
+		expected string
+	}{
+		{lang: "de", input: ":daumen_hoch: :thumbs_up:", expected: "👍 👍"},
+		{lang: "de", input: ":gesicht_mit_freudentranen:", expected: "😂"},
+		{lang: "pt", input: ":maos_aplaudindo: :daumen_hoch:", expected: "👏 :daumen_hoch:"},
+		{lang: "ja", input: "こんにちは:ピザ:", expected: "こんにちは🍕"},
+	}
+
+	for i, tc := range tt {
+		t.Run(fmt.Sprintf("test #%d", i), func(t *testing.T) {
+			if findLocale(tc.lang) == nil {
+				t.Skipf("locale %v is not built", tc.lang)
+			}
+
+			if got := NewReplacer(WithLocale(tc.lang)).Replace(tc.input); got != tc.expected {
+				t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got, tc.expected)
+			}
+		})
+	}
+}
@@ -2,2762 +2,2231 @@ package emoji
 
 // Code generated by github.com/AkinAD/emoji/internal/generator DO NOT EDIT.
 
-// Source: https://raw.githubusercontent.com/github/gemoji/master/db/emoji.json
-// Create at: 2022-01-13T21:03:21-05:00
+// Source: https://raw.githubusercontent.com/github/gemoji/v4.1.0/db/emoji.json
+// Create at: 2026-10-19T05:48:25Z
 
-var emojiMap = map[string]string{
-	":+1:":                               "\U0001f44d",
-	":-1:":                               "\U0001f44e",
-	":100:":                              "\U0001f4af",
-	":1234:":                             "\U0001f522",
-	":1st_place_medal:":                  "\U0001f947",
-	":2nd_place_medal:":                  "\U0001f948",
-	":3rd_place_medal:":                  "\U0001f949",
-	":8ball:":                            "\U0001f3b1",
-	":a:":                                "\U0001f170\ufe0f",
-	":a_button_blood_type:":              "\U0001f170\ufe0f",
-	":ab:":                               "\U0001f18e",
-	":ab_button_blood_type:":             "\U0001f18e",
-	":abacus:":                           "\U0001f9ee",
-	":abc:":                              "\U0001f524",
-	":abcd:":                             "\U0001f521",
-	":accept:":                           "\U0001f251",
-	":accordion:":                        "\U0001fa97",
-	":adhesive_bandage:":                 "\U0001fa79",
-	":admission_tickets:":                "\U0001f39f\ufe0f",
-	":adult:":                            "\U0001f9d1",
-	":aerial_tramway:":                   "\U0001f6a1",
-	":afghanistan:":                      "\U0001f1e6\U0001f1eb",
-	":airplane:":                         "\u2708\ufe0f",
-	":airplane_arrival:":                 "\U0001f6ec",
-	":airplane_departure:":               "\U0001f6eb",
-	":aland_islands:":                    "\U0001f1e6\U0001f1fd",
-	":alarm_clock:":                      "\u23f0",
-	":albania:":                          "\U0001f1e6\U0001f1f1",
-	":alembic:":                          "\u2697\ufe0f",
-	":algeria:":                          "\U0001f1e9\U0001f1ff",
-	":alien:":                            "\U0001f47d",
-	":alien_monster:":                    "\U0001f47e",
-	":ambulance:":                        "\U0001f691",
-	":american_football:":                "\U0001f3c8",
-	":american_samoa:":                   "\U0001f1e6\U0001f1f8",
-	":amphora:":                          "\U0001f3fa",
-	":anatomical_heart:":                 "\U0001fac0",
-	":anchor:":                           "\u2693",
-	":andorra:":                          "\U0001f1e6\U0001f1e9",
-	":angel:":                            "\U0001f47c",
-	":anger:":                            "\U0001f4a2",
-	":anger_symbol:":                     "\U0001f4a2",
-	":angola:":                           "\U0001f1e6\U0001f1f4",
-	":angry:":                            "\U0001f620",
-	":angry_face:":                       "\U0001f620",
-	":angry_face_with_horns:":            "\U0001f47f",
-	":anguilla:":                         "\U0001f1e6\U0001f1ee",
-	":anguished:":                        "\U0001f627",
-	":anguished_face:":                   "\U0001f627",
-	":ant:":                              "\U0001f41c",
-	":antarctica:":                       "\U0001f1e6\U0001f1f6",
-	":antenna_bars:":                     "\U0001f4f6",
-	":antigua_barbuda:":                  "\U0001f1e6\U0001f1ec",
-	":anxious_face_with_sweat:":          "\U0001f630",
-	":apple:":                            "\U0001f34e",
-	":aquarius:":                         "\u2652",
-	":argentina:":                        "\U0001f1e6\U0001f1f7",
-	":aries:":                            "\u2648",
-	":armenia:":                          "\U0001f1e6\U0001f1f2",
-	":arrow_backward:":                   "\u25c0\ufe0f",
-	":arrow_double_down:":                "\u23ec",
-	":arrow_double_up:":                  "\u23eb",
-	":arrow_down:":                       "\u2b07\ufe0f",
-	":arrow_down_small:":                 "\U0001f53d",
-	":arrow_forward:":                    "\u25b6\ufe0f",
-	":arrow_heading_down:":               "\u2935\ufe0f",
-	":arrow_heading_up:":                 "\u2934\ufe0f",
-	":arrow_left:":                       "\u2b05\ufe0f",
-	":arrow_lower_left:":                 "\u2199\ufe0f",
-	":arrow_lower_right:":                "\u2198\ufe0f",
-	":arrow_right:":                      "\u27a1\ufe0f",
-	":arrow_right_hook:":                 "\u21aa\ufe0f",
-	":arrow_up:":                         "\u2b06\ufe0f",
-	":arrow_up_down:":                    "\u2195\ufe0f",
-	":arrow_up_small:":                   "\U0001f53c",
-	":arrow_upper_left:":                 "\u2196\ufe0f",
-	":arrow_upper_right:":                "\u2197\ufe0f",
-	":arrows_clockwise:":                 "\U0001f503",
-	":arrows_counterclockwise:":          "\U0001f504",
-	":art:":                              "\U0001f3a8",
-	":articulated_lorry:":                "\U0001f69b",
-	":artificial_satellite:":             "\U0001f6f0\ufe0f",
-	":artist:":                           "\U0001f9d1\u200d\U0001f3a8",
-	":artist_palette:":                   "\U0001f3a8",
-	":aruba:":                            "\U0001f1e6\U0001f1fc",
-	":ascension_island:":                 "\U0001f1e6\U0001f1e8",
-	":asterisk:":                         "*\ufe0f\u20e3",
-	":astonished:":                       "\U0001f632",
-	":astonished_face:":                  "\U0001f632",
-	":astronaut:":                        "\U0001f9d1\u200d\U0001f680",
-	":athletic_shoe:":                    "\U0001f45f",
-	":atm:":                              "\U0001f3e7",
-	":atm_sign:":                         "\U0001f3e7",
-	":atom_symbol:":                      "\u269b\ufe0f",
-	":australia:":                        "\U0001f1e6\U0001f1fa",
-	":austria:":                          "\U0001f1e6\U0001f1f9",
-	":auto_rickshaw:":                    "\U0001f6fa",
-	":automobile:":                       "\U0001f697",
-	":avocado:":                          "\U0001f951",
-	":axe:":                              "\U0001fa93",
-	":azerbaijan:":                       "\U0001f1e6\U0001f1ff",
-	":b:":                                "\U0001f171\ufe0f",
-	":b_button_blood_type:":              "\U0001f171\ufe0f",
-	":baby:":                             "\U0001f476",
-	":baby_angel:":                       "\U0001f47c",
-	":baby_bottle:":                      "\U0001f37c",
-	":baby_chick:":                       "\U0001f424",
-	":baby_symbol:":                      "\U0001f6bc",
-	":back:":                             "\U0001f519",
-	":back_arrow:":                       "\U0001f519",
-	":backhand_index_pointing_down:":     "\U0001f447",
-	":backhand_index_pointing_left:":     "\U0001f448",
-	":backhand_index_pointing_right:":    "\U0001f449",
-	":backhand_index_pointing_up:":       "\U0001f446",
-	":backpack:":                         "\U0001f392",
-	":bacon:":                            "\U0001f953",
-	":badger:":                           "\U0001f9a1",
-	":badminton:":                        "\U0001f3f8",
-	":bagel:":                            "\U0001f96f",
-	":baggage_claim:":                    "\U0001f6c4",
-	":baguette_bread:":                   "\U0001f956",
-	":bahamas:":                          "\U0001f1e7\U0001f1f8",
-	":bahrain:":                          "\U0001f1e7\U0001f1ed",
-	":balance_scale:":                    "\u2696\ufe0f",
-	":bald:":                             "\U0001f9b2",
-	":bald_man:":                         "\U0001f468\u200d\U0001f9b2",
-	":bald_woman:":                       "\U0001f469\u200d\U0001f9b2",
-	":ballet_shoes:":                     "\U0001fa70",
-	":balloon:":                          "\U0001f388",
-	":ballot_box:":                       "\U0001f5f3\ufe0f",
-	":ballot_box_with_ballot:":           "\U0001f5f3\ufe0f",
-	":ballot_box_with_check:":            "\u2611\ufe0f",
-	":bamboo:":                           "\U0001f38d",
-	":banana:":                           "\U0001f34c",
-	":bangbang:":                         "\u203c\ufe0f",
-	":bangladesh:":                       "\U0001f1e7\U0001f1e9",
-	":banjo:":                            "\U0001fa95",
-	":bank:":                             "\U0001f3e6",
-	":bar_chart:":                        "\U0001f4ca",
-	":barbados:":                         "\U0001f1e7\U0001f1e7",
-	":barber:":                           "\U0001f488",
-	":barber_pole:":                      "\U0001f488",
-	":baseball:":                         "\u26be",
-	":basket:":                           "\U0001f9fa",
-	":basketball:":                       "\U0001f3c0",
-	":basketball_man:":                   "\u26f9\ufe0f\u200d\u2642\ufe0f",
-	":basketball_woman:":                 "\u26f9\ufe0f\u200d\u2640\ufe0f",
-	":bat:":                              "\U0001f987",
-	":bath:":                             "\U0001f6c0",
-	":bathtub:":                          "\U0001f6c1",
-	":battery:":                          "\U0001f50b",
-	":beach_umbrella:":                   "\U0001f3d6\ufe0f",
-	":beach_with_umbrella:":              "\U0001f3d6\ufe0f",
-	":beaming_face_with_smiling_eyes:":   "\U0001f601",
-	":beans:":                            "\U0001fad8",
-	":bear:":                             "\U0001f43b",
-	":bearded_person:":                   "\U0001f9d4",
-	":beating_heart:":                    "\U0001f493",
-	":beaver:":                           "\U0001f9ab",
-	":bed:":                              "\U0001f6cf\ufe0f",
-	":bee:":                              "\U0001f41d",
-	":beer:":                             "\U0001f37a",
-	":beer_mug:":                         "\U0001f37a",
-	":beers:":                            "\U0001f37b",
-	":beetle:":                           "\U0001fab2",
-	":beginner:":                         "\U0001f530",
-	":belarus:":                          "\U0001f1e7\U0001f1fe",
-	":belgium:":                          "\U0001f1e7\U0001f1ea",
-	":belize:":                           "\U0001f1e7\U0001f1ff",
-	":bell:":                             "\U0001f514",
-	":bell_pepper:":                      "\U0001fad1",
-	":bell_with_slash:":                  "\U0001f515",
-	":bellhop_bell:":                     "\U0001f6ce\ufe0f",
-	":benin:":                            "\U0001f1e7\U0001f1ef",
-	":bento:":                            "\U0001f371",
-	":bento_box:":                        "\U0001f371",
-	":bermuda:":                          "\U0001f1e7\U0001f1f2",
-	":beverage_box:":                     "\U0001f9c3",
-	":bhutan:":                           "\U0001f1e7\U0001f1f9",
-	":bicycle:":                          "\U0001f6b2",
-	":bicyclist:":                        "\U0001f6b4",
-	":bike:":                             "\U0001f6b2",
-	":biking_man:":                       "\U0001f6b4\u200d\u2642\ufe0f",
-	":biking_woman:":                     "\U0001f6b4\u200d\u2640\ufe0f",
-	":bikini:":                           "\U0001f459",
-	":billed_cap:":                       "\U0001f9e2",
-	":biohazard:":                        "\u2623\ufe0f",
-	":bird:":                             "\U0001f426",
-	":birthday:":                         "\U0001f382",
-	":birthday_cake:":                    "\U0001f382",
-	":bison:":                            "\U0001f9ac",
-	":biting_lip:":                       "\U0001fae6",
-	":black_cat:":                        "\U0001f408\u200d\u2b1b",
-	":black_circle:":                     "\u26ab",
-	":black_flag:":                       "\U0001f3f4",
-	":black_heart:":                      "\U0001f5a4",
-	":black_joker:":                      "\U0001f0cf",
-	":black_large_square:":               "\u2b1b",
-	":black_medium_small_square:":        "\u25fe",
-	":black_medium_square:":              "\u25fc\ufe0f",
-	":black_nib:":                        "\u2712\ufe0f",
-	":black_small_square:":               "\u25aa\ufe0f",
-	":black_square_button:":              "\U0001f532",
-	":blond_haired_man:":                 "\U0001f471\u200d\u2642\ufe0f",
-	":blond_haired_person:":              "\U0001f471",
-	":blond_haired_woman:":               "\U0001f471\u200d\u2640\ufe0f",
-	":blonde_woman:":                     "\U0001f471\u200d\u2640\ufe0f",
-	":blossom:":                          "\U0001f33c",
-	":blowfish:":                         "\U0001f421",
-	":blue_book:":                        "\U0001f4d8",
-	":blue_car:":                         "\U0001f699",
-	":blue_circle:":                      "\U0001f535",
-	":blue_heart:":                       "\U0001f499",
-	":blue_square:":                      "\U0001f7e6",
-	":blueberries:":                      "\U0001fad0",
-	":blush:":                            "\U0001f60a",
-	":boar:":                             "\U0001f417",
-	":boat:":                             "\u26f5",
-	":bolivia:":                          "\U0001f1e7\U0001f1f4",
-	":bomb:":                             "\U0001f4a3",
-	":bone:":                             "\U0001f9b4",
-	":book:":                             "\U0001f4d6",
-	":bookmark:":                         "\U0001f516",
-	":bookmark_tabs:":                    "\U0001f4d1",
-	":books:":                            "\U0001f4da",
-	":boom:":                             "\U0001f4a5",
-	":boomerang:":                        "\U0001fa83",
-	":boot:":                             "\U0001f462",
-	":bosnia_herzegovina:":               "\U0001f1e7\U0001f1e6",
-	":botswana:":                         "\U0001f1e7\U0001f1fc",
-	":bottle_with_popping_cork:":         "\U0001f37e",
-	":bouncing_ball_man:":                "\u26f9\ufe0f\u200d\u2642\ufe0f",
-	":bouncing_ball_person:":             "\u26f9\ufe0f",
-	":bouncing_ball_woman:":              "\u26f9\ufe0f\u200d\u2640\ufe0f",
-	":bouquet:":                          "\U0001f490",
-	":bouvet_island:":                    "\U0001f1e7\U0001f1fb",
-	":bow:":                              "\U0001f647",
-	":bow_and_arrow:":                    "\U0001f3f9",
-	":bowing_man:":                       "\U0001f647\u200d\u2642\ufe0f",
-	":bowing_woman:":                     "\U0001f647\u200d\u2640\ufe0f",
-	":bowl_with_spoon:":                  "\U0001f963",
-	":bowling:":                          "\U0001f3b3",
-	":boxing_glove:":                     "\U0001f94a",
-	":boy:":                              "\U0001f466",
-	":brain:":                            "\U0001f9e0",
-	":brazil:":                           "\U0001f1e7\U0001f1f7",
-	":bread:":                            "\U0001f35e",
-	":breast_feeding:":                   "\U0001f931",
-	":brick:":                            "\U0001f9f1",
-	":bricks:":                           "\U0001f9f1",
-	":bride_with_veil:":                  "\U0001f470\u200d\u2640\ufe0f",
-	":bridge_at_night:":                  "\U0001f309",
-	":briefcase:":                        "\U0001f4bc",
-	":briefs:":                           "\U0001fa72",
-	":bright_button:":                    "\U0001f506",
-	":british_indian_ocean_territory:":   "\U0001f1ee\U0001f1f4",
-	":british_virgin_islands:":           "\U0001f1fb\U0001f1ec",
-	":broccoli:":                         "\U0001f966",
-	":broken_heart:":                     "\U0001f494",
-	":broom:":                            "\U0001f9f9",
-	":brown_circle:":                     "\U0001f7e4",
-	":brown_heart:":                      "\U0001f90e",
-	":brown_square:":                     "\U0001f7eb",
-	":brunei:":                           "\U0001f1e7\U0001f1f3",
-	":bubble_tea:":                       "\U0001f9cb",
-	":bubbles:":                          "\U0001fae7",
-	":bucket:":                           "\U0001faa3",
-	":bug:":                              "\U0001f41b",
-	":building_construction:":            "\U0001f3d7\ufe0f",
-	":bulb:":                             "\U0001f4a1",
-	":bulgaria:":                         "\U0001f1e7\U0001f1ec",
-	":bullet_train:":                     "\U0001f685",
-	":bullettrain_front:":                "\U0001f685",
-	":bullettrain_side:":                 "\U0001f684",
-	":bullseye:":                         "\U0001f3af",
-	":burkina_faso:":                     "\U0001f1e7\U0001f1eb",
-	":burrito:":                          "\U0001f32f",
-	":burundi:":                          "\U0001f1e7\U0001f1ee",
-	":bus:":                              "\U0001f68c",
-	":bus_stop:":                         "\U0001f68f",
-	":business_suit_levitating:":         "\U0001f574\ufe0f",
-	":busstop:":                          "\U0001f68f",
-	":bust_in_silhouette:":               "\U0001f464",
-	":busts_in_silhouette:":              "\U0001f465",
-	":butter:":                           "\U0001f9c8",
-	":butterfly:":                        "\U0001f98b",
-	":cactus:":                           "\U0001f335",
-	":cake:":                             "\U0001f370",
-	":calendar:":                         "\U0001f4c6",
-	":call_me_hand:":                     "\U0001f919",
-	":calling:":                          "\U0001f4f2",
-	":cambodia:":                         "\U0001f1f0\U0001f1ed",
-	":camel:":                            "\U0001f42b",
-	":camera:":                           "\U0001f4f7",
-	":camera_flash:":                     "\U0001f4f8",
-	":camera_with_flash:":                "\U0001f4f8",
-	":cameroon:":                         "\U0001f1e8\U0001f1f2",
-	":camping:":                          "\U0001f3d5\ufe0f",
-	":canada:":                           "\U0001f1e8\U0001f1e6",
-	":canary_islands:":                   "\U0001f1ee\U0001f1e8",
-	":cancer:":                           "\u264b",
-	":candle:":                           "\U0001f56f\ufe0f",
-	":candy:":                            "\U0001f36c",
-	":canned_food:":                      "\U0001f96b",
-	":canoe:":                            "\U0001f6f6",
-	":cape_verde:":                       "\U0001f1e8\U0001f1fb",
-	":capital_abcd:":                     "\U0001f520",
-	":capricorn:":                        "\u2651",
-	":car:":                              "\U0001f697",
-	":card_file_box:":                    "\U0001f5c3\ufe0f",
-	":card_index:":                       "\U0001f4c7",
-	":card_index_dividers:":              "\U0001f5c2\ufe0f",
-	":caribbean_netherlands:":            "\U0001f1e7\U0001f1f6",
-	":carousel_horse:":                   "\U0001f3a0",
-	":carp_streamer:":                    "\U0001f38f",
-	":carpentry_saw:":                    "\U0001fa9a",
-	":carrot:":                           "\U0001f955",
-	":cartwheeling:":                     "\U0001f938",
-	":castle:":                           "\U0001f3f0",
-	":cat2:":                             "\U0001f408",
-	":cat:":                              "\U0001f431",
-	":cat_face:":                         "\U0001f431",
-	":cat_with_tears_of_joy:":            "\U0001f639",
-	":cat_with_wry_smile:":               "\U0001f63c",
-	":cayman_islands:":                   "\U0001f1f0\U0001f1fe",
-	":cd:":                               "\U0001f4bf",
-	":central_african_republic:":         "\U0001f1e8\U0001f1eb",
-	":ceuta_melilla:":                    "\U0001f1ea\U0001f1e6",
-	":chad:":                             "\U0001f1f9\U0001f1e9",
-	":chains:":                           "\u26d3\ufe0f",
-	":chair:":                            "\U0001fa91",
-	":champagne:":                        "\U0001f37e",
-	":chart:":                            "\U0001f4b9",
-	":chart_decreasing:":                 "\U0001f4c9",
-	":chart_increasing:":                 "\U0001f4c8",
-	":chart_increasing_with_yen:":        "\U0001f4b9",
-	":chart_with_downwards_trend:":       "\U0001f4c9",
-	":chart_with_upwards_trend:":         "\U0001f4c8",
-	":check_box_with_check:":             "\u2611\ufe0f",
-	":check_mark:":                       "\u2714\ufe0f",
-	":check_mark_button:":                "\u2705",
-	":checkered_flag:":                   "\U0001f3c1",
-	":cheese:":                           "\U0001f9c0",
-	":cheese_wedge:":                     "\U0001f9c0",
-	":chequered_flag:":                   "\U0001f3c1",
-	":cherries:":                         "\U0001f352",
-	":cherry_blossom:":                   "\U0001f338",
-	":chess_pawn:":                       "\u265f\ufe0f",
-	":chestnut:":                         "\U0001f330",
-	":chicken:":                          "\U0001f414",
-	":child:":                            "\U0001f9d2",
-	":children_crossing:":                "\U0001f6b8",
-	":chile:":                            "\U0001f1e8\U0001f1f1",
-	":chipmunk:":                         "\U0001f43f\ufe0f",
-	":chocolate_bar:":                    "\U0001f36b",
-	":chopsticks:":                       "\U0001f962",
-	":christmas_island:":                 "\U0001f1e8\U0001f1fd",
-	":christmas_tree:":                   "\U0001f384",
-	":church:":                           "\u26ea",
-	":cigarette:":                        "\U0001f6ac",
-	":cinema:":                           "\U0001f3a6",
-	":circled_m:":                        "\u24c2\ufe0f",
-	":circus_tent:":                      "\U0001f3aa",
-	":city_sunrise:":                     "\U0001f307",
-	":city_sunset:":                      "\U0001f306",
-	":cityscape:":                        "\U0001f3d9\ufe0f",
-	":cityscape_at_dusk:":                "\U0001f306",
-	":cl:":                               "\U0001f191",
-	":cl_button:":                        "\U0001f191",
-	":clamp:":                            "\U0001f5dc\ufe0f",
-	":clap:":                             "\U0001f44f",
-	":clapper:":                          "\U0001f3ac",
-	":clapper_board:":                    "\U0001f3ac",
-	":clapping_hands:":                   "\U0001f44f",
-	":classical_building:":               "\U0001f3db\ufe0f",
-	":climbing:":                         "\U0001f9d7",
-	":climbing_man:":                     "\U0001f9d7\u200d\u2642\ufe0f",
-	":climbing_woman:":                   "\U0001f9d7\u200d\u2640\ufe0f",
-	":clinking_beer_mugs:":               "\U0001f37b",
-	":clinking_glasses:":                 "\U0001f942",
-	":clipboard:":                        "\U0001f4cb",
-	":clipperton_island:":                "\U0001f1e8\U0001f1f5",
-	":clock1030:":                        "\U0001f565",
-	":clock10:":                          "\U0001f559",
-	":clock1130:":                        "\U0001f566",
-	":clock11:":                          "\U0001f55a",
-	":clock1230:":                        "\U0001f567",
-	":clock12:":                          "\U0001f55b",
-	":clock130:":                         "\U0001f55c",
-	":clock1:":                           "\U0001f550",
-	":clock230:":                         "\U0001f55d",
-	":clock2:":                           "\U0001f551",
-	":clock330:":                         "\U0001f55e",
-	":clock3:":                           "\U0001f552",
-	":clock430:":                         "\U0001f55f",
-	":clock4:":                           "\U0001f553",
-	":clock530:":                         "\U0001f560",
-	":clock5:":                           "\U0001f554",
-	":clock630:":                         "\U0001f561",
-	":clock6:":                           "\U0001f555",
-	":clock730:":                         "\U0001f562",
-	":clock7:":                           "\U0001f556",
-	":clock830:":                         "\U0001f563",
-	":clock8:":                           "\U0001f557",
-	":clock930:":                         "\U0001f564",
-	":clock9:":                           "\U0001f558",
-	":clockwise_vertical_arrows:":        "\U0001f503",
-	":closed_book:":                      "\U0001f4d5",
-	":closed_lock_with_key:":             "\U0001f510",
-	":closed_mailbox_with_lowered_flag:": "\U0001f4ea",
-	":closed_mailbox_with_raised_flag:":  "\U0001f4eb",
-	":closed_umbrella:":                  "\U0001f302",
-	":cloud:":                            "\u2601\ufe0f",
-	":cloud_with_lightning:":             "\U0001f329\ufe0f",
-	":cloud_with_lightning_and_rain:":    "\u26c8\ufe0f",
-	":cloud_with_rain:":                  "\U0001f327\ufe0f",
-	":cloud_with_snow:":                  "\U0001f328\ufe0f",
-	":clown_face:":                       "\U0001f921",
-	":club_suit:":                        "\u2663\ufe0f",
-	":clubs:":                            "\u2663\ufe0f",
-	":clutch_bag:":                       "\U0001f45d",
-	":cn:":                               "\U0001f1e8\U0001f1f3",
-	":coat:":                             "\U0001f9e5",
-	":cockroach:":                        "\U0001fab3",
-	":cocktail:":                         "\U0001f378",
-	":cocktail_glass:":                   "\U0001f378",
-	":coconut:":                          "\U0001f965",
-	":cocos_islands:":                    "\U0001f1e8\U0001f1e8",
-	":coffee:":                           "\u2615",
-	":coffin:":                           "\u26b0\ufe0f",
-	":coin:":                             "\U0001fa99",
-	":cold_face:":                        "\U0001f976",
-	":cold_sweat:":                       "\U0001f630",
-	":collision:":                        "\U0001f4a5",
-	":colombia:":                         "\U0001f1e8\U0001f1f4",
-	":comet:":                            "\u2604\ufe0f",
-	":comoros:":                          "\U0001f1f0\U0001f1f2",
-	":compass:":                          "\U0001f9ed",
-	":computer:":                         "\U0001f4bb",
-	":computer_disk:":                    "\U0001f4bd",
-	":computer_mouse:":                   "\U0001f5b1\ufe0f",
-	":confetti_ball:":                    "\U0001f38a",
-	":confounded:":                       "\U0001f616",
-	":confounded_face:":                  "\U0001f616",
-	":confused:":                         "\U0001f615",
-	":confused_face:":                    "\U0001f615",
-	":congo_brazzaville:":                "\U0001f1e8\U0001f1ec",
-	":congo_kinshasa:":                   "\U0001f1e8\U0001f1e9",
-	":congratulations:":                  "\u3297\ufe0f",
-	":construction:":                     "\U0001f6a7",
-	":construction_worker:":              "\U0001f477",
-	":construction_worker_man:":          "\U0001f477\u200d\u2642\ufe0f",
-	":construction_worker_woman:":        "\U0001f477\u200d\u2640\ufe0f",
-	":control_knobs:":                    "\U0001f39b\ufe0f",
-	":convenience_store:":                "\U0001f3ea",
-	":cook:":                             "\U0001f9d1\u200d\U0001f373",
-	":cook_islands:":                     "\U0001f1e8\U0001f1f0",
-	":cooked_rice:":                      "\U0001f35a",
-	":cookie:":                           "\U0001f36a",
-	":cooking:":                          "\U0001f373",
-	":cool:":                             "\U0001f192",
-	":cool_button:":                      "\U0001f192",
-	":cop:":                              "\U0001f46e",
-	":copyright:":                        "\u00a9\ufe0f",
-	":coral:":                            "\U0001fab8",
-	":corn:":                             "\U0001f33d",
-	":costa_rica:":                       "\U0001f1e8\U0001f1f7",
-	":cote_divoire:":                     "\U0001f1e8\U0001f1ee",
-	":couch_and_lamp:":                   "\U0001f6cb\ufe0f",
-	":counterclockwise_arrows_button:":   "\U0001f504",
-	":couple:":                           "\U0001f46b",
-	":couple_with_heart:":                "\U0001f491",
-	":couple_with_heart_man_man:":        "\U0001f468\u200d\u2764\ufe0f\u200d\U0001f468",
-	":couple_with_heart_person_person:":  "\U0001f9d1\U0001f3fb\u200d\u2764\ufe0f\u200d\U0001f9d1\U0001f3fc",
-	":couple_with_heart_woman_man:":      "\U0001f469\u200d\u2764\ufe0f\u200d\U0001f468",
-	":couple_with_heart_woman_woman:":    "\U0001f469\u200d\u2764\ufe0f\u200d\U0001f469",
-	":couplekiss:":                       "\U0001f48f",
-	":couplekiss_man_man:":               "\U0001f468\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468",
-	":couplekiss_man_woman:":             "\U0001f469\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468",
-	":couplekiss_woman_woman:":           "\U0001f469\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f469",
-	":cow2:":                             "\U0001f404",
-	":cow:":                              "\U0001f42e",
-	":cow_face:":                         "\U0001f42e",
-	":cowboy_hat_face:":                  "\U0001f920",
-	":crab:":                             "\U0001f980",
-	":crayon:":                           "\U0001f58d\ufe0f",
-	":credit_card:":                      "\U0001f4b3",
-	":crescent_moon:":                    "\U0001f319",
-	":cricket:":                          "\U0001f997",
-	":cricket_game:":                     "\U0001f3cf",
-	":croatia:":                          "\U0001f1ed\U0001f1f7",
-	":crocodile:":                        "\U0001f40a",
-	":croissant:":                        "\U0001f950",
-	":cross_mark:":                       "\u274c",
-	":cross_mark_button:":                "\u274e",
-	":crossed_fingers:":                  "\U0001f91e",
-	":crossed_flags:":                    "\U0001f38c",
-	":crossed_swords:":                   "\u2694\ufe0f",
-	":crown:":                            "\U0001f451",
-	":crutch:":                           "\U0001fa7c",
-	":cry:":                              "\U0001f622",
-	":crying_cat:":                       "\U0001f63f",
-	":crying_cat_face:":                  "\U0001f63f",
-	":crying_face:":                      "\U0001f622",
-	":crystal_ball:":                     "\U0001f52e",
-	":cuba:":                             "\U0001f1e8\U0001f1fa",
-	":cucumber:":                         "\U0001f952",
-	":cup_with_straw:":                   "\U0001f964",
-	":cupcake:":                          "\U0001f9c1",
-	":cupid:":                            "\U0001f498",
-	":curacao:":                          "\U0001f1e8\U0001f1fc",
-	":curling_stone:":                    "\U0001f94c",
-	":curly_hair:":                       "\U0001f9b1",
-	":curly_haired_man:":                 "\U0001f468\u200d\U0001f9b1",
-	":curly_haired_woman:":               "\U0001f469\u200d\U0001f9b1",
-	":curly_loop:":                       "\u27b0",
-	":currency_exchange:":                "\U0001f4b1",
-	":curry:":                            "\U0001f35b",
-	":curry_rice:":                       "\U0001f35b",
-	":cursing_face:":                     "\U0001f92c",
-	":custard:":                          "\U0001f36e",
-	":customs:":                          "\U0001f6c3",
-	":cut_of_meat:":                      "\U0001f969",
-	":cyclone:":                          "\U0001f300",
-	":cyprus:":                           "\U0001f1e8\U0001f1fe",
-	":czech_republic:":                   "\U0001f1e8\U0001f1ff",
-	":dagger:":                           "\U0001f5e1\ufe0f",
-	":dancer:":                           "\U0001f483",
-	":dancers:":                          "\U0001f46f",
-	":dancing_men:":                      "\U0001f46f\u200d\u2642\ufe0f",
-	":dancing_women:":                    "\U0001f46f\u200d\u2640\ufe0f",
-	":dango:":                            "\U0001f361",
-	":dark_skin_tone:":                   "\U0001f3ff",
-	":dark_sunglasses:":                  "\U0001f576\ufe0f",
-	":dart:":                             "\U0001f3af",
-	":dash:":                             "\U0001f4a8",
-	":dashing_away:":                     "\U0001f4a8",
-	":date:":                             "\U0001f4c5",
-	":de:":                               "\U0001f1e9\U0001f1ea",
-	":deaf_man:":                         "\U0001f9cf\u200d\u2642\ufe0f",
-	":deaf_person:":                      "\U0001f9cf",
-	":deaf_woman:":                       "\U0001f9cf\u200d\u2640\ufe0f",
-	":deciduous_tree:":                   "\U0001f333",
-	":deer:":                             "\U0001f98c",
-	":delivery_truck:":                   "\U0001f69a",
-	":denmark:":                          "\U0001f1e9\U0001f1f0",
-	":department_store:":                 "\U0001f3ec",
-	":derelict_house:":                   "\U0001f3da\ufe0f",
-	":desert:":                           "\U0001f3dc\ufe0f",
-	":desert_island:":                    "\U0001f3dd\ufe0f",
-	":desktop_computer:":                 "\U0001f5a5\ufe0f",
-	":detective:":                        "\U0001f575\ufe0f",
-	":diamond_shape_with_a_dot_inside:":  "\U0001f4a0",
-	":diamond_suit:":                     "\u2666\ufe0f",
-	":diamond_with_a_dot:":               "\U0001f4a0",
-	":diamonds:":                         "\u2666\ufe0f",
-	":diego_garcia:":                     "\U0001f1e9\U0001f1ec",
-	":dim_button:":                       "\U0001f505",
-	":disappointed:":                     "\U0001f61e",
-	":disappointed_face:":                "\U0001f61e",
-	":disappointed_relieved:":            "\U0001f625",
-	":disguised_face:":                   "\U0001f978",
-	":divide:":                           "\u2797",
-	":diving_mask:":                      "\U0001f93f",
-	":diya_lamp:":                        "\U0001fa94",
-	":dizzy:":                            "\U0001f4ab",
-	":dizzy_face:":                       "\U0001f635",
-	":djibouti:":                         "\U0001f1e9\U0001f1ef",
-	":dna:":                              "\U0001f9ec",
-	":do_not_litter:":                    "\U0001f6af",
-	":dodo:":                             "\U0001f9a4",
-	":dog2:":                             "\U0001f415",
-	":dog:":                              "\U0001f436",
-	":dog_face:":                         "\U0001f436",
-	":dollar:":                           "\U0001f4b5",
-	":dollar_banknote:":                  "\U0001f4b5",
-	":dolls:":                            "\U0001f38e",
-	":dolphin:":                          "\U0001f42c",
-	":dominica:":                         "\U0001f1e9\U0001f1f2",
-	":dominican_republic:":               "\U0001f1e9\U0001f1f4",
-	":door:":                             "\U0001f6aa",
-	":dotted_line_face:":                 "\U0001fae5",
-	":dotted_six_pointed_star:":          "\U0001f52f",
-	":double_curly_loop:":                "\u27bf",
-	":double_exclamation_mark:":          "\u203c\ufe0f",
-	":doughnut:":                         "\U0001f369",
-	":dove:":                             "\U0001f54a\ufe0f",
-	":down_arrow:":                       "\u2b07\ufe0f",
-	":down_left_arrow:":                  "\u2199\ufe0f",
-	":down_right_arrow:":                 "\u2198\ufe0f",
-	":downcast_face_with_sweat:":         "\U0001f613",
-	":downwards_button:":                 "\U0001f53d",
-	":dragon:":                           "\U0001f409",
-	":dragon_face:":                      "\U0001f432",
-	":dress:":                            "\U0001f457",
-	":dromedary_camel:":                  "\U0001f42a",
-	":drooling_face:":                    "\U0001f924",
-	":drop_of_blood:":                    "\U0001fa78",
-	":droplet:":                          "\U0001f4a7",
-	":drum:":                             "\U0001f941",
-	":duck:":                             "\U0001f986",
-	":dumpling:":                         "\U0001f95f",
-	":dvd:":                              "\U0001f4c0",
-	":e-mail:":                           "\U0001f4e7",
-	":e_mail:":                           "\U0001f4e7",
-	":eagle:":                            "\U0001f985",
-	":ear:":                              "\U0001f442",
-	":ear_of_corn:":                      "\U0001f33d",
-	":ear_of_rice:":                      "\U0001f33e",
-	":ear_with_hearing_aid:":             "\U0001f9bb",
-	":earth_africa:":                     "\U0001f30d",
-	":earth_americas:":                   "\U0001f30e",
-	":earth_asia:":                       "\U0001f30f",
-	":ecuador:":                          "\U0001f1ea\U0001f1e8",
-	":egg:":                              "\U0001f95a",
-	":eggplant:":                         "\U0001f346",
-	":egypt:":                            "\U0001f1ea\U0001f1ec",
-	":eight:":                            "8\ufe0f\u20e3",
-	":eight_o_clock:":                    "\U0001f557",
-	":eight_pointed_black_star:":         "\u2734\ufe0f",
-	":eight_pointed_star:":               "\u2734\ufe0f",
-	":eight_spoked_asterisk:":            "\u2733\ufe0f",
-	":eight_thirty:":                     "\U0001f563",
-	":eject_button:":                     "\u23cf\ufe0f",
-	":el_salvador:":                      "\U0001f1f8\U0001f1fb",
-	":electric_plug:":                    "\U0001f50c",
-	":elephant:":                         "\U0001f418",
-	":elevator:":                         "\U0001f6d7",
-	":eleven_o_clock:":                   "\U0001f55a",
-	":eleven_thirty:":                    "\U0001f566",
-	":elf:":                              "\U0001f9dd",
-	":elf_man:":                          "\U0001f9dd\u200d\u2642\ufe0f",
-	":elf_woman:":                        "\U0001f9dd\u200d\u2640\ufe0f",
-	":email:":                            "\U0001f4e7",
-	":empty_nest:":                       "\U0001fab9",
-	":end:":                              "\U0001f51a",
-	":end_arrow:":                        "\U0001f51a",
-	":england:":                          "\U0001f3f4\U000e0067\U000e0062\U000e0065\U000e006e\U000e0067\U000e007f",
-	":envelope:":                         "\u2709\ufe0f",
-	":envelope_with_arrow:":              "\U0001f4e9",
-	":equatorial_guinea:":                "\U0001f1ec\U0001f1f6",
-	":eritrea:":                          "\U0001f1ea\U0001f1f7",
-	":es:":                               "\U0001f1ea\U0001f1f8",
-	":estonia:":                          "\U0001f1ea\U0001f1ea",
-	":ethiopia:":                         "\U0001f1ea\U0001f1f9",
-	":eu:":                               "\U0001f1ea\U0001f1fa",
-	":euro:":                             "\U0001f4b6",
-	":euro_banknote:":                    "\U0001f4b6",
-	":european_castle:":                  "\U0001f3f0",
-	":european_post_office:":             "\U0001f3e4",
-	":european_union:":                   "\U0001f1ea\U0001f1fa",
-	":evergreen_tree:":                   "\U0001f332",
-	":ewe:":                              "\U0001f411",
-	":exclamation:":                      "\u2757",
-	":exclamation_question_mark:":        "\u2049\ufe0f",
-	":exploding_head:":                   "\U0001f92f",
-	":expressionless:":                   "\U0001f611",
-	":expressionless_face:":              "\U0001f611",
-	":eye:":                              "\U0001f441\ufe0f",
-	":eye_in_speech_bubble:":             "\U0001f441\ufe0f\u200d\U0001f5e8\ufe0f",
-	":eye_speech_bubble:":                "\U0001f441\ufe0f\u200d\U0001f5e8\ufe0f",
-	":eyeglasses:":                       "\U0001f453",
-	":eyes:":                             "\U0001f440",
-	":face_blowing_a_kiss:":              "\U0001f618",
-	":face_exhaling:":                    "\U0001f62e\u200d\U0001f4a8",
-	":face_holding_back_tears:":          "\U0001f979",
-	":face_in_clouds:":                   "\U0001f636\u200d\U0001f32b\ufe0f",
-	":face_savoring_food:":               "\U0001f60b",
-	":face_screaming_in_fear:":           "\U0001f631",
-	":face_vomiting:":                    "\U0001f92e",
-	":face_with_crossed_out_eyes:":       "\U0001f635",
-	":face_with_diagonal_mouth:":         "\U0001fae4",
-	":face_with_hand_over_mouth:":        "\U0001f92d",
-	":face_with_head_bandage:":           "\U0001f915",
-	":face_with_medical_mask:":           "\U0001f637",
-	":face_with_monocle:":                "\U0001f9d0",
-	":face_with_open_eyes_and_hand_over_mouth:": "\U0001fae2",
-	":face_with_open_mouth:":                    "\U0001f62e",
-	":face_with_peeking_eye:":                   "\U0001fae3",
-	":face_with_raised_eyebrow:":                "\U0001f928",
-	":face_with_rolling_eyes:":                  "\U0001f644",
-	":face_with_spiral_eyes:":                   "\U0001f635\u200d\U0001f4ab",
-	":face_with_steam_from_nose:":               "\U0001f624",
-	":face_with_symbols_on_mouth:":              "\U0001f92c",
-	":face_with_tears_of_joy:":                  "\U0001f602",
-	":face_with_thermometer:":                   "\U0001f912",
-	":face_with_tongue:":                        "\U0001f61b",
-	":face_without_mouth:":                      "\U0001f636",
-	":facepalm:":                                "\U0001f926",
-	":facepunch:":                               "\U0001f44a",
-	":factory:":                                 "\U0001f3ed",
-	":factory_worker:":                          "\U0001f9d1\u200d\U0001f3ed",
-	":fairy:":                                   "\U0001f9da",
-	":fairy_man:":                               "\U0001f9da\u200d\u2642\ufe0f",
-	":fairy_woman:":                             "\U0001f9da\u200d\u2640\ufe0f",
-	":falafel:":                                 "\U0001f9c6",
-	":falkland_islands:":                        "\U0001f1eb\U0001f1f0",
-	":fallen_leaf:":                             "\U0001f342",
-	":family:":                                  "\U0001f46a",
-	":family_man_boy:":                          "\U0001f468\u200d\U0001f466",
-	":family_man_boy_boy:":                      "\U0001f468\u200d\U0001f466\u200d\U0001f466",
-	":family_man_girl:":                         "\U0001f468\u200d\U0001f467",
-	":family_man_girl_boy:":                     "\U0001f468\u200d\U0001f467\u200d\U0001f466",
-	":family_man_girl_girl:":                    "\U0001f468\u200d\U0001f467\u200d\U0001f467",
-	":family_man_man_boy:":                      "\U0001f468\u200d\U0001f468\u200d\U0001f466",
-	":family_man_man_boy_boy:":                  "\U0001f468\u200d\U0001f468\u200d\U0001f466\u200d\U0001f466",
-	":family_man_man_girl:":                     "\U0001f468\u200d\U0001f468\u200d\U0001f467",
-	":family_man_man_girl_boy:":                 "\U0001f468\u200d\U0001f468\u200d\U0001f467\u200d\U0001f466",
-	":family_man_man_girl_girl:":                "\U0001f468\u200d\U0001f468\u200d\U0001f467\u200d\U0001f467",
-	":family_man_woman_boy:":                    "\U0001f468\u200d\U0001f469\u200d\U0001f466",
-	":family_man_woman_boy_boy:":                "\U0001f468\u200d\U0001f469\u200d\U0001f466\u200d\U0001f466",
-	":family_man_woman_girl:":                   "\U0001f468\u200d\U0001f469\u200d\U0001f467",
-	":family_man_woman_girl_boy:":               "\U0001f468\u200d\U0001f469\u200d\U0001f467\u200d\U0001f466",
-	":family_man_woman_girl_girl:":              "\U0001f468\u200d\U0001f469\u200d\U0001f467\u200d\U0001f467",
-	":family_woman_boy:":                        "\U0001f469\u200d\U0001f466",
-	":family_woman_boy_boy:":                    "\U0001f469\u200d\U0001f466\u200d\U0001f466",
-	":family_woman_girl:":                       "\U0001f469\u200d\U0001f467",
-	":family_woman_girl_boy:":                   "\U0001f469\u200d\U0001f467\u200d\U0001f466",
-	":family_woman_girl_girl:":                  "\U0001f469\u200d\U0001f467\u200d\U0001f467",
-	":family_woman_woman_boy:":                  "\U0001f469\u200d\U0001f469\u200d\U0001f466",
-	":family_woman_woman_boy_boy:":              "\U0001f469\u200d\U0001f469\u200d\U0001f466\u200d\U0001f466",
-	":family_woman_woman_girl:":                 "\U0001f469\u200d\U0001f469\u200d\U0001f467",
-	":family_woman_woman_girl_boy:":             "\U0001f469\u200d\U0001f469\u200d\U0001f467\u200d\U0001f466",
-	":family_woman_woman_girl_girl:":            "\U0001f469\u200d\U0001f469\u200d\U0001f467\u200d\U0001f467",
-	":farmer:":                                  "\U0001f9d1\u200d\U0001f33e",
-	":faroe_islands:":                           "\U0001f1eb\U0001f1f4",
-	":fast_down_button:":                        "\u23ec",
-	":fast_forward:":                            "\u23e9",
-	":fast_forward_button:":                     "\u23e9",
-	":fast_reverse_button:":                     "\u23ea",
-	":fast_up_button:":                          "\u23eb",
-	":fax:":                                     "\U0001f4e0",
-	":fax_machine:":                             "\U0001f4e0",
-	":fearful:":                                 "\U0001f628",
-	":fearful_face:":                            "\U0001f628",
-	":feather:":                                 "\U0001fab6",
-	":feet:":                                    "\U0001f43e",
-	":female_detective:":                        "\U0001f575\ufe0f\u200d\u2640\ufe0f",
-	":female_sign:":                             "\u2640\ufe0f",
-	":ferris_wheel:":                            "\U0001f3a1",
-	":ferry:":                                   "\u26f4\ufe0f",
-	":field_hockey:":                            "\U0001f3d1",
-	":fiji:":                                    "\U0001f1eb\U0001f1ef",
-	":file_cabinet:":                            "\U0001f5c4\ufe0f",
-	":file_folder:":                             "\U0001f4c1",
-	":film_frames:":                             "\U0001f39e\ufe0f",
-	":film_projector:":                          "\U0001f4fd\ufe0f",
-	":film_strip:":                              "\U0001f39e\ufe0f",
-	":finland:":                                 "\U0001f1eb\U0001f1ee",
-	":fire:":                                    "\U0001f525",
-	":fire_engine:":                             "\U0001f692",
-	":fire_extinguisher:":                       "\U0001f9ef",
-	":firecracker:":                             "\U0001f9e8",
-	":firefighter:":                             "\U0001f9d1\u200d\U0001f692",
-	":fireworks:":                               "\U0001f386",
-	":first_place_medal:":                       "\U0001f947",
-	":first_quarter_moon:":                      "\U0001f313",
-	":first_quarter_moon_face:":                 "\U0001f31b",
-	":first_quarter_moon_with_face:":            "\U0001f31b",
-	":fish:":                                    "\U0001f41f",
-	":fish_cake:":                               "\U0001f365",
-	":fish_cake_with_swirl:":                    "\U0001f365",
-	":fishing_pole:":                            "\U0001f3a3",
-	":fishing_pole_and_fish:":                   "\U0001f3a3",
-	":fist:":                                    "\u270a",
-	":fist_left:":                               "\U0001f91b",
-	":fist_oncoming:":                           "\U0001f44a",
-	":fist_raised:":                             "\u270a",
-	":fist_right:":                              "\U0001f91c",
-	":five:":                                    "5\ufe0f\u20e3",
-	":five_o_clock:":                            "\U0001f554",
-	":five_thirty:":                             "\U0001f560",
-	":flag_for_afghanistan:":                    "\U0001f1e6\U0001f1eb",
-	":flag_for_aland_islands:":                  "\U0001f1e6\U0001f1fd",
-	":flag_for_albania:":                        "\U0001f1e6\U0001f1f1",
-	":flag_for_algeria:":                        "\U0001f1e9\U0001f1ff",
-	":flag_for_american_samoa:":                 "\U0001f1e6\U0001f1f8",
-	":flag_for_andorra:":                        "\U0001f1e6\U0001f1e9",
-	":flag_for_angola:":                         "\U0001f1e6\U0001f1f4",
-	":flag_for_anguilla:":                       "\U0001f1e6\U0001f1ee",
-	":flag_for_antarctica:":                     "\U0001f1e6\U0001f1f6",
-	":flag_for_antigua_and_barbuda:":            "\U0001f1e6\U0001f1ec",
-	":flag_for_argentina:":                      "\U0001f1e6\U0001f1f7",
-	":flag_for_armenia:":                        "\U0001f1e6\U0001f1f2",
-	":flag_for_aruba:":                          "\U0001f1e6\U0001f1fc",
-	":flag_for_ascension_island:":               "\U0001f1e6\U0001f1e8",
-	":flag_for_australia:":                      "\U0001f1e6\U0001f1fa",
-	":flag_for_austria:":                        "\U0001f1e6\U0001f1f9",
-	":flag_for_azerbaijan:":                     "\U0001f1e6\U0001f1ff",
-	":flag_for_bahamas:":                        "\U0001f1e7\U0001f1f8",
-	":flag_for_bahrain:":                        "\U0001f1e7\U0001f1ed",
-	":flag_for_bangladesh:":                     "\U0001f1e7\U0001f1e9",
-	":flag_for_barbados:":                       "\U0001f1e7\U0001f1e7",
-	":flag_for_belarus:":                        "\U0001f1e7\U0001f1fe",
-	":flag_for_belgium:":                        "\U0001f1e7\U0001f1ea",
-	":flag_for_belize:":                         "\U0001f1e7\U0001f1ff",
-	":flag_for_benin:":                          "\U0001f1e7\U0001f1ef",
-	":flag_for_bermuda:":                        "\U0001f1e7\U0001f1f2",
-	":flag_for_bhutan:":                         "\U0001f1e7\U0001f1f9",
-	":flag_for_bolivia:":                        "\U0001f1e7\U0001f1f4",
-	":flag_for_bosnia_and_herzegovina:":         "\U0001f1e7\U0001f1e6",
-	":flag_for_botswana:":                       "\U0001f1e7\U0001f1fc",
-	":flag_for_bouvet_island:":                  "\U0001f1e7\U0001f1fb",
-	":flag_for_brazil:":                         "\U0001f1e7\U0001f1f7",
-	":flag_for_british_indian_ocean_territory:": "\U0001f1ee\U0001f1f4",
-	":flag_for_british_virgin_islands:":         "\U0001f1fb\U0001f1ec",
-	":flag_for_brunei:":                         "\U0001f1e7\U0001f1f3",
-	":flag_for_bulgaria:":                       "\U0001f1e7\U0001f1ec",
-	":flag_for_burkina_faso:":                   "\U0001f1e7\U0001f1eb",
-	":flag_for_burundi:":                        "\U0001f1e7\U0001f1ee",
-	":flag_for_cambodia:":                       "\U0001f1f0\U0001f1ed",
-	":flag_for_cameroon:":                       "\U0001f1e8\U0001f1f2",
-	":flag_for_canada:":                         "\U0001f1e8\U0001f1e6",
-	":flag_for_canary_islands:":                 "\U0001f1ee\U0001f1e8",
-	":flag_for_cape_verde:":                     "\U0001f1e8\U0001f1fb",
-	":flag_for_caribbean_netherlands:":          "\U0001f1e7\U0001f1f6",
-	":flag_for_cayman_islands:":                 "\U0001f1f0\U0001f1fe",
-	":flag_for_central_african_republic:":       "\U0001f1e8\U0001f1eb",
-	":flag_for_ceuta_and_melilla:":              "\U0001f1ea\U0001f1e6",
-	":flag_for_chad:":                           "\U0001f1f9\U0001f1e9",
-	":flag_for_chile:":                          "\U0001f1e8\U0001f1f1",
-	":flag_for_china:":                          "\U0001f1e8\U0001f1f3",
-	":flag_for_christmas_island:":               "\U0001f1e8\U0001f1fd",
-	":flag_for_clipperton_island:":              "\U0001f1e8\U0001f1f5",
-	":flag_for_cocos_keeling_islands:":          "\U0001f1e8\U0001f1e8",
-	":flag_for_colombia:":                       "\U0001f1e8\U0001f1f4",
-	":flag_for_comoros:":                        "\U0001f1f0\U0001f1f2",
-	":flag_for_congo_brazzaville:":              "\U0001f1e8\U0001f1ec",
-	":flag_for_congo_kinshasa:":                 "\U0001f1e8\U0001f1e9",
-	":flag_for_cook_islands:":                   "\U0001f1e8\U0001f1f0",
-	":flag_for_costa_rica:":                     "\U0001f1e8\U0001f1f7",
-	":flag_for_cote_d_ivoire:":                  "\U0001f1e8\U0001f1ee",
-	":flag_for_croatia:":                        "\U0001f1ed\U0001f1f7",
-	":flag_for_cuba:":                           "\U0001f1e8\U0001f1fa",
-	":flag_for_curacao:":                        "\U0001f1e8\U0001f1fc",
-	":flag_for_cyprus:":                         "\U0001f1e8\U0001f1fe",
-	":flag_for_czechia:":                        "\U0001f1e8\U0001f1ff",
-	":flag_for_denmark:":                        "\U0001f1e9\U0001f1f0",
-	":flag_for_diego_garcia:":                   "\U0001f1e9\U0001f1ec",
-	":flag_for_djibouti:":                       "\U0001f1e9\U0001f1ef",
-	":flag_for_dominica:":                       "\U0001f1e9\U0001f1f2",
-	":flag_for_dominican_republic:":             "\U0001f1e9\U0001f1f4",
-	":flag_for_ecuador:":                        "\U0001f1ea\U0001f1e8",
-	":flag_for_egypt:":                          "\U0001f1ea\U0001f1ec",
-	":flag_for_el_salvador:":                    "\U0001f1f8\U0001f1fb",
-	":flag_for_england:":                        "\U0001f3f4\U000e0067\U000e0062\U000e0065\U000e006e\U000e0067\U000e007f",
-	":flag_for_equatorial_guinea:":              "\U0001f1ec\U0001f1f6",
-	":flag_for_eritrea:":                        "\U0001f1ea\U0001f1f7",
-	":flag_for_estonia:":                        "\U0001f1ea\U0001f1ea",
-	":flag_for_eswatini:":                       "\U0001f1f8\U0001f1ff",
-	":flag_for_ethiopia:":                       "\U0001f1ea\U0001f1f9",
-	":flag_for_european_union:":                 "\U0001f1ea\U0001f1fa",
-	":flag_for_falkland_islands:":               "\U0001f1eb\U0001f1f0",
-	":flag_for_faroe_islands:":                  "\U0001f1eb\U0001f1f4",
-	":flag_for_fiji:":                           "\U0001f1eb\U0001f1ef",
-	":flag_for_finland:":                        "\U0001f1eb\U0001f1ee",
-	":flag_for_france:":                         "\U0001f1eb\U0001f1f7",
-	":flag_for_french_guiana:":                  "\U0001f1ec\U0001f1eb",
-	":flag_for_french_polynesia:":               "\U0001f1f5\U0001f1eb",
-	":flag_for_french_southern_territories:":    "\U0001f1f9\U0001f1eb",
-	":flag_for_gabon:":                          "\U0001f1ec\U0001f1e6",
-	":flag_for_gambia:":                         "\U0001f1ec\U0001f1f2",
-	":flag_for_georgia:":                        "\U0001f1ec\U0001f1ea",
-	":flag_for_germany:":                        "\U0001f1e9\U0001f1ea",
-	":flag_for_ghana:":                          "\U0001f1ec\U0001f1ed",
-	":flag_for_gibraltar:":                      "\U0001f1ec\U0001f1ee",
-	":flag_for_greece:":                         "\U0001f1ec\U0001f1f7",
-	":flag_for_greenland:":                      "\U0001f1ec\U0001f1f1",
-	":flag_for_grenada:":                        "\U0001f1ec\U0001f1e9",
-	":flag_for_guadeloupe:":                     "\U0001f1ec\U0001f1f5",
-	":flag_for_guam:":                           "\U0001f1ec\U0001f1fa",
-	":flag_for_guatemala:":                      "\U0001f1ec\U0001f1f9",
-	":flag_for_guernsey:":                       "\U0001f1ec\U0001f1ec",
-	":flag_for_guinea:":                         "\U0001f1ec\U0001f1f3",
-	":flag_for_guinea_bissau:":                  "\U0001f1ec\U0001f1fc",
-	":flag_for_guyana:":                         "\U0001f1ec\U0001f1fe",
-	":flag_for_haiti:":                          "\U0001f1ed\U0001f1f9",
-	":flag_for_heard_and_mcdonald_islands:":     "\U0001f1ed\U0001f1f2",
-	":flag_for_honduras:":                       "\U0001f1ed\U0001f1f3",
-	":flag_for_hong_kong_sar_china:":            "\U0001f1ed\U0001f1f0",
-	":flag_for_hungary:":                        "\U0001f1ed\U0001f1fa",
-	":flag_for_iceland:":                        "\U0001f1ee\U0001f1f8",
-	":flag_for_india:":                          "\U0001f1ee\U0001f1f3",
-	":flag_for_indonesia:":                      "\U0001f1ee\U0001f1e9",
-	":flag_for_iran:":                           "\U0001f1ee\U0001f1f7",
-	":flag_for_iraq:":                           "\U0001f1ee\U0001f1f6",
-	":flag_for_ireland:":                        "\U0001f1ee\U0001f1ea",
-	":flag_for_isle_of_man:":                    "\U0001f1ee\U0001f1f2",
-	":flag_for_israel:":                         "\U0001f1ee\U0001f1f1",
-	":flag_for_italy:":                          "\U0001f1ee\U0001f1f9",
-	":flag_for_jamaica:":                        "\U0001f1ef\U0001f1f2",
-	":flag_for_japan:":                          "\U0001f1ef\U0001f1f5",
-	":flag_for_jersey:":                         "\U0001f1ef\U0001f1ea",
-	":flag_for_jordan:":                         "\U0001f1ef\U0001f1f4",
-	":flag_for_kazakhstan:":                     "\U0001f1f0\U0001f1ff",
-	":flag_for_kenya:":                          "\U0001f1f0\U0001f1ea",
-	":flag_for_kiribati:":                       "\U0001f1f0\U0001f1ee",
-	":flag_for_kosovo:":                         "\U0001f1fd\U0001f1f0",
-	":flag_for_kuwait:":                         "\U0001f1f0\U0001f1fc",
-	":flag_for_kyrgyzstan:":                     "\U0001f1f0\U0001f1ec",
-	":flag_for_laos:":                           "\U0001f1f1\U0001f1e6",
-	":flag_for_latvia:":                         "\U0001f1f1\U0001f1fb",
-	":flag_for_lebanon:":                        "\U0001f1f1\U0001f1e7",
-	":flag_for_lesotho:":                        "\U0001f1f1\U0001f1f8",
-	":flag_for_liberia:":                        "\U0001f1f1\U0001f1f7",
-	":flag_for_libya:":                          "\U0001f1f1\U0001f1fe",
-	":flag_for_liechtenstein:":                  "\U0001f1f1\U0001f1ee",
-	":flag_for_lithuania:":                      "\U0001f1f1\U0001f1f9",
-	":flag_for_luxembourg:":                     "\U0001f1f1\U0001f1fa",
-	":flag_for_macao_sar_china:":                "\U0001f1f2\U0001f1f4",
-	":flag_for_madagascar:":                     "\U0001f1f2\U0001f1ec",
-	":flag_for_malawi:":                         "\U0001f1f2\U0001f1fc",
-	":flag_for_malaysia:":                       "\U0001f1f2\U0001f1fe",
-	":flag_for_maldives:":                       "\U0001f1f2\U0001f1fb",
-	":flag_for_mali:":                           "\U0001f1f2\U0001f1f1",
-	":flag_for_malta:":                          "\U0001f1f2\U0001f1f9",
-	":flag_for_marshall_islands:":               "\U0001f1f2\U0001f1ed",
-	":flag_for_martinique:":                     "\U0001f1f2\U0001f1f6",
-	":flag_for_mauritania:":                     "\U0001f1f2\U0001f1f7",
-	":flag_for_mauritius:":                      "\U0001f1f2\U0001f1fa",
-	":flag_for_mayotte:":                        "\U0001f1fe\U0001f1f9",
-	":flag_for_mexico:":                         "\U0001f1f2\U0001f1fd",
-	":flag_for_micronesia:":                     "\U0001f1eb\U0001f1f2",
-	":flag_for_moldova:":                        "\U0001f1f2\U0001f1e9",
-	":flag_for_monaco:":                         "\U0001f1f2\U0001f1e8",
-	":flag_for_mongolia:":                       "\U0001f1f2\U0001f1f3",
-	":flag_for_montenegro:":                     "\U0001f1f2\U0001f1ea",
-	":flag_for_montserrat:":                     "\U0001f1f2\U0001f1f8",
-	":flag_for_morocco:":                        "\U0001f1f2\U0001f1e6",
-	":flag_for_mozambique:":                     "\U0001f1f2\U0001f1ff",
-	":flag_for_myanmar_burma:":                  "\U0001f1f2\U0001f1f2",
-	":flag_for_namibia:":                        "\U0001f1f3\U0001f1e6",
-	":flag_for_nauru:":                          "\U0001f1f3\U0001f1f7",
-	":flag_for_nepal:":                          "\U0001f1f3\U0001f1f5",
-	":flag_for_netherlands:":                    "\U0001f1f3\U0001f1f1",
-	":flag_for_new_caledonia:":                  "\U0001f1f3\U0001f1e8",
-	":flag_for_new_zealand:":                    "\U0001f1f3\U0001f1ff",
-	":flag_for_nicaragua:":                      "\U0001f1f3\U0001f1ee",
-	":flag_for_niger:":                          "\U0001f1f3\U0001f1ea",
-	":flag_for_nigeria:":                        "\U0001f1f3\U0001f1ec",
-	":flag_for_niue:":                           "\U0001f1f3\U0001f1fa",
-	":flag_for_norfolk_island:":                 "\U0001f1f3\U0001f1eb",
-	":flag_for_north_korea:":                    "\U0001f1f0\U0001f1f5",
-	":flag_for_north_macedonia:":                "\U0001f1f2\U0001f1f0",
-	":flag_for_northern_mariana_islands:":       "\U0001f1f2\U0001f1f5",
-	":flag_for_norway:":                         "\U0001f1f3\U0001f1f4",
-	":flag_for_oman:":                           "\U0001f1f4\U0001f1f2",
-	":flag_for_pakistan:":                       "\U0001f1f5\U0001f1f0",
-	":flag_for_palau:":                          "\U0001f1f5\U0001f1fc",
-	":flag_for_palestinian_territories:":        "\U0001f1f5\U0001f1f8",
-	":flag_for_panama:":                         "\U0001f1f5\U0001f1e6",
-	":flag_for_papua_new_guinea:":               "\U0001f1f5\U0001f1ec",
-	":flag_for_paraguay:":                       "\U0001f1f5\U0001f1fe",
-	":flag_for_peru:":                           "\U0001f1f5\U0001f1ea",
-	":flag_for_philippines:":                    "\U0001f1f5\U0001f1ed",
-	":flag_for_pitcairn_islands:":               "\U0001f1f5\U0001f1f3",
-	":flag_for_poland:":                         "\U0001f1f5\U0001f1f1",
-	":flag_for_portugal:":                       "\U0001f1f5\U0001f1f9",
-	":flag_for_puerto_rico:":                    "\U0001f1f5\U0001f1f7",
-	":flag_for_qatar:":                          "\U0001f1f6\U0001f1e6",
-	":flag_for_reunion:":                        "\U0001f1f7\U0001f1ea",
-	":flag_for_romania:":                        "\U0001f1f7\U0001f1f4",
-	":flag_for_russia:":                         "\U0001f1f7\U0001f1fa",
-	":flag_for_rwanda:":                         "\U0001f1f7\U0001f1fc",
-	":flag_for_samoa:":                          "\U0001f1fc\U0001f1f8",
-	":flag_for_san_marino:":                     "\U0001f1f8\U0001f1f2",
-	":flag_for_sao_tome_and_principe:":          "\U0001f1f8\U0001f1f9",
-	":flag_for_saudi_arabia:":                   "\U0001f1f8\U0001f1e6",
-	":flag_for_scotland:":                       "\U0001f3f4\U000e0067\U000e0062\U000e0073\U000e0063\U000e0074\U000e007f",
-	":flag_for_senegal:":                        "\U0001f1f8\U0001f1f3",
-	":flag_for_serbia:":                         "\U0001f1f7\U0001f1f8",
-	":flag_for_seychelles:":                     "\U0001f1f8\U0001f1e8",
-	":flag_for_sierra_leone:":                   "\U0001f1f8\U0001f1f1",
-	":flag_for_singapore:":                      "\U0001f1f8\U0001f1ec",
-	":flag_for_sint_maarten:":                   "\U0001f1f8\U0001f1fd",
-	":flag_for_slovakia:":                       "\U0001f1f8\U0001f1f0",
-	":flag_for_slovenia:":                       "\U0001f1f8\U0001f1ee",
-	":flag_for_solomon_islands:":                "\U0001f1f8\U0001f1e7",
-	":flag_for_somalia:":                        "\U0001f1f8\U0001f1f4",
-	":flag_for_south_africa:":                   "\U0001f1ff\U0001f1e6",
-	":flag_for_south_georgia_and_south_sandwich_islands:": "\U0001f1ec\U0001f1f8",
-	":flag_for_south_korea:":                              "\U0001f1f0\U0001f1f7",
-	":flag_for_south_sudan:":                              "\U0001f1f8\U0001f1f8",
-	":flag_for_spain:":                                    "\U0001f1ea\U0001f1f8",
-	":flag_for_sri_lanka:":                                "\U0001f1f1\U0001f1f0",
-	":flag_for_st_barthelemy:":                            "\U0001f1e7\U0001f1f1",
-	":flag_for_st_helena:":                                "\U0001f1f8\U0001f1ed",
-	":flag_for_st_kitts_and_nevis:":                       "\U0001f1f0\U0001f1f3",
-	":flag_for_st_lucia:":                                 "\U0001f1f1\U0001f1e8",
-	":flag_for_st_martin:":                                "\U0001f1f2\U0001f1eb",
-	":flag_for_st_pierre_and_miquelon:":                   "\U0001f1f5\U0001f1f2",
-	":flag_for_st_vincent_and_grenadines:":                "\U0001f1fb\U0001f1e8",
-	":flag_for_sudan:":                                    "\U0001f1f8\U0001f1e9",
-	":flag_for_suriname:":                                 "\U0001f1f8\U0001f1f7",
-	":flag_for_svalbard_and_jan_mayen:":                   "\U0001f1f8\U0001f1ef",
-	":flag_for_sweden:":                                   "\U0001f1f8\U0001f1ea",
-	":flag_for_switzerland:":                              "\U0001f1e8\U0001f1ed",
-	":flag_for_syria:":                                    "\U0001f1f8\U0001f1fe",
-	":flag_for_taiwan:":                                   "\U0001f1f9\U0001f1fc",
-	":flag_for_tajikistan:":                               "\U0001f1f9\U0001f1ef",
-	":flag_for_tanzania:":                                 "\U0001f1f9\U0001f1ff",
-	":flag_for_thailand:":                                 "\U0001f1f9\U0001f1ed",
-	":flag_for_timor_leste:":                              "\U0001f1f9\U0001f1f1",
-	":flag_for_togo:":                                     "\U0001f1f9\U0001f1ec",
-	":flag_for_tokelau:":                                  "\U0001f1f9\U0001f1f0",
-	":flag_for_tonga:":                                    "\U0001f1f9\U0001f1f4",
-	":flag_for_trinidad_and_tobago:":                      "\U0001f1f9\U0001f1f9",
-	":flag_for_tristan_da_cunha:":                         "\U0001f1f9\U0001f1e6",
-	":flag_for_tunisia:":                                  "\U0001f1f9\U0001f1f3",
-	":flag_for_turkey:":                                   "\U0001f1f9\U0001f1f7",
-	":flag_for_turkmenistan:":                             "\U0001f1f9\U0001f1f2",
-	":flag_for_turks_and_caicos_islands:":                 "\U0001f1f9\U0001f1e8",
-	":flag_for_tuvalu:":                                   "\U0001f1f9\U0001f1fb",
-	":flag_for_uganda:":                                   "\U0001f1fa\U0001f1ec",
-	":flag_for_ukraine:":                                  "\U0001f1fa\U0001f1e6",
-	":flag_for_united_arab_emirates:":                     "\U0001f1e6\U0001f1ea",
-	":flag_for_united_kingdom:":                           "\U0001f1ec\U0001f1e7",
-	":flag_for_united_nations:":                           "\U0001f1fa\U0001f1f3",
-	":flag_for_united_states:":                            "\U0001f1fa\U0001f1f8",
-	":flag_for_uruguay:":                                  "\U0001f1fa\U0001f1fe",
-	":flag_for_us_outlying_islands:":                      "\U0001f1fa\U0001f1f2",
-	":flag_for_us_virgin_islands:":                        "\U0001f1fb\U0001f1ee",
-	":flag_for_uzbekistan:":                               "\U0001f1fa\U0001f1ff",
-	":flag_for_vanuatu:":                                  "\U0001f1fb\U0001f1fa",
-	":flag_for_vatican_city:":                             "\U0001f1fb\U0001f1e6",
-	":flag_for_venezuela:":                                "\U0001f1fb\U0001f1ea",
-	":flag_for_vietnam:":                                  "\U0001f1fb\U0001f1f3",
-	":flag_for_wales:":                                    "\U0001f3f4\U000e0067\U000e0062\U000e0077\U000e006c\U000e0073\U000e007f",
-	":flag_for_wallis_and_futuna:":                        "\U0001f1fc\U0001f1eb",
-	":flag_for_western_sahara:":                           "\U0001f1ea\U0001f1ed",
-	":flag_for_yemen:":                                    "\U0001f1fe\U0001f1ea",
-	":flag_for_zambia:":                                   "\U0001f1ff\U0001f1f2",
-	":flag_for_zimbabwe:":                                 "\U0001f1ff\U0001f1fc",
-	":flag_in_hole:":                                      "\u26f3",
-	":flags:":                                             "\U0001f38f",
-	":flamingo:":                                          "\U0001f9a9",
-	":flashlight:":                                        "\U0001f526",
-	":flat_shoe:":                                         "\U0001f97f",
-	":flatbread:":                                         "\U0001fad3",
-	":fleur_de_lis:":                                      "\u269c\ufe0f",
-	":flexed_biceps:":                                     "\U0001f4aa",
-	":flight_arrival:":                                    "\U0001f6ec",
-	":flight_departure:":                                  "\U0001f6eb",
-	":flipper:":                                           "\U0001f42c",
-	":floppy_disk:":                                       "\U0001f4be",
-	":flower_playing_cards:":                              "\U0001f3b4",
-	":flushed:":                                           "\U0001f633",
-	":flushed_face:":                                      "\U0001f633",
-	":fly:":                                               "\U0001fab0",
-	":flying_disc:":                                       "\U0001f94f",
-	":flying_saucer:":                                     "\U0001f6f8",
-	":fog:":                                               "\U0001f32b\ufe0f",
-	":foggy:":                                             "\U0001f301",
-	":folded_hands:":                                      "\U0001f64f",
-	":fondue:":                                            "\U0001fad5",
-	":foot:":                                              "\U0001f9b6",
-	":football:":                                          "\U0001f3c8",
-	":footprints:":                                        "\U0001f463",
-	":fork_and_knife:":                                    "\U0001f374",
-	":fork_and_knife_with_plate:":                         "\U0001f37d\ufe0f",
-	":fortune_cookie:":                                    "\U0001f960",
-	":fountain:":                                          "\u26f2",
-	":fountain_pen:":                                      "\U0001f58b\ufe0f",
-	":four:":                                              "4\ufe0f\u20e3",
-	":four_leaf_clover:":                                  "\U0001f340",
-	":four_o_clock:":                                      "\U0001f553",
-	":four_thirty:":                                       "\U0001f55f",
-	":fox:":                                               "\U0001f98a",
-	":fox_face:":                                          "\U0001f98a",
-	":fr:":                                                "\U0001f1eb\U0001f1f7",
-	":framed_picture:":                                    "\U0001f5bc\ufe0f",
-	":free:":                                              "\U0001f193",
-	":free_button:":                                       "\U0001f193",
-	":french_fries:":                                      "\U0001f35f",
-	":french_guiana:":                                     "\U0001f1ec\U0001f1eb",
-	":french_polynesia:":                                  "\U0001f1f5\U0001f1eb",
-	":french_southern_territories:":                       "\U0001f1f9\U0001f1eb",
-	":fried_egg:":                                         "\U0001f373",
-	":fried_shrimp:":                                      "\U0001f364",
-	":fries:":                                             "\U0001f35f",
-	":frog:":                                              "\U0001f438",
-	":front_facing_baby_chick:":                           "\U0001f425",
-	":frowning:":                                          "\U0001f626",
-	":frowning_face:":                                     "\u2639\ufe0f",
-	":frowning_face_with_open_mouth:":                     "\U0001f626",
-	":frowning_man:":                                      "\U0001f64d\u200d\u2642\ufe0f",
-	":frowning_person:":                                   "\U0001f64d",
-	":frowning_woman:":                                    "\U0001f64d\u200d\u2640\ufe0f",
-	":fu:":                                                "\U0001f595",
-	":fuel_pump:":                                         "\u26fd",
-	":fuelpump:":                                          "\u26fd",
-	":full_moon:":                                         "\U0001f315",
-	":full_moon_face:":                                    "\U0001f31d",
-	":full_moon_with_face:":                               "\U0001f31d",
-	":funeral_urn:":                                       "\u26b1\ufe0f",
-	":gabon:":                                             "\U0001f1ec\U0001f1e6",
-	":gambia:":                                            "\U0001f1ec\U0001f1f2",
-	":game_die:":                                          "\U0001f3b2",
-	":garlic:":                                            "\U0001f9c4",
-	":gb:":                                                "\U0001f1ec\U0001f1e7",
-	":gear:":                                              "\u2699\ufe0f",
-	":gem:":                                               "\U0001f48e",
-	":gem_stone:":                                         "\U0001f48e",
-	":gemini:":                                            "\u264a",
-	":genie:":                                             "\U0001f9de",
-	":genie_man:":                                         "\U0001f9de\u200d\u2642\ufe0f",
-	":genie_woman:":                                       "\U0001f9de\u200d\u2640\ufe0f",
-	":georgia:":                                           "\U0001f1ec\U0001f1ea",
-	":ghana:":                                             "\U0001f1ec\U0001f1ed",
-	":ghost:":                                             "\U0001f47b",
-	":gibraltar:":                                         "\U0001f1ec\U0001f1ee",
-	":gift:":                                              "\U0001f381",
-	":gift_heart:":                                        "\U0001f49d",
-	":giraffe:":                                           "\U0001f992",
-	":girl:":                                              "\U0001f467",
-	":glass_of_milk:":                                     "\U0001f95b",
-	":glasses:":                                           "\U0001f453",
-	":globe_showing_americas:":                            "\U0001f30e",
-	":globe_showing_asia_australia:":                      "\U0001f30f",
-	":globe_showing_europe_africa:":                       "\U0001f30d",
-	":globe_with_meridians:":                              "\U0001f310",
-	":gloves:":                                            "\U0001f9e4",
-	":glowing_star:":                                      "\U0001f31f",
-	":goal_net:":                                          "\U0001f945",
-	":goat:":                                              "\U0001f410",
-	":goblin:":                                            "\U0001f47a",
-	":goggles:":                                           "\U0001f97d",
-	":golf:":                                              "\u26f3",
-	":golfing:":                                           "\U0001f3cc\ufe0f",
-	":golfing_man:":                                       "\U0001f3cc\ufe0f\u200d\u2642\ufe0f",
-	":golfing_woman:":                                     "\U0001f3cc\ufe0f\u200d\u2640\ufe0f",
-	":gorilla:":                                           "\U0001f98d",
-	":graduation_cap:":                                    "\U0001f393",
-	":grapes:":                                            "\U0001f347",
-	":greece:":                                            "\U0001f1ec\U0001f1f7",
-	":green_apple:":                                       "\U0001f34f",
-	":green_book:":                                        "\U0001f4d7",
-	":green_circle:":                                      "\U0001f7e2",
-	":green_heart:":                                       "\U0001f49a",
-	":green_salad:":                                       "\U0001f957",
-	":green_square:":                                      "\U0001f7e9",
-	":greenland:":                                         "\U0001f1ec\U0001f1f1",
-	":grenada:":                                           "\U0001f1ec\U0001f1e9",
-	":grey_exclamation:":                                  "\u2755",
-	":grey_question:":                                     "\u2754",
-	":grimacing:":                                         "\U0001f62c",
-	":grimacing_face:":                                    "\U0001f62c",
-	":grin:":                                              "\U0001f601",
-	":grinning:":                                          "\U0001f600",
-	":grinning_cat:":                                      "\U0001f63a",
-	":grinning_cat_with_smiling_eyes:":                    "\U0001f638",
-	":grinning_face:":                                     "\U0001f600",
-	":grinning_face_with_big_eyes:":                       "\U0001f603",
-	":grinning_face_with_smiling_eyes:":                   "\U0001f604",
-	":grinning_face_with_sweat:":                          "\U0001f605",
-	":grinning_squinting_face:":                           "\U0001f606",
-	":growing_heart:":                                     "\U0001f497",
-	":guadeloupe:":                                        "\U0001f1ec\U0001f1f5",
-	":guam:":                                              "\U0001f1ec\U0001f1fa",
-	":guard:":                                             "\U0001f482",
-	":guardsman:":                                         "\U0001f482\u200d\u2642\ufe0f",
-	":guardswoman:":                                       "\U0001f482\u200d\u2640\ufe0f",
-	":guatemala:":                                         "\U0001f1ec\U0001f1f9",
-	":guernsey:":                                          "\U0001f1ec\U0001f1ec",
-	":guide_dog:":                                         "\U0001f9ae",
-	":guinea:":                                            "\U0001f1ec\U0001f1f3",
-	":guinea_bissau:":                                     "\U0001f1ec\U0001f1fc",
-	":guitar:":                                            "\U0001f3b8",
-	":gun:":                                               "\U0001f52b",
-	":guyana:":                                            "\U0001f1ec\U0001f1fe",
-	":haircut:":                                           "\U0001f487",
-	":haircut_man:":                                       "\U0001f487\u200d\u2642\ufe0f",
-	":haircut_woman:":                                     "\U0001f487\u200d\u2640\ufe0f",
-	":haiti:":                                             "\U0001f1ed\U0001f1f9",
-	":hamburger:":                                         "\U0001f354",
-	":hammer:":                                            "\U0001f528",
-	":hammer_and_pick:":                                   "\u2692\ufe0f",
-	":hammer_and_wrench:":                                 "\U0001f6e0\ufe0f",
-	":hamsa:":                                             "\U0001faac",
-	":hamster:":                                           "\U0001f439",
-	":hand:":                                              "\u270b",
-	":hand_over_mouth:":                                   "\U0001f92d",
-	":hand_with_fingers_splayed:":                         "\U0001f590\ufe0f",
-	":hand_with_index_finger_and_thumb_crossed:": "\U0001faf0",
-	":handbag:":                              "\U0001f45c",
-	":handball_person:":                      "\U0001f93e",
-	":handshake:":                            "\U0001f91d",
-	":hankey:":                               "\U0001f4a9",
-	":hash:":                                 "#\ufe0f\u20e3",
-	":hatched_chick:":                        "\U0001f425",
-	":hatching_chick:":                       "\U0001f423",
-	":headphone:":                            "\U0001f3a7",
-	":headphones:":                           "\U0001f3a7",
-	":headstone:":                            "\U0001faa6",
-	":health_worker:":                        "\U0001f9d1\u200d\u2695\ufe0f",
-	":hear_no_evil:":                         "\U0001f649",
-	":hear_no_evil_monkey:":                  "\U0001f649",
-	":heard_mcdonald_islands:":               "\U0001f1ed\U0001f1f2",
-	":heart:":                                "\u2764\ufe0f",
-	":heart_decoration:":                     "\U0001f49f",
-	":heart_exclamation:":                    "\u2763\ufe0f",
-	":heart_eyes:":                           "\U0001f60d",
-	":heart_eyes_cat:":                       "\U0001f63b",
-	":heart_hands:":                          "\U0001faf6",
-	":heart_on_fire:":                        "\u2764\ufe0f\u200d\U0001f525",
-	":heart_suit:":                           "\u2665\ufe0f",
-	":heart_with_arrow:":                     "\U0001f498",
-	":heart_with_ribbon:":                    "\U0001f49d",
-	":heartbeat:":                            "\U0001f493",
-	":heartpulse:":                           "\U0001f497",
-	":hearts:":                               "\u2665\ufe0f",
-	":heavy_check_mark:":                     "\u2714\ufe0f",
-	":heavy_division_sign:":                  "\u2797",
-	":heavy_dollar_sign:":                    "\U0001f4b2",
-	":heavy_equals_sign:":                    "\U0001f7f0",
-	":heavy_exclamation_mark:":               "\u2757",
-	":heavy_heart_exclamation:":              "\u2763\ufe0f",
-	":heavy_minus_sign:":                     "\u2796",
-	":heavy_multiplication_x:":               "\u2716\ufe0f",
-	":heavy_plus_sign:":                      "\u2795",
-	":hedgehog:":                             "\U0001f994",
-	":helicopter:":                           "\U0001f681",
-	":herb:":                                 "\U0001f33f",
-	":hibiscus:":                             "\U0001f33a",
-	":high_brightness:":                      "\U0001f506",
-	":high_heel:":                            "\U0001f460",
-	":high_heeled_shoe:":                     "\U0001f460",
-	":high_speed_train:":                     "\U0001f684",
-	":high_voltage:":                         "\u26a1",
-	":hiking_boot:":                          "\U0001f97e",
-	":hindu_temple:":                         "\U0001f6d5",
-	":hippopotamus:":                         "\U0001f99b",
-	":hocho:":                                "\U0001f52a",
-	":hole:":                                 "\U0001f573\ufe0f",
-	":hollow_red_circle:":                    "\u2b55",
-	":honduras:":                             "\U0001f1ed\U0001f1f3",
-	":honey_pot:":                            "\U0001f36f",
-	":honeybee:":                             "\U0001f41d",
-	":hong_kong:":                            "\U0001f1ed\U0001f1f0",
-	":hook:":                                 "\U0001fa9d",
-	":horizontal_traffic_light:":             "\U0001f6a5",
-	":horse:":                                "\U0001f434",
-	":horse_face:":                           "\U0001f434",
-	":horse_racing:":                         "\U0001f3c7",
-	":hospital:":                             "\U0001f3e5",
-	":hot_beverage:":                         "\u2615",
-	":hot_dog:":                              "\U0001f32d",
-	":hot_face:":                             "\U0001f975",
-	":hot_pepper:":                           "\U0001f336\ufe0f",
-	":hot_springs:":                          "\u2668\ufe0f",
-	":hotdog:":                               "\U0001f32d",
-	":hotel:":                                "\U0001f3e8",
-	":hotsprings:":                           "\u2668\ufe0f",
-	":hourglass:":                            "\u231b",
-	":hourglass_done:":                       "\u231b",
-	":hourglass_flowing_sand:":               "\u23f3",
-	":hourglass_not_done:":                   "\u23f3",
-	":house:":                                "\U0001f3e0",
-	":house_with_garden:":                    "\U0001f3e1",
-	":houses:":                               "\U0001f3d8\ufe0f",
-	":hugs:":                                 "\U0001f917",
-	":hundred_points:":                       "\U0001f4af",
-	":hungary:":                              "\U0001f1ed\U0001f1fa",
-	":hushed:":                               "\U0001f62f",
-	":hushed_face:":                          "\U0001f62f",
-	":hut:":                                  "\U0001f6d6",
-	":ice:":                                  "\U0001f9ca",
-	":ice_cream:":                            "\U0001f368",
-	":ice_cube:":                             "\U0001f9ca",
-	":ice_hockey:":                           "\U0001f3d2",
-	":ice_skate:":                            "\u26f8\ufe0f",
-	":icecream:":                             "\U0001f366",
-	":iceland:":                              "\U0001f1ee\U0001f1f8",
-	":id:":                                   "\U0001f194",
-	":id_button:":                            "\U0001f194",
-	":identification_card:":                  "\U0001faaa",
-	":ideograph_advantage:":                  "\U0001f250",
-	":imp:":                                  "\U0001f47f",
-	":inbox_tray:":                           "\U0001f4e5",
-	":incoming_envelope:":                    "\U0001f4e8",
-	":index_pointing_at_the_viewer:":         "\U0001faf5",
-	":index_pointing_up:":                    "\u261d\ufe0f",
-	":india:":                                "\U0001f1ee\U0001f1f3",
-	":indonesia:":                            "\U0001f1ee\U0001f1e9",
-	":infinity:":                             "\u267e\ufe0f",
-	":information:":                          "\u2139\ufe0f",
-	":information_desk_person:":              "\U0001f481",
-	":information_source:":                   "\u2139\ufe0f",
-	":innocent:":                             "\U0001f607",
-	":input_latin_letters:":                  "\U0001f524",
-	":input_latin_lowercase:":                "\U0001f521",
-	":input_latin_uppercase:":                "\U0001f520",
-	":input_numbers:":                        "\U0001f522",
-	":input_symbols:":                        "\U0001f523",
-	":interrobang:":                          "\u2049\ufe0f",
-	":iphone:":                               "\U0001f4f1",
-	":iran:":                                 "\U0001f1ee\U0001f1f7",
-	":iraq:":                                 "\U0001f1ee\U0001f1f6",
-	":ireland:":                              "\U0001f1ee\U0001f1ea",
-	":isle_of_man:":                          "\U0001f1ee\U0001f1f2",
-	":israel:":                               "\U0001f1ee\U0001f1f1",
-	":it:":                                   "\U0001f1ee\U0001f1f9",
-	":izakaya_lantern:":                      "\U0001f3ee",
-	":jack_o_lantern:":                       "\U0001f383",
-	":jamaica:":                              "\U0001f1ef\U0001f1f2",
-	":japan:":                                "\U0001f5fe",
-	":japanese_acceptable_button:":           "\U0001f251",
-	":japanese_application_button:":          "\U0001f238",
-	":japanese_bargain_button:":              "\U0001f250",
-	":japanese_castle:":                      "\U0001f3ef",
-	":japanese_congratulations_button:":      "\u3297\ufe0f",
-	":japanese_discount_button:":             "\U0001f239",
-	":japanese_dolls:":                       "\U0001f38e",
-	":japanese_free_of_charge_button:":       "\U0001f21a",
-	":japanese_goblin:":                      "\U0001f47a",
-	":japanese_here_button:":                 "\U0001f201",
-	":japanese_monthly_amount_button:":       "\U0001f237\ufe0f",
-	":japanese_no_vacancy_button:":           "\U0001f235",
-	":japanese_not_free_of_charge_button:":   "\U0001f236",
-	":japanese_ogre:":                        "\U0001f479",
-	":japanese_open_for_business_button:":    "\U0001f23a",
-	":japanese_passing_grade_button:":        "\U0001f234",
-	":japanese_post_office:":                 "\U0001f3e3",
-	":japanese_prohibited_button:":           "\U0001f232",
-	":japanese_reserved_button:":             "\U0001f22f",
-	":japanese_secret_button:":               "\u3299\ufe0f",
-	":japanese_service_charge_button:":       "\U0001f202\ufe0f",
-	":japanese_symbol_for_beginner:":         "\U0001f530",
-	":japanese_vacancy_button:":              "\U0001f233",
-	":jar:":                                  "\U0001fad9",
-	":jeans:":                                "\U0001f456",
-	":jersey:":                               "\U0001f1ef\U0001f1ea",
-	":jigsaw:":                               "\U0001f9e9",
-	":joker:":                                "\U0001f0cf",
-	":jordan:":                               "\U0001f1ef\U0001f1f4",
-	":joy:":                                  "\U0001f602",
-	":joy_cat:":                              "\U0001f639",
-	":joystick:":                             "\U0001f579\ufe0f",
-	":jp:":                                   "\U0001f1ef\U0001f1f5",
-	":judge:":                                "\U0001f9d1\u200d\u2696\ufe0f",
-	":juggling_person:":                      "\U0001f939",
-	":kaaba:":                                "\U0001f54b",
-	":kangaroo:":                             "\U0001f998",
-	":kazakhstan:":                           "\U0001f1f0\U0001f1ff",
-	":kenya:":                                "\U0001f1f0\U0001f1ea",
-	":key:":                                  "\U0001f511",
-	":keyboard:":                             "\u2328\ufe0f",
-	":keycap_0:":                             "0\ufe0f\u20e3",
-	":keycap_10:":                            "\U0001f51f",
-	":keycap_1:":                             "1\ufe0f\u20e3",
-	":keycap_2:":                             "2\ufe0f\u20e3",
-	":keycap_3:":                             "3\ufe0f\u20e3",
-	":keycap_4:":                             "4\ufe0f\u20e3",
-	":keycap_5:":                             "5\ufe0f\u20e3",
-	":keycap_6:":                             "6\ufe0f\u20e3",
-	":keycap_7:":                             "7\ufe0f\u20e3",
-	":keycap_8:":                             "8\ufe0f\u20e3",
-	":keycap_9:":                             "9\ufe0f\u20e3",
-	":keycap_asterisk:":                      "*\ufe0f\u20e3",
-	":keycap_hash:":                          "#\ufe0f\u20e3",
-	":keycap_ten:":                           "\U0001f51f",
-	":kick_scooter:":                         "\U0001f6f4",
-	":kimono:":                               "\U0001f458",
-	":kiribati:":                             "\U0001f1f0\U0001f1ee",
-	":kiss:":                                 "\U0001f48b",
-	":kiss_man_man:":                         "\U0001f468\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468",
-	":kiss_mark:":                            "\U0001f48b",
-	":kiss_person_person:":                   "\U0001f9d1\U0001f3fb\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f9d1\U0001f3fc",
-	":kiss_woman_man:":                       "\U0001f469\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468",
-	":kiss_woman_woman:":                     "\U0001f469\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f469",
-	":kissing:":                              "\U0001f617",
-	":kissing_cat:":                          "\U0001f63d",
-	":kissing_closed_eyes:":                  "\U0001f61a",
-	":kissing_face:":                         "\U0001f617",
-	":kissing_face_with_closed_eyes:":        "\U0001f61a",
-	":kissing_face_with_smiling_eyes:":       "\U0001f619",
-	":kissing_heart:":                        "\U0001f618",
-	":kissing_smiling_eyes:":                 "\U0001f619",
-	":kitchen_knife:":                        "\U0001f52a",
-	":kite:":                                 "\U0001fa81",
-	":kiwi_fruit:":                           "\U0001f95d",
-	":kneeling_man:":                         "\U0001f9ce\u200d\u2642\ufe0f",
-	":kneeling_person:":                      "\U0001f9ce",
-	":kneeling_woman:":                       "\U0001f9ce\u200d\u2640\ufe0f",
-	":knife:":                                "\U0001f52a",
-	":knot:":                                 "\U0001faa2",
-	":koala:":                                "\U0001f428",
-	":koko:":                                 "\U0001f201",
-	":kosovo:":                               "\U0001f1fd\U0001f1f0",
-	":kr:":                                   "\U0001f1f0\U0001f1f7",
-	":kuwait:":                               "\U0001f1f0\U0001f1fc",
-	":kyrgyzstan:":                           "\U0001f1f0\U0001f1ec",
-	":lab_coat:":                             "\U0001f97c",
-	":label:":                                "\U0001f3f7\ufe0f",
-	":lacrosse:":                             "\U0001f94d",
-	":ladder:":                               "\U0001fa9c",
-	":lady_beetle:":                          "\U0001f41e",
-	":lantern:":                              "\U0001f3ee",
-	":laos:":                                 "\U0001f1f1\U0001f1e6",
-	":laptop:":                               "\U0001f4bb",
-	":large_blue_circle:":                    "\U0001f535",
-	":large_blue_diamond:":                   "\U0001f537",
-	":large_orange_diamond:":                 "\U0001f536",
-	":last_quarter_moon:":                    "\U0001f317",
-	":last_quarter_moon_face:":               "\U0001f31c",
-	":last_quarter_moon_with_face:":          "\U0001f31c",
-	":last_track_button:":                    "\u23ee\ufe0f",
-	":latin_cross:":                          "\u271d\ufe0f",
-	":latvia:":                               "\U0001f1f1\U0001f1fb",
-	":laughing:":                             "\U0001f606",
-	":leaf_fluttering_in_wind:":              "\U0001f343",
-	":leafy_green:":                          "\U0001f96c",
-	":leaves:":                               "\U0001f343",
-	":lebanon:":                              "\U0001f1f1\U0001f1e7",
-	":ledger:":                               "\U0001f4d2",
-	":left_arrow:":                           "\u2b05\ufe0f",
-	":left_arrow_curving_right:":             "\u21aa\ufe0f",
-	":left_facing_fist:":                     "\U0001f91b",
-	":left_luggage:":                         "\U0001f6c5",
-	":left_right_arrow:":                     "\u2194\ufe0f",
-	":left_speech_bubble:":                   "\U0001f5e8\ufe0f",
-	":leftwards_arrow_with_hook:":            "\u21a9\ufe0f",
-	":leftwards_hand:":                       "\U0001faf2",
-	":leg:":                                  "\U0001f9b5",
-	":lemon:":                                "\U0001f34b",
-	":leo:":                                  "\u264c",
-	":leopard:":                              "\U0001f406",
-	":lesotho:":                              "\U0001f1f1\U0001f1f8",
-	":level_slider:":                         "\U0001f39a\ufe0f",
-	":liberia:":                              "\U0001f1f1\U0001f1f7",
-	":libra:":                                "\u264e",
-	":libya:":                                "\U0001f1f1\U0001f1fe",
-	":liechtenstein:":                        "\U0001f1f1\U0001f1ee",
-	":light_bulb:":                           "\U0001f4a1",
-	":light_rail:":                           "\U0001f688",
-	":light_skin_tone:":                      "\U0001f3fb",
-	":link:":                                 "\U0001f517",
-	":linked_paperclips:":                    "\U0001f587\ufe0f",
-	":lion:":                                 "\U0001f981",
-	":lips:":                                 "\U0001f444",
-	":lipstick:":                             "\U0001f484",
-	":lithuania:":                            "\U0001f1f1\U0001f1f9",
-	":litter_in_bin_sign:":                   "\U0001f6ae",
-	":lizard:":                               "\U0001f98e",
-	":llama:":                                "\U0001f999",
-	":lobster:":                              "\U0001f99e",
-	":lock:":                                 "\U0001f512",
-	":lock_with_ink_pen:":                    "\U0001f50f",
-	":locked:":                               "\U0001f512",
-	":locked_with_key:":                      "\U0001f510",
-	":locked_with_pen:":                      "\U0001f50f",
-	":locomotive:":                           "\U0001f682",
-	":lollipop:":                             "\U0001f36d",
-	":long_drum:":                            "\U0001fa98",
-	":loop:":                                 "\u27bf",
-	":lotion_bottle:":                        "\U0001f9f4",
-	":lotus:":                                "\U0001fab7",
-	":lotus_position:":                       "\U0001f9d8",
-	":lotus_position_man:":                   "\U0001f9d8\u200d\u2642\ufe0f",
-	":lotus_position_woman:":                 "\U0001f9d8\u200d\u2640\ufe0f",
-	":loud_sound:":                           "\U0001f50a",
-	":loudly_crying_face:":                   "\U0001f62d",
-	":loudspeaker:":                          "\U0001f4e2",
-	":love_hotel:":                           "\U0001f3e9",
-	":love_letter:":                          "\U0001f48c",
-	":love_you_gesture:":                     "\U0001f91f",
-	":low_battery:":                          "\U0001faab",
-	":low_brightness:":                       "\U0001f505",
-	":luggage:":                              "\U0001f9f3",
-	":lungs:":                                "\U0001fac1",
-	":luxembourg:":                           "\U0001f1f1\U0001f1fa",
-	":lying_face:":                           "\U0001f925",
-	":m:":                                    "\u24c2\ufe0f",
-	":macau:":                                "\U0001f1f2\U0001f1f4",
-	":macedonia:":                            "\U0001f1f2\U0001f1f0",
-	":madagascar:":                           "\U0001f1f2\U0001f1ec",
-	":mag:":                                  "\U0001f50d",
-	":mag_right:":                            "\U0001f50e",
-	":mage:":                                 "\U0001f9d9",
-	":mage_man:":                             "\U0001f9d9\u200d\u2642\ufe0f",
-	":mage_woman:":                           "\U0001f9d9\u200d\u2640\ufe0f",
-	":magic_wand:":                           "\U0001fa84",
-	":magnet:":                               "\U0001f9f2",
-	":magnifying_glass_tilted_left:":         "\U0001f50d",
-	":magnifying_glass_tilted_right:":        "\U0001f50e",
-	":mahjong:":                              "\U0001f004",
-	":mahjong_red_dragon:":                   "\U0001f004",
-	":mailbox:":                              "\U0001f4eb",
-	":mailbox_closed:":                       "\U0001f4ea",
-	":mailbox_with_mail:":                    "\U0001f4ec",
-	":mailbox_with_no_mail:":                 "\U0001f4ed",
-	":malawi:":                               "\U0001f1f2\U0001f1fc",
-	":malaysia:":                             "\U0001f1f2\U0001f1fe",
-	":maldives:":                             "\U0001f1f2\U0001f1fb",
-	":male_detective:":                       "\U0001f575\ufe0f\u200d\u2642\ufe0f",
-	":male_sign:":                            "\u2642\ufe0f",
-	":mali:":                                 "\U0001f1f2\U0001f1f1",
-	":malta:":                                "\U0001f1f2\U0001f1f9",
-	":mammoth:":                              "\U0001f9a3",
-	":man:":                                  "\U0001f468",
-	":man_artist:":                           "\U0001f468\u200d\U0001f3a8",
-	":man_astronaut:":                        "\U0001f468\u200d\U0001f680",
-	":man_bald:":                             "\U0001f468\u200d\U0001f9b2",
-	":man_beard:":                            "\U0001f9d4\u200d\u2642\ufe0f",
-	":man_biking:":                           "\U0001f6b4\u200d\u2642\ufe0f",
-	":man_bouncing_ball:":                    "\u26f9\ufe0f\u200d\u2642\ufe0f",
-	":man_bowing:":                           "\U0001f647\u200d\u2642\ufe0f",
-	":man_cartwheeling:":                     "\U0001f938\u200d\u2642\ufe0f",
-	":man_climbing:":                         "\U0001f9d7\u200d\u2642\ufe0f",
-	":man_construction_worker:":              "\U0001f477\u200d\u2642\ufe0f",
-	":man_cook:":                             "\U0001f468\u200d\U0001f373",
-	":man_dancing:":                          "\U0001f57a",
-	":man_detective:":                        "\U0001f575\ufe0f\u200d\u2642\ufe0f",
-	":man_elf:":                              "\U0001f9dd\u200d\u2642\ufe0f",
-	":man_facepalming:":                      "\U0001f926\u200d\u2642\ufe0f",
-	":man_factory_worker:":                   "\U0001f468\u200d\U0001f3ed",
-	":man_fairy:":                            "\U0001f9da\u200d\u2642\ufe0f",
-	":man_farmer:":                           "\U0001f468\u200d\U0001f33e",
-	":man_feeding_baby:":                     "\U0001f468\u200d\U0001f37c",
-	":man_firefighter:":                      "\U0001f468\u200d\U0001f692",
-	":man_frowning:":                         "\U0001f64d\u200d\u2642\ufe0f",
-	":man_genie:":                            "\U0001f9de\u200d\u2642\ufe0f",
-	":man_gesturing_no:":                     "\U0001f645\u200d\u2642\ufe0f",
-	":man_gesturing_ok:":                     "\U0001f646\u200d\u2642\ufe0f",
-	":man_getting_haircut:":                  "\U0001f487\u200d\u2642\ufe0f",
-	":man_getting_massage:":                  "\U0001f486\u200d\u2642\ufe0f",
-	":man_golfing:":                          "\U0001f3cc\ufe0f\u200d\u2642\ufe0f",
-	":man_guard:":                            "\U0001f482\u200d\u2642\ufe0f",
-	":man_health_worker:":                    "\U0001f468\u200d\u2695\ufe0f",
-	":man_in_lotus_position:":                "\U0001f9d8\u200d\u2642\ufe0f",
-	":man_in_manual_wheelchair:":             "\U0001f468\u200d\U0001f9bd",
-	":man_in_motorized_wheelchair:":          "\U0001f468\u200d\U0001f9bc",
-	":man_in_steamy_room:":                   "\U0001f9d6\u200d\u2642\ufe0f",
-	":man_in_tuxedo:":                        "\U0001f935\u200d\u2642\ufe0f",
-	":man_judge:":                            "\U0001f468\u200d\u2696\ufe0f",
-	":man_juggling:":                         "\U0001f939\u200d\u2642\ufe0f",
-	":man_kneeling:":                         "\U0001f9ce\u200d\u2642\ufe0f",
-	":man_lifting_weights:":                  "\U0001f3cb\ufe0f\u200d\u2642\ufe0f",
-	":man_mage:":                             "\U0001f9d9\u200d\u2642\ufe0f",
-	":man_mechanic:":                         "\U0001f468\u200d\U0001f527",
-	":man_mountain_biking:":                  "\U0001f6b5\u200d\u2642\ufe0f",
-	":man_office_worker:":                    "\U0001f468\u200d\U0001f4bc",
-	":man_pilot:":                            "\U0001f468\u200d\u2708\ufe0f",
-	":man_playing_handball:":                 "\U0001f93e\u200d\u2642\ufe0f",
-	":man_playing_water_polo:":               "\U0001f93d\u200d\u2642\ufe0f",
-	":man_police_officer:":                   "\U0001f46e\u200d\u2642\ufe0f",
-	":man_pouting:":                          "\U0001f64e\u200d\u2642\ufe0f",
-	":man_raising_hand:":                     "\U0001f64b\u200d\u2642\ufe0f",
-	":man_rowing_boat:":                      "\U0001f6a3\u200d\u2642\ufe0f",
-	":man_running:":                          "\U0001f3c3\u200d\u2642\ufe0f",
-	":man_s_shoe:":                           "\U0001f45e",
-	":man_scientist:":                        "\U0001f468\u200d\U0001f52c",
-	":man_shrugging:":                        "\U0001f937\u200d\u2642\ufe0f",
-	":man_singer:":                           "\U0001f468\u200d\U0001f3a4",
-	":man_standing:":                         "\U0001f9cd\u200d\u2642\ufe0f",
-	":man_student:":                          "\U0001f468\u200d\U0001f393",
-	":man_superhero:":                        "\U0001f9b8\u200d\u2642\ufe0f",
-	":man_supervillain:":                     "\U0001f9b9\u200d\u2642\ufe0f",
-	":man_surfing:":                          "\U0001f3c4\u200d\u2642\ufe0f",
-	":man_swimming:":                         "\U0001f3ca\u200d\u2642\ufe0f",
-	":man_teacher:":                          "\U0001f468\u200d\U0001f3eb",
-	":man_technologist:":                     "\U0001f468\u200d\U0001f4bb",
-	":man_tipping_hand:":                     "\U0001f481\u200d\u2642\ufe0f",
-	":man_vampire:":                          "\U0001f9db\u200d\u2642\ufe0f",
-	":man_walking:":                          "\U0001f6b6\u200d\u2642\ufe0f",
-	":man_wearing_turban:":                   "\U0001f473\u200d\u2642\ufe0f",
-	":man_with_beard:":                       "\U0001f9d4\u200d\u2642\ufe0f",
-	":man_with_blond_hair:":                  "\U0001f471\u200d\u2642\ufe0f",
-	":man_with_curly_hair:":                  "\U0001f468\u200d\U0001f9b1",
-	":man_with_gua_pi_mao:":                  "\U0001f472",
-	":man_with_probing_cane:":                "\U0001f468\u200d\U0001f9af",
-	":man_with_red_hair:":                    "\U0001f468\u200d\U0001f9b0",
-	":man_with_turban:":                      "\U0001f473\u200d\u2642\ufe0f",
-	":man_with_veil:":                        "\U0001f470\u200d\u2642\ufe0f",
-	":man_with_white_cane:":                  "\U0001f468\u200d\U0001f9af",
-	":man_with_white_hair:":                  "\U0001f468\u200d\U0001f9b3",
-	":man_zombie:":                           "\U0001f9df\u200d\u2642\ufe0f",
-	":mandarin:":                             "\U0001f34a",
-	":mango:":                                "\U0001f96d",
-	":mans_shoe:":                            "\U0001f45e",
-	":mantelpiece_clock:":                    "\U0001f570\ufe0f",
-	":manual_wheelchair:":                    "\U0001f9bd",
-	":map_of_japan:":                         "\U0001f5fe",
-	":maple_leaf:":                           "\U0001f341",
-	":marshall_islands:":                     "\U0001f1f2\U0001f1ed",
-	":martial_arts_uniform:":                 "\U0001f94b",
-	":martinique:":                           "\U0001f1f2\U0001f1f6",
-	":mask:":                                 "\U0001f637",
-	":massage:":                              "\U0001f486",
-	":massage_man:":                          "\U0001f486\u200d\u2642\ufe0f",
-	":massage_woman:":                        "\U0001f486\u200d\u2640\ufe0f",
-	":mate:":                                 "\U0001f9c9",
-	":mauritania:":                           "\U0001f1f2\U0001f1f7",
-	":mauritius:":                            "\U0001f1f2\U0001f1fa",
-	":mayotte:":                              "\U0001f1fe\U0001f1f9",
-	":meat_on_bone:":                         "\U0001f356",
-	":mechanic:":                             "\U0001f9d1\u200d\U0001f527",
-	":mechanical_arm:":                       "\U0001f9be",
-	":mechanical_leg:":                       "\U0001f9bf",
-	":medal_military:":                       "\U0001f396\ufe0f",
-	":medal_sports:":                         "\U0001f3c5",
-	":medical_symbol:":                       "\u2695\ufe0f",
-	":medium_dark_skin_tone:":                "\U0001f3fe",
-	":medium_light_skin_tone:":               "\U0001f3fc",
-	":medium_skin_tone:":                     "\U0001f3fd",
-	":mega:":                                 "\U0001f4e3",
-	":megaphone:":                            "\U0001f4e3",
-	":melon:":                                "\U0001f348",
-	":melting_face:":                         "\U0001fae0",
-	":memo:":                                 "\U0001f4dd",
-	":men_holding_hands:":                    "\U0001f46c",
-	":men_s_room:":                           "\U0001f6b9",
-	":men_with_bunny_ears:":                  "\U0001f46f\u200d\u2642\ufe0f",
-	":men_wrestling:":                        "\U0001f93c\u200d\u2642\ufe0f",
-	":mending_heart:":                        "\u2764\ufe0f\u200d\U0001fa79",
-	":menorah:":                              "\U0001f54e",
-	":mens:":                                 "\U0001f6b9",
-	":mermaid:":                              "\U0001f9dc\u200d\u2640\ufe0f",
-	":merman:":                               "\U0001f9dc\u200d\u2642\ufe0f",
-	":merperson:":                            "\U0001f9dc",
-	":metal:":                                "\U0001f918",
-	":metro:":                                "\U0001f687",
-	":mexico:":                               "\U0001f1f2\U0001f1fd",
-	":microbe:":                              "\U0001f9a0",
-	":micronesia:":                           "\U0001f1eb\U0001f1f2",
-	":microphone:":                           "\U0001f3a4",
-	":microscope:":                           "\U0001f52c",
-	":middle_finger:":                        "\U0001f595",
-	":military_helmet:":                      "\U0001fa96",
-	":military_medal:":                       "\U0001f396\ufe0f",
-	":milk_glass:":                           "\U0001f95b",
-	":milky_way:":                            "\U0001f30c",
-	":minibus:":                              "\U0001f690",
-	":minidisc:":                             "\U0001f4bd",
-	":minus:":                                "\u2796",
-	":mirror:":                               "\U0001fa9e",
-	":mirror_ball:":                          "\U0001faa9",
-	":moai:":                                 "\U0001f5ff",
-	":mobile_phone:":                         "\U0001f4f1",
-	":mobile_phone_off:":                     "\U0001f4f4",
-	":mobile_phone_with_arrow:":              "\U0001f4f2",
-	":moldova:":                              "\U0001f1f2\U0001f1e9",
-	":monaco:":                               "\U0001f1f2\U0001f1e8",
-	":money_bag:":                            "\U0001f4b0",
-	":money_mouth_face:":                     "\U0001f911",
-	":money_with_wings:":                     "\U0001f4b8",
-	":moneybag:":                             "\U0001f4b0",
-	":mongolia:":                             "\U0001f1f2\U0001f1f3",
-	":monkey:":                               "\U0001f412",
-	":monkey_face:":                          "\U0001f435",
-	":monocle_face:":                         "\U0001f9d0",
-	":monorail:":                             "\U0001f69d",
-	":montenegro:":                           "\U0001f1f2\U0001f1ea",
-	":montserrat:":                           "\U0001f1f2\U0001f1f8",
-	":moon:":                                 "\U0001f314",
-	":moon_cake:":                            "\U0001f96e",
-	":moon_viewing_ceremony:":                "\U0001f391",
-	":morocco:":                              "\U0001f1f2\U0001f1e6",
-	":mortar_board:":                         "\U0001f393",
-	":mosque:":                               "\U0001f54c",
-	":mosquito:":                             "\U0001f99f",
-	":motor_boat:":                           "\U0001f6e5\ufe0f",
-	":motor_scooter:":                        "\U0001f6f5",
-	":motorcycle:":                           "\U0001f3cd\ufe0f",
-	":motorized_wheelchair:":                 "\U0001f9bc",
-	":motorway:":                             "\U0001f6e3\ufe0f",
-	":mount_fuji:":                           "\U0001f5fb",
-	":mountain:":                             "\u26f0\ufe0f",
-	":mountain_bicyclist:":                   "\U0001f6b5",
-	":mountain_biking_man:":                  "\U0001f6b5\u200d\u2642\ufe0f",
-	":mountain_biking_woman:":                "\U0001f6b5\u200d\u2640\ufe0f",
-	":mountain_cableway:":                    "\U0001f6a0",
-	":mountain_railway:":                     "\U0001f69e",
-	":mountain_snow:":                        "\U0001f3d4\ufe0f",
-	":mouse2:":                               "\U0001f401",
-	":mouse:":                                "\U0001f42d",
-	":mouse_face:":                           "\U0001f42d",
-	":mouse_trap:":                           "\U0001faa4",
-	":mouth:":                                "\U0001f444",
-	":movie_camera:":                         "\U0001f3a5",
-	":moyai:":                                "\U0001f5ff",
-	":mozambique:":                           "\U0001f1f2\U0001f1ff",
-	":mrs_claus:":                            "\U0001f936",
-	":multiply:":                             "\u2716\ufe0f",
-	":muscle:":                               "\U0001f4aa",
-	":mushroom:":                             "\U0001f344",
-	":musical_keyboard:":                     "\U0001f3b9",
-	":musical_note:":                         "\U0001f3b5",
-	":musical_notes:":                        "\U0001f3b6",
-	":musical_score:":                        "\U0001f3bc",
-	":mute:":                                 "\U0001f507",
-	":muted_speaker:":                        "\U0001f507",
-	":mx_claus:":                             "\U0001f9d1\u200d\U0001f384",
-	":myanmar:":                              "\U0001f1f2\U0001f1f2",
-	":nail_care:":                            "\U0001f485",
-	":nail_polish:":                          "\U0001f485",
-	":name_badge:":                           "\U0001f4db",
-	":namibia:":                              "\U0001f1f3\U0001f1e6",
-	":national_park:":                        "\U0001f3de\ufe0f",
-	":nauru:":                                "\U0001f1f3\U0001f1f7",
-	":nauseated_face:":                       "\U0001f922",
-	":nazar_amulet:":                         "\U0001f9ff",
-	":necktie:":                              "\U0001f454",
-	":negative_squared_cross_mark:":          "\u274e",
-	":nepal:":                                "\U0001f1f3\U0001f1f5",
-	":nerd_face:":                            "\U0001f913",
-	":nest_with_eggs:":                       "\U0001faba",
-	":nesting_dolls:":                        "\U0001fa86",
-	":netherlands:":                          "\U0001f1f3\U0001f1f1",
-	":neutral_face:":                         "\U0001f610",
-	":new:":                                  "\U0001f195",
-	":new_button:":                           "\U0001f195",
-	":new_caledonia:":                        "\U0001f1f3\U0001f1e8",
-	":new_moon:":                             "\U0001f311",
-	":new_moon_face:":                        "\U0001f31a",
-	":new_moon_with_face:":                   "\U0001f31a",
-	":new_zealand:":                          "\U0001f1f3\U0001f1ff",
-	":newspaper:":                            "\U0001f4f0",
-	":newspaper_roll:":                       "\U0001f5de\ufe0f",
-	":next_track_button:":                    "\u23ed\ufe0f",
-	":ng:":                                   "\U0001f196",
-	":ng_button:":                            "\U0001f196",
-	":ng_man:":                               "\U0001f645\u200d\u2642\ufe0f",
-	":ng_woman:":                             "\U0001f645\u200d\u2640\ufe0f",
-	":nicaragua:":                            "\U0001f1f3\U0001f1ee",
-	":niger:":                                "\U0001f1f3\U0001f1ea",
-	":nigeria:":                              "\U0001f1f3\U0001f1ec",
-	":night_with_stars:":                     "\U0001f303",
-	":nine:":                                 "9\ufe0f\u20e3",
-	":nine_o_clock:":                         "\U0001f558",
-	":nine_thirty:":                          "\U0001f564",
-	":ninja:":                                "\U0001f977",
-	":niue:":                                 "\U0001f1f3\U0001f1fa",
-	":no_bell:":                              "\U0001f515",
-	":no_bicycles:":                          "\U0001f6b3",
-	":no_entry:":                             "\u26d4",
-	":no_entry_sign:":                        "\U0001f6ab",
-	":no_good:":                              "\U0001f645",
-	":no_good_man:":                          "\U0001f645\u200d\u2642\ufe0f",
-	":no_good_woman:":                        "\U0001f645\u200d\u2640\ufe0f",
-	":no_littering:":                         "\U0001f6af",
-	":no_mobile_phones:":                     "\U0001f4f5",
-	":no_mouth:":                             "\U0001f636",
-	":no_one_under_eighteen:":                "\U0001f51e",
-	":no_pedestrians:":                       "\U0001f6b7",
-	":no_smoking:":                           "\U0001f6ad",
-	":non-potable_water:":                    "\U0001f6b1",
-	":non_potable_water:":                    "\U0001f6b1",
-	":norfolk_island:":                       "\U0001f1f3\U0001f1eb",
-	":north_korea:":                          "\U0001f1f0\U0001f1f5",
-	":northern_mariana_islands:":             "\U0001f1f2\U0001f1f5",
-	":norway:":                               "\U0001f1f3\U0001f1f4",
-	":nose:":                                 "\U0001f443",
-	":notebook:":                             "\U0001f4d3",
-	":notebook_with_decorative_cover:":       "\U0001f4d4",
-	":notes:":                                "\U0001f3b6",
-	":nut_and_bolt:":                         "\U0001f529",
-	":o2:":                                   "\U0001f17e\ufe0f",
-	":o:":                                    "\u2b55",
-	":o_button_blood_type:":                  "\U0001f17e\ufe0f",
-	":ocean:":                                "\U0001f30a",
-	":octopus:":                              "\U0001f419",
-	":oden:":                                 "\U0001f362",
-	":office:":                               "\U0001f3e2",
-	":office_building:":                      "\U0001f3e2",
-	":office_worker:":                        "\U0001f9d1\u200d\U0001f4bc",
-	":ogre:":                                 "\U0001f479",
-	":oil_drum:":                             "\U0001f6e2\ufe0f",
-	":ok:":                                   "\U0001f197",
-	":ok_button:":                            "\U0001f197",
-	":ok_hand:":                              "\U0001f44c",
-	":ok_man:":                               "\U0001f646\u200d\u2642\ufe0f",
-	":ok_person:":                            "\U0001f646",
-	":ok_woman:":                             "\U0001f646\u200d\u2640\ufe0f",
-	":old_key:":                              "\U0001f5dd\ufe0f",
-	":old_man:":                              "\U0001f474",
-	":old_woman:":                            "\U0001f475",
-	":older_adult:":                          "\U0001f9d3",
-	":older_man:":                            "\U0001f474",
-	":older_person:":                         "\U0001f9d3",
-	":older_woman:":                          "\U0001f475",
-	":olive:":                                "\U0001fad2",
-	":om:":                                   "\U0001f549\ufe0f",
-	":oman:":                                 "\U0001f1f4\U0001f1f2",
-	":on:":                                   "\U0001f51b",
-	":on_arrow:":                             "\U0001f51b",
-	":oncoming_automobile:":                  "\U0001f698",
-	":oncoming_bus:":                         "\U0001f68d",
-	":oncoming_fist:":                        "\U0001f44a",
-	":oncoming_police_car:":                  "\U0001f694",
-	":oncoming_taxi:":                        "\U0001f696",
-	":one:":                                  "1\ufe0f\u20e3",
-	":one_o_clock:":                          "\U0001f550",
-	":one_piece_swimsuit:":                   "\U0001fa71",
-	":one_thirty:":                           "\U0001f55c",
-	":onion:":                                "\U0001f9c5",
-	":open_book:":                            "\U0001f4d6",
-	":open_file_folder:":                     "\U0001f4c2",
-	":open_hands:":                           "\U0001f450",
-	":open_mailbox_with_lowered_flag:":       "\U0001f4ed",
-	":open_mailbox_with_raised_flag:":        "\U0001f4ec",
-	":open_mouth:":                           "\U0001f62e",
-	":open_umbrella:":                        "\u2602\ufe0f",
-	":ophiuchus:":                            "\u26ce",
-	":optical_disk:":                         "\U0001f4bf",
-	":orange:":                               "\U0001f34a",
-	":orange_book:":                          "\U0001f4d9",
-	":orange_circle:":                        "\U0001f7e0",
-	":orange_heart:":                         "\U0001f9e1",
-	":orange_square:":                        "\U0001f7e7",
-	":orangutan:":                            "\U0001f9a7",
-	":orthodox_cross:":                       "\u2626\ufe0f",
-	":otter:":                                "\U0001f9a6",
-	":outbox_tray:":                          "\U0001f4e4",
-	":owl:":                                  "\U0001f989",
-	":ox:":                                   "\U0001f402",
-	":oyster:":                               "\U0001f9aa",
-	":p_button:":                             "\U0001f17f\ufe0f",
-	":package:":                              "\U0001f4e6",
-	":page_facing_up:":                       "\U0001f4c4",
-	":page_with_curl:":                       "\U0001f4c3",
-	":pager:":                                "\U0001f4df",
-	":paintbrush:":                           "\U0001f58c\ufe0f",
-	":pakistan:":                             "\U0001f1f5\U0001f1f0",
-	":palau:":                                "\U0001f1f5\U0001f1fc",
-	":palestinian_territories:":              "\U0001f1f5\U0001f1f8",
-	":palm_down_hand:":                       "\U0001faf3",
-	":palm_tree:":                            "\U0001f334",
-	":palm_up_hand:":                         "\U0001faf4",
-	":palms_up_together:":                    "\U0001f932",
-	":panama:":                               "\U0001f1f5\U0001f1e6",
-	":pancakes:":                             "\U0001f95e",
-	":panda:":                                "\U0001f43c",
-	":panda_face:":                           "\U0001f43c",
-	":paperclip:":                            "\U0001f4ce",
-	":paperclips:":                           "\U0001f587\ufe0f",
-	":papua_new_guinea:":                     "\U0001f1f5\U0001f1ec",
-	":parachute:":                            "\U0001fa82",
-	":paraguay:":                             "\U0001f1f5\U0001f1fe",
-	":parasol_on_ground:":                    "\u26f1\ufe0f",
-	":parking:":                              "\U0001f17f\ufe0f",
-	":parrot:":                               "\U0001f99c",
-	":part_alternation_mark:":                "\u303d\ufe0f",
-	":partly_sunny:":                         "\u26c5",
-	":party_popper:":                         "\U0001f389",
-	":partying_face:":                        "\U0001f973",
-	":passenger_ship:":                       "\U0001f6f3\ufe0f",
-	":passport_control:":                     "\U0001f6c2",
-	":pause_button:":                         "\u23f8\ufe0f",
-	":paw_prints:":                           "\U0001f43e",
-	":peace_symbol:":                         "\u262e\ufe0f",
-	":peach:":                                "\U0001f351",
-	":peacock:":                              "\U0001f99a",
-	":peanuts:":                              "\U0001f95c",
-	":pear:":                                 "\U0001f350",
-	":pen:":                                  "\U0001f58a\ufe0f",
-	":pencil2:":                              "\u270f\ufe0f",
-	":pencil:":                               "\U0001f4dd",
-	":penguin:":                              "\U0001f427",
-	":pensive:":                              "\U0001f614",
-	":pensive_face:":                         "\U0001f614",
-	":people_holding_hands:":                 "\U0001f9d1\u200d\U0001f91d\u200d\U0001f9d1",
-	":people_hugging:":                       "\U0001fac2",
-	":people_with_bunny_ears:":               "\U0001f46f",
-	":people_wrestling:":                     "\U0001f93c",
-	":performing_arts:":                      "\U0001f3ad",
-	":persevere:":                            "\U0001f623",
-	":persevering_face:":                     "\U0001f623",
-	":person:":                               "\U0001f9d1",
-	":person_bald:":                          "\U0001f9d1\u200d\U0001f9b2",
-	":person_biking:":                        "\U0001f6b4",
-	":person_bouncing_ball:":                 "\u26f9\ufe0f",
-	":person_bowing:":                        "\U0001f647",
-	":person_cartwheeling:":                  "\U0001f938",
-	":person_climbing:":                      "\U0001f9d7",
-	":person_curly_hair:":                    "\U0001f9d1\u200d\U0001f9b1",
-	":person_facepalming:":                   "\U0001f926",
-	":person_feeding_baby:":                  "\U0001f9d1\u200d\U0001f37c",
-	":person_fencing:":                       "\U0001f93a",
-	":person_frowning:":                      "\U0001f64d",
-	":person_gesturing_no:":                  "\U0001f645",
-	":person_gesturing_ok:":                  "\U0001f646",
-	":person_getting_haircut:":               "\U0001f487",
-	":person_getting_massage:":               "\U0001f486",
-	":person_golfing:":                       "\U0001f3cc\ufe0f",
-	":person_in_bed:":                        "\U0001f6cc",
-	":person_in_lotus_position:":             "\U0001f9d8",
-	":person_in_manual_wheelchair:":          "\U0001f9d1\u200d\U0001f9bd",
-	":person_in_motorized_wheelchair:":       "\U0001f9d1\u200d\U0001f9bc",
-	":person_in_steamy_room:":                "\U0001f9d6",
-	":person_in_suit_levitating:":            "\U0001f574\ufe0f",
-	":person_in_tuxedo:":                     "\U0001f935",
-	":person_juggling:":                      "\U0001f939",
-	":person_kneeling:":                      "\U0001f9ce",
-	":person_lifting_weights:":               "\U0001f3cb\ufe0f",
-	":person_mountain_biking:":               "\U0001f6b5",
-	":person_playing_handball:":              "\U0001f93e",
-	":person_playing_water_polo:":            "\U0001f93d",
-	":person_pouting:":                       "\U0001f64e",
-	":person_raising_hand:":                  "\U0001f64b",
-	":person_red_hair:":                      "\U0001f9d1\u200d\U0001f9b0",
-	":person_rowing_boat:":                   "\U0001f6a3",
-	":person_running:":                       "\U0001f3c3",
-	":person_shrugging:":                     "\U0001f937",
-	":person_standing:":                      "\U0001f9cd",
-	":person_surfing:":                       "\U0001f3c4",
-	":person_swimming:":                      "\U0001f3ca",
-	":person_taking_bath:":                   "\U0001f6c0",
-	":person_tipping_hand:":                  "\U0001f481",
-	":person_walking:":                       "\U0001f6b6",
-	":person_wearing_turban:":                "\U0001f473",
-	":person_white_hair:":                    "\U0001f9d1\u200d\U0001f9b3",
-	":person_with_beard:":                    "\U0001f9d4",
-	":person_with_blond_hair:":               "\U0001f471",
-	":person_with_crown:":                    "\U0001fac5",
-	":person_with_curly_hair:":               "\U0001f9d1\u200d\U0001f9b1",
-	":person_with_probing_cane:":             "\U0001f9d1\u200d\U0001f9af",
-	":person_with_red_hair:":                 "\U0001f9d1\u200d\U0001f9b0",
-	":person_with_skullcap:":                 "\U0001f472",
-	":person_with_turban:":                   "\U0001f473",
-	":person_with_veil:":                     "\U0001f470",
-	":person_with_white_cane:":               "\U0001f9d1\u200d\U0001f9af",
-	":person_with_white_hair:":               "\U0001f9d1\u200d\U0001f9b3",
-	":peru:":                                 "\U0001f1f5\U0001f1ea",
-	":petri_dish:":                           "\U0001f9eb",
-	":philippines:":                          "\U0001f1f5\U0001f1ed",
-	":phone:":                                "\u260e\ufe0f",
-	":pick:":                                 "\u26cf\ufe0f",
-	":pickup_truck:":                         "\U0001f6fb",
-	":pie:":                                  "\U0001f967",
-	":pig2:":                                 "\U0001f416",
-	":pig:":                                  "\U0001f437",
-	":pig_face:":                             "\U0001f437",
-	":pig_nose:":                             "\U0001f43d",
-	":pile_of_poo:":                          "\U0001f4a9",
-	":pill:":                                 "\U0001f48a",
-	":pilot:":                                "\U0001f9d1\u200d\u2708\ufe0f",
-	":pinata:":                               "\U0001fa85",
-	":pinched_fingers:":                      "\U0001f90c",
-	":pinching_hand:":                        "\U0001f90f",
-	":pine_decoration:":                      "\U0001f38d",
-	":pineapple:":                            "\U0001f34d",
-	":ping_pong:":                            "\U0001f3d3",
-	":pirate_flag:":                          "\U0001f3f4\u200d\u2620\ufe0f",
-	":pisces:":                               "\u2653",
-	":pitcairn_islands:":                     "\U0001f1f5\U0001f1f3",
-	":pizza:":                                "\U0001f355",
-	":placard:":                              "\U0001faa7",
-	":place_of_worship:":                     "\U0001f6d0",
-	":plate_with_cutlery:":                   "\U0001f37d\ufe0f",
-	":play_button:":                          "\u25b6\ufe0f",
-	":play_or_pause_button:":                 "\u23ef\ufe0f",
-	":playground_slide:":                     "\U0001f6dd",
-	":pleading_face:":                        "\U0001f97a",
-	":plunger:":                              "\U0001faa0",
-	":plus:":                                 "\u2795",
-	":point_down:":                           "\U0001f447",
-	":point_left:":                           "\U0001f448",
-	":point_right:":                          "\U0001f449",
-	":point_up:":                             "\u261d\ufe0f",
-	":point_up_2:":                           "\U0001f446",
-	":poland:":                               "\U0001f1f5\U0001f1f1",
-	":polar_bear:":                           "\U0001f43b\u200d\u2744\ufe0f",
-	":police_car:":                           "\U0001f693",
-	":police_car_light:":                     "\U0001f6a8",
-	":police_officer:":                       "\U0001f46e",
-	":policeman:":                            "\U0001f46e\u200d\u2642\ufe0f",
-	":policewoman:":                          "\U0001f46e\u200d\u2640\ufe0f",
-	":poodle:":                               "\U0001f429",
-	":pool_8_ball:":                          "\U0001f3b1",
-	":poop:":                                 "\U0001f4a9",
-	":popcorn:":                              "\U0001f37f",
-	":portugal:":                             "\U0001f1f5\U0001f1f9",
-	":post_office:":                          "\U0001f3e3",
-	":postal_horn:":                          "\U0001f4ef",
-	":postbox:":                              "\U0001f4ee",
-	":pot_of_food:":                          "\U0001f372",
-	":potable_water:":                        "\U0001f6b0",
-	":potato:":                               "\U0001f954",
-	":potted_plant:":                         "\U0001fab4",
-	":pouch:":                                "\U0001f45d",
-	":poultry_leg:":                          "\U0001f357",
-	":pound:":                                "\U0001f4b7",
-	":pound_banknote:":                       "\U0001f4b7",
-	":pouring_liquid:":                       "\U0001fad7",
-	":pout:":                                 "\U0001f621",
-	":pouting_cat:":                          "\U0001f63e",
-	":pouting_face:":                         "\U0001f64e",
-	":pouting_man:":                          "\U0001f64e\u200d\u2642\ufe0f",
-	":pouting_woman:":                        "\U0001f64e\u200d\u2640\ufe0f",
-	":pray:":                                 "\U0001f64f",
-	":prayer_beads:":                         "\U0001f4ff",
-	":pregnant_man:":                         "\U0001fac3",
-	":pregnant_person:":                      "\U0001fac4",
-	":pregnant_woman:":                       "\U0001f930",
-	":pretzel:":                              "\U0001f968",
-	":previous_track_button:":                "\u23ee\ufe0f",
-	":prince:":                               "\U0001f934",
-	":princess:":                             "\U0001f478",
-	":printer:":                              "\U0001f5a8\ufe0f",
-	":probing_cane:":                         "\U0001f9af",
-	":prohibited:":                           "\U0001f6ab",
-	":puerto_rico:":                          "\U0001f1f5\U0001f1f7",
-	":punch:":                                "\U0001f44a",
-	":purple_circle:":                        "\U0001f7e3",
-	":purple_heart:":                         "\U0001f49c",
-	":purple_square:":                        "\U0001f7ea",
-	":purse:":                                "\U0001f45b",
-	":pushpin:":                              "\U0001f4cc",
-	":put_litter_in_its_place:":              "\U0001f6ae",
-	":puzzle_piece:":                         "\U0001f9e9",
-	":qatar:":                                "\U0001f1f6\U0001f1e6",
-	":question:":                             "\u2753",
-	":rabbit2:":                              "\U0001f407",
-	":rabbit:":                               "\U0001f430",
-	":rabbit_face:":                          "\U0001f430",
-	":raccoon:":                              "\U0001f99d",
-	":racehorse:":                            "\U0001f40e",
-	":racing_car:":                           "\U0001f3ce\ufe0f",
-	":radio:":                                "\U0001f4fb",
-	":radio_button:":                         "\U0001f518",
-	":radioactive:":                          "\u2622\ufe0f",
-	":rage:":                                 "\U0001f621",
-	":railway_car:":                          "\U0001f683",
-	":railway_track:":                        "\U0001f6e4\ufe0f",
-	":rainbow:":                              "\U0001f308",
-	":rainbow_flag:":                         "\U0001f3f3\ufe0f\u200d\U0001f308",
-	":raised_back_of_hand:":                  "\U0001f91a",
-	":raised_eyebrow:":                       "\U0001f928",
-	":raised_fist:":                          "\u270a",
-	":raised_hand:":                          "\u270b",
-	":raised_hand_with_fingers_splayed:":     "\U0001f590\ufe0f",
-	":raised_hands:":                         "\U0001f64c",
-	":raising_hand:":                         "\U0001f64b",
-	":raising_hand_man:":                     "\U0001f64b\u200d\u2642\ufe0f",
-	":raising_hand_woman:":                   "\U0001f64b\u200d\u2640\ufe0f",
-	":raising_hands:":                        "\U0001f64c",
-	":ram:":                                  "\U0001f40f",
-	":ramen:":                                "\U0001f35c",
-	":rat:":                                  "\U0001f400",
-	":razor:":                                "\U0001fa92",
-	":receipt:":                              "\U0001f9fe",
-	":record_button:":                        "\u23fa\ufe0f",
-	":recycle:":                              "\u267b\ufe0f",
-	":recycling_symbol:":                     "\u267b\ufe0f",
-	":red_apple:":                            "\U0001f34e",
-	":red_car:":                              "\U0001f697",
-	":red_circle:":                           "\U0001f534",
-	":red_envelope:":                         "\U0001f9e7",
-	":red_exclamation_mark:":                 "\u2757",
-	":red_hair:":                             "\U0001f9b0",
-	":red_haired_man:":                       "\U0001f468\u200d\U0001f9b0",
-	":red_haired_woman:":                     "\U0001f469\u200d\U0001f9b0",
-	":red_heart:":                            "\u2764\ufe0f",
-	":red_paper_lantern:":                    "\U0001f3ee",
-	":red_question_mark:":                    "\u2753",
-	":red_square:":                           "\U0001f7e5",
-	":red_triangle_pointed_down:":            "\U0001f53b",
-	":red_triangle_pointed_up:":              "\U0001f53a",
-	":registered:":                           "\u00ae\ufe0f",
-	":relaxed:":                              "\u263a\ufe0f",
-	":relieved:":                             "\U0001f60c",
-	":relieved_face:":                        "\U0001f60c",
-	":reminder_ribbon:":                      "\U0001f397\ufe0f",
-	":repeat:":                               "\U0001f501",
-	":repeat_button:":                        "\U0001f501",
-	":repeat_one:":                           "\U0001f502",
-	":repeat_single_button:":                 "\U0001f502",
-	":rescue_worker_helmet:":                 "\u26d1\ufe0f",
-	":rescue_worker_s_helmet:":               "\u26d1\ufe0f",
-	":restroom:":                             "\U0001f6bb",
-	":reunion:":                              "\U0001f1f7\U0001f1ea",
-	":reverse_button:":                       "\u25c0\ufe0f",
-	":revolving_hearts:":                     "\U0001f49e",
-	":rewind:":                               "\u23ea",
-	":rhinoceros:":                           "\U0001f98f",
-	":ribbon:":                               "\U0001f380",
-	":rice:":                                 "\U0001f35a",
-	":rice_ball:":                            "\U0001f359",
-	":rice_cracker:":                         "\U0001f358",
-	":rice_scene:":                           "\U0001f391",
-	":right_anger_bubble:":                   "\U0001f5ef\ufe0f",
-	":right_arrow:":                          "\u27a1\ufe0f",
-	":right_arrow_curving_down:":             "\u2935\ufe0f",
-	":right_arrow_curving_left:":             "\u21a9\ufe0f",
-	":right_arrow_curving_up:":               "\u2934\ufe0f",
-	":right_facing_fist:":                    "\U0001f91c",
-	":rightwards_hand:":                      "\U0001faf1",
-	":ring:":                                 "\U0001f48d",
-	":ring_buoy:":                            "\U0001f6df",
-	":ringed_planet:":                        "\U0001fa90",
-	":roasted_sweet_potato:":                 "\U0001f360",
-	":robot:":                                "\U0001f916",
-	":robot_face:":                           "\U0001f916",
-	":rock:":                                 "\U0001faa8",
-	":rocket:":                               "\U0001f680",
-	":rofl:":                                 "\U0001f923",
-	":roll_eyes:":                            "\U0001f644",
-	":roll_of_paper:":                        "\U0001f9fb",
-	":rolled_up_newspaper:":                  "\U0001f5de\ufe0f",
-	":roller_coaster:":                       "\U0001f3a2",
-	":roller_skate:":                         "\U0001f6fc",
-	":rolling_on_the_floor_laughing:":        "\U0001f923",
-	":romania:":                              "\U0001f1f7\U0001f1f4",
-	":rooster:":                              "\U0001f413",
-	":rose:":                                 "\U0001f339",
-	":rosette:":                              "\U0001f3f5\ufe0f",
-	":rotating_light:":                       "\U0001f6a8",
-	":round_pushpin:":                        "\U0001f4cd",
-	":rowboat:":                              "\U0001f6a3",
-	":rowing_man:":                           "\U0001f6a3\u200d\u2642\ufe0f",
-	":rowing_woman:":                         "\U0001f6a3\u200d\u2640\ufe0f",
-	":ru:":                                   "\U0001f1f7\U0001f1fa",
-	":rugby_football:":                       "\U0001f3c9",
-	":runner:":                               "\U0001f3c3",
-	":running:":                              "\U0001f3c3",
-	":running_man:":                          "\U0001f3c3\u200d\u2642\ufe0f",
-	":running_shirt:":                        "\U0001f3bd",
-	":running_shirt_with_sash:":              "\U0001f3bd",
-	":running_shoe:":                         "\U0001f45f",
-	":running_woman:":                        "\U0001f3c3\u200d\u2640\ufe0f",
-	":rwanda:":                               "\U0001f1f7\U0001f1fc",
-	":sa:":                                   "\U0001f202\ufe0f",
-	":sad_but_relieved_face:":                "\U0001f625",
-	":safety_pin:":                           "\U0001f9f7",
-	":safety_vest:":                          "\U0001f9ba",
-	":sagittarius:":                          "\u2650",
-	":sailboat:":                             "\u26f5",
-	":sake:":                                 "\U0001f376",
-	":salt:":                                 "\U0001f9c2",
-	":saluting_face:":                        "\U0001fae1",
-	":samoa:":                                "\U0001f1fc\U0001f1f8",
-	":san_marino:":                           "\U0001f1f8\U0001f1f2",
-	":sandal:":                               "\U0001f461",
-	":sandwich:":                             "\U0001f96a",
-	":santa:":                                "\U0001f385",
-	":santa_claus:":                          "\U0001f385",
-	":sao_tome_principe:":                    "\U0001f1f8\U0001f1f9",
-	":sari:":                                 "\U0001f97b",
-	":sassy_man:":                            "\U0001f481\u200d\u2642\ufe0f",
-	":sassy_woman:":                          "\U0001f481\u200d\u2640\ufe0f",
-	":satellite:":                            "\U0001f4e1",
-	":satellite_antenna:":                    "\U0001f4e1",
-	":satisfied:":                            "\U0001f606",
-	":saudi_arabia:":                         "\U0001f1f8\U0001f1e6",
-	":sauna_man:":                            "\U0001f9d6\u200d\u2642\ufe0f",
-	":sauna_person:":                         "\U0001f9d6",
-	":sauna_woman:":                          "\U0001f9d6\u200d\u2640\ufe0f",
-	":sauropod:":                             "\U0001f995",
-	":saxophone:":                            "\U0001f3b7",
-	":scarf:":                                "\U0001f9e3",
-	":school:":                               "\U0001f3eb",
-	":school_satchel:":                       "\U0001f392",
-	":scientist:":                            "\U0001f9d1\u200d\U0001f52c",
-	":scissors:":                             "\u2702\ufe0f",
-	":scorpio:":                              "\u264f",
-	":scorpion:":                             "\U0001f982",
-	":scorpius:":                             "\u264f",
-	":scotland:":                             "\U0001f3f4\U000e0067\U000e0062\U000e0073\U000e0063\U000e0074\U000e007f",
-	":scream:":                               "\U0001f631",
-	":scream_cat:":                           "\U0001f640",
-	":screwdriver:":                          "\U0001fa9b",
-	":scroll:":                               "\U0001f4dc",
-	":seal:":                                 "\U0001f9ad",
-	":seat:":                                 "\U0001f4ba",
-	":second_place_medal:":                   "\U0001f948",
-	":secret:":                               "\u3299\ufe0f",
-	":see_no_evil:":                          "\U0001f648",
-	":see_no_evil_monkey:":                   "\U0001f648",
-	":seedling:":                             "\U0001f331",
-	":selfie:":                               "\U0001f933",
-	":senegal:":                              "\U0001f1f8\U0001f1f3",
-	":serbia:":                               "\U0001f1f7\U0001f1f8",
-	":service_dog:":                          "\U0001f415\u200d\U0001f9ba",
-	":seven:":                                "7\ufe0f\u20e3",
-	":seven_o_clock:":                        "\U0001f556",
-	":seven_thirty:":                         "\U0001f562",
-	":sewing_needle:":                        "\U0001faa1",
-	":seychelles:":                           "\U0001f1f8\U0001f1e8",
-	":shallow_pan_of_food:":                  "\U0001f958",
-	":shamrock:":                             "\u2618\ufe0f",
-	":shark:":                                "\U0001f988",
-	":shaved_ice:":                           "\U0001f367",
-	":sheaf_of_rice:":                        "\U0001f33e",
-	":sheep:":                                "\U0001f411",
-	":shell:":                                "\U0001f41a",
-	":shield:":                               "\U0001f6e1\ufe0f",
-	":shinto_shrine:":                        "\u26e9\ufe0f",
-	":ship:":                                 "\U0001f6a2",
-	":shirt:":                                "\U0001f455",
-	":shit:":                                 "\U0001f4a9",
-	":shoe:":                                 "\U0001f45e",
-	":shooting_star:":                        "\U0001f320",
-	":shopping:":                             "\U0001f6cd\ufe0f",
-	":shopping_bags:":                        "\U0001f6cd\ufe0f",
-	":shopping_cart:":                        "\U0001f6d2",
-	":shortcake:":                            "\U0001f370",
-	":shorts:":                               "\U0001fa73",
-	":shower:":                               "\U0001f6bf",
-	":shrimp:":                               "\U0001f990",
-	":shrug:":                                "\U0001f937",
-	":shuffle_tracks_button:":                "\U0001f500",
-	":shushing_face:":                        "\U0001f92b",
-	":sierra_leone:":                         "\U0001f1f8\U0001f1f1",
-	":sign_of_the_horns:":                    "\U0001f918",
-	":signal_strength:":                      "\U0001f4f6",
-	":singapore:":                            "\U0001f1f8\U0001f1ec",
-	":singer:":                               "\U0001f9d1\u200d\U0001f3a4",
-	":sint_maarten:":                         "\U0001f1f8\U0001f1fd",
-	":six:":                                  "6\ufe0f\u20e3",
-	":six_o_clock:":                          "\U0001f555",
-	":six_pointed_star:":                     "\U0001f52f",
-	":six_thirty:":                           "\U0001f561",
-	":skateboard:":                           "\U0001f6f9",
-	":ski:":                                  "\U0001f3bf",
-	":skier:":                                "\u26f7\ufe0f",
-	":skis:":                                 "\U0001f3bf",
-	":skull:":                                "\U0001f480",
-	":skull_and_crossbones:":                 "\u2620\ufe0f",
-	":skunk:":                                "\U0001f9a8",
-	":sled:":                                 "\U0001f6f7",
-	":sleeping:":                             "\U0001f634",
-	":sleeping_bed:":                         "\U0001f6cc",
-	":sleeping_face:":                        "\U0001f634",
-	":sleepy:":                               "\U0001f62a",
-	":sleepy_face:":                          "\U0001f62a",
-	":slightly_frowning_face:":               "\U0001f641",
-	":slightly_smiling_face:":                "\U0001f642",
-	":slot_machine:":                         "\U0001f3b0",
-	":sloth:":                                "\U0001f9a5",
-	":slovakia:":                             "\U0001f1f8\U0001f1f0",
-	":slovenia:":                             "\U0001f1f8\U0001f1ee",
-	":small_airplane:":                       "\U0001f6e9\ufe0f",
-	":small_blue_diamond:":                   "\U0001f539",
-	":small_orange_diamond:":                 "\U0001f538",
-	":small_red_triangle:":                   "\U0001f53a",
-	":small_red_triangle_down:":              "\U0001f53b",
-	":smile:":                                "\U0001f604",
-	":smile_cat:":                            "\U0001f638",
-	":smiley:":                               "\U0001f603",
-	":smiley_cat:":                           "\U0001f63a",
-	":smiling_cat_with_heart_eyes:":          "\U0001f63b",
-	":smiling_face:":                         "\u263a\ufe0f",
-	":smiling_face_with_halo:":               "\U0001f607",
-	":smiling_face_with_heart_eyes:":         "\U0001f60d",
-	":smiling_face_with_hearts:":             "\U0001f970",
-	":smiling_face_with_horns:":              "\U0001f608",
-	":smiling_face_with_open_hands:":         "\U0001f917",
-	":smiling_face_with_smiling_eyes:":       "\U0001f60a",
-	":smiling_face_with_sunglasses:":         "\U0001f60e",
-	":smiling_face_with_tear:":               "\U0001f972",
-	":smiling_face_with_three_hearts:":       "\U0001f970",
-	":smiling_imp:":                          "\U0001f608",
-	":smirk:":                                "\U0001f60f",
-	":smirk_cat:":                            "\U0001f63c",
-	":smirking_face:":                        "\U0001f60f",
-	":smoking:":                              "\U0001f6ac",
-	":snail:":                                "\U0001f40c",
-	":snake:":                                "\U0001f40d",
-	":sneezing_face:":                        "\U0001f927",
-	":snow_capped_mountain:":                 "\U0001f3d4\ufe0f",
-	":snowboarder:":                          "\U0001f3c2",
-	":snowflake:":                            "\u2744\ufe0f",
-	":snowman:":                              "\u26c4",
-	":snowman_with_snow:":                    "\u2603\ufe0f",
-	":snowman_without_snow:":                 "\u26c4",
-	":soap:":                                 "\U0001f9fc",
-	":sob:":                                  "\U0001f62d",
-	":soccer:":                               "\u26bd",
-	":soccer_ball:":                          "\u26bd",
-	":socks:":                                "\U0001f9e6",
-	":soft_ice_cream:":                       "\U0001f366",
-	":softball:":                             "\U0001f94e",
-	":solomon_islands:":                      "\U0001f1f8\U0001f1e7",
-	":somalia:":                              "\U0001f1f8\U0001f1f4",
-	":soon:":                                 "\U0001f51c",
-	":soon_arrow:":                           "\U0001f51c",
-	":sos:":                                  "\U0001f198",
-	":sos_button:":                           "\U0001f198",
-	":sound:":                                "\U0001f509",
-	":south_africa:":                         "\U0001f1ff\U0001f1e6",
-	":south_georgia_south_sandwich_islands:": "\U0001f1ec\U0001f1f8",
-	":south_sudan:":                          "\U0001f1f8\U0001f1f8",
-	":space_invader:":                        "\U0001f47e",
-	":spade_suit:":                           "\u2660\ufe0f",
-	":spades:":                               "\u2660\ufe0f",
-	":spaghetti:":                            "\U0001f35d",
-	":sparkle:":                              "\u2747\ufe0f",
-	":sparkler:":                             "\U0001f387",
-	":sparkles:":                             "\u2728",
-	":sparkling_heart:":                      "\U0001f496",
-	":speak_no_evil:":                        "\U0001f64a",
-	":speak_no_evil_monkey:":                 "\U0001f64a",
-	":speaker:":                              "\U0001f508",
-	":speaker_high_volume:":                  "\U0001f50a",
-	":speaker_low_volume:":                   "\U0001f508",
-	":speaker_medium_volume:":                "\U0001f509",
-	":speaking_head:":                        "\U0001f5e3\ufe0f",
-	":speech_balloon:":                       "\U0001f4ac",
-	":speedboat:":                            "\U0001f6a4",
-	":spider:":                               "\U0001f577\ufe0f",
-	":spider_web:":                           "\U0001f578\ufe0f",
-	":spiral_calendar:":                      "\U0001f5d3\ufe0f",
-	":spiral_notepad:":                       "\U0001f5d2\ufe0f",
-	":spiral_shell:":                         "\U0001f41a",
-	":sponge:":                               "\U0001f9fd",
-	":spoon:":                                "\U0001f944",
-	":sport_utility_vehicle:":                "\U0001f699",
-	":sports_medal:":                         "\U0001f3c5",
-	":spouting_whale:":                       "\U0001f433",
-	":squid:":                                "\U0001f991",
-	":squinting_face_with_tongue:":           "\U0001f61d",
-	":sri_lanka:":                            "\U0001f1f1\U0001f1f0",
-	":st_barthelemy:":                        "\U0001f1e7\U0001f1f1",
-	":st_helena:":                            "\U0001f1f8\U0001f1ed",
-	":st_kitts_nevis:":                       "\U0001f1f0\U0001f1f3",
-	":st_lucia:":                             "\U0001f1f1\U0001f1e8",
-	":st_martin:":                            "\U0001f1f2\U0001f1eb",
-	":st_pierre_miquelon:":                   "\U0001f1f5\U0001f1f2",
-	":st_vincent_grenadines:":                "\U0001f1fb\U0001f1e8",
-	":stadium:":                              "\U0001f3df\ufe0f",
-	":standing_man:":                         "\U0001f9cd\u200d\u2642\ufe0f",
-	":standing_person:":                      "\U0001f9cd",
-	":standing_woman:":                       "\U0001f9cd\u200d\u2640\ufe0f",
-	":star2:":                                "\U0001f31f",
-	":star:":                                 "\u2b50",
-	":star_and_crescent:":                    "\u262a\ufe0f",
-	":star_of_david:":                        "\u2721\ufe0f",
-	":star_struck:":                          "\U0001f929",
-	":stars:":                                "\U0001f320",
-	":station:":                              "\U0001f689",
-	":statue_of_liberty:":                    "\U0001f5fd",
-	":steam_locomotive:":                     "\U0001f682",
-	":steaming_bowl:":                        "\U0001f35c",
-	":stethoscope:":                          "\U0001fa7a",
-	":stew:":                                 "\U0001f372",
-	":stop_button:":                          "\u23f9\ufe0f",
-	":stop_sign:":                            "\U0001f6d1",
-	":stopwatch:":                            "\u23f1\ufe0f",
-	":straight_ruler:":                       "\U0001f4cf",
-	":strawberry:":                           "\U0001f353",
-	":stuck_out_tongue:":                     "\U0001f61b",
-	":stuck_out_tongue_closed_eyes:":         "\U0001f61d",
-	":stuck_out_tongue_winking_eye:":         "\U0001f61c",
-	":student:":                              "\U0001f9d1\u200d\U0001f393",
-	":studio_microphone:":                    "\U0001f399\ufe0f",
-	":stuffed_flatbread:":                    "\U0001f959",
-	":sudan:":                                "\U0001f1f8\U0001f1e9",
-	":sun:":                                  "\u2600\ufe0f",
-	":sun_behind_cloud:":                     "\u26c5",
-	":sun_behind_large_cloud:":               "\U0001f325\ufe0f",
-	":sun_behind_rain_cloud:":                "\U0001f326\ufe0f",
-	":sun_behind_small_cloud:":               "\U0001f324\ufe0f",
-	":sun_with_face:":                        "\U0001f31e",
-	":sunflower:":                            "\U0001f33b",
-	":sunglasses:":                           "\U0001f60e",
-	":sunny:":                                "\u2600\ufe0f",
-	":sunrise:":                              "\U0001f305",
-	":sunrise_over_mountains:":               "\U0001f304",
-	":sunset:":                               "\U0001f307",
-	":superhero:":                            "\U0001f9b8",
-	":superhero_man:":                        "\U0001f9b8\u200d\u2642\ufe0f",
-	":superhero_woman:":                      "\U0001f9b8\u200d\u2640\ufe0f",
-	":supervillain:":                         "\U0001f9b9",
-	":supervillain_man:":                     "\U0001f9b9\u200d\u2642\ufe0f",
-	":supervillain_woman:":                   "\U0001f9b9\u200d\u2640\ufe0f",
-	":surfer:":                               "\U0001f3c4",
-	":surfing_man:":                          "\U0001f3c4\u200d\u2642\ufe0f",
-	":surfing_woman:":                        "\U0001f3c4\u200d\u2640\ufe0f",
-	":suriname:":                             "\U0001f1f8\U0001f1f7",
-	":sushi:":                                "\U0001f363",
-	":suspension_railway:":                   "\U0001f69f",
-	":svalbard_jan_mayen:":                   "\U0001f1f8\U0001f1ef",
-	":swan:":                                 "\U0001f9a2",
-	":swaziland:":                            "\U0001f1f8\U0001f1ff",
-	":sweat:":                                "\U0001f613",
-	":sweat_droplets:":                       "\U0001f4a6",
-	":sweat_drops:":                          "\U0001f4a6",
-	":sweat_smile:":                          "\U0001f605",
-	":sweden:":                               "\U0001f1f8\U0001f1ea",
-	":sweet_potato:":                         "\U0001f360",
-	":swim_brief:":                           "\U0001fa72",
-	":swimmer:":                              "\U0001f3ca",
-	":swimming_man:":                         "\U0001f3ca\u200d\u2642\ufe0f",
-	":swimming_woman:":                       "\U0001f3ca\u200d\u2640\ufe0f",
-	":switzerland:":                          "\U0001f1e8\U0001f1ed",
-	":symbols:":                              "\U0001f523",
-	":synagogue:":                            "\U0001f54d",
-	":syria:":                                "\U0001f1f8\U0001f1fe",
-	":syringe:":                              "\U0001f489",
-	":t-rex:":                                "\U0001f996",
-	":t_rex:":                                "\U0001f996",
-	":t_shirt:":                              "\U0001f455",
-	":taco:":                                 "\U0001f32e",
-	":tada:":                                 "\U0001f389",
-	":taiwan:":                               "\U0001f1f9\U0001f1fc",
-	":tajikistan:":                           "\U0001f1f9\U0001f1ef",
-	":takeout_box:":                          "\U0001f961",
-	":tamale:":                               "\U0001fad4",
-	":tanabata_tree:":                        "\U0001f38b",
-	":tangerine:":                            "\U0001f34a",
-	":tanzania:":                             "\U0001f1f9\U0001f1ff",
-	":taurus:":                               "\u2649",
-	":taxi:":                                 "\U0001f695",
-	":tea:":                                  "\U0001f375",
-	":teacher:":                              "\U0001f9d1\u200d\U0001f3eb",
-	":teacup_without_handle:":                "\U0001f375",
-	":teapot:":                               "\U0001fad6",
-	":tear_off_calendar:":                    "\U0001f4c6",
-	":technologist:":                         "\U0001f9d1\u200d\U0001f4bb",
-	":teddy_bear:":                           "\U0001f9f8",
-	":telephone:":                            "\u260e\ufe0f",
-	":telephone_receiver:":                   "\U0001f4de",
-	":telescope:":                            "\U0001f52d",
-	":television:":                           "\U0001f4fa",
-	":ten_o_clock:":                          "\U0001f559",
-	":ten_thirty:":                           "\U0001f565",
-	":tennis:":                               "\U0001f3be",
-	":tent:":                                 "\u26fa",
-	":test_tube:":                            "\U0001f9ea",
-	":thailand:":                             "\U0001f1f9\U0001f1ed",
-	":thermometer:":                          "\U0001f321\ufe0f",
-	":thinking:":                             "\U0001f914",
-	":thinking_face:":                        "\U0001f914",
-	":third_place_medal:":                    "\U0001f949",
-	":thong_sandal:":                         "\U0001fa74",
-	":thought_balloon:":                      "\U0001f4ad",
-	":thread:":                               "\U0001f9f5",
-	":three:":                                "3\ufe0f\u20e3",
-	":three_o_clock:":                        "\U0001f552",
-	":three_thirty:":                         "\U0001f55e",
-	":thumbs_down:":                          "\U0001f44e",
-	":thumbs_up:":                            "\U0001f44d",
-	":thumbsdown:":                           "\U0001f44e",
-	":thumbsup:":                             "\U0001f44d",
-	":ticket:":                               "\U0001f3ab",
-	":tickets:":                              "\U0001f39f\ufe0f",
-	":tiger2:":                               "\U0001f405",
-	":tiger:":                                "\U0001f42f",
-	":tiger_face:":                           "\U0001f42f",
-	":timer_clock:":                          "\u23f2\ufe0f",
-	":timor_leste:":                          "\U0001f1f9\U0001f1f1",
-	":tipping_hand_man:":                     "\U0001f481\u200d\u2642\ufe0f",
-	":tipping_hand_person:":                  "\U0001f481",
-	":tipping_hand_woman:":                   "\U0001f481\u200d\u2640\ufe0f",
-	":tired_face:":                           "\U0001f62b",
-	":tm:":                                   "\u2122\ufe0f",
-	":togo:":                                 "\U0001f1f9\U0001f1ec",
-	":toilet:":                               "\U0001f6bd",
-	":tokelau:":                              "\U0001f1f9\U0001f1f0",
-	":tokyo_tower:":                          "\U0001f5fc",
-	":tomato:":                               "\U0001f345",
-	":tonga:":                                "\U0001f1f9\U0001f1f4",
-	":tongue:":                               "\U0001f445",
-	":toolbox:":                              "\U0001f9f0",
-	":tooth:":                                "\U0001f9b7",
-	":toothbrush:":                           "\U0001faa5",
-	":top:":                                  "\U0001f51d",
-	":top_arrow:":                            "\U0001f51d",
-	":top_hat:":                              "\U0001f3a9",
-	":tophat:":                               "\U0001f3a9",
-	":tornado:":                              "\U0001f32a\ufe0f",
-	":tr:":                                   "\U0001f1f9\U0001f1f7",
-	":trackball:":                            "\U0001f5b2\ufe0f",
-	":tractor:":                              "\U0001f69c",
-	":trade_mark:":                           "\u2122\ufe0f",
-	":traffic_light:":                        "\U0001f6a5",
-	":train2:":                               "\U0001f686",
-	":train:":                                "\U0001f68b",
-	":tram:":                                 "\U0001f68a",
-	":tram_car:":                             "\U0001f68b",
-	":transgender_flag:":                     "\U0001f3f3\ufe0f\u200d\u26a7\ufe0f",
-	":transgender_symbol:":                   "\u26a7\ufe0f",
-	":triangular_flag:":                      "\U0001f6a9",
-	":triangular_flag_on_post:":              "\U0001f6a9",
-	":triangular_ruler:":                     "\U0001f4d0",
-	":trident:":                              "\U0001f531",
-	":trident_emblem:":                       "\U0001f531",
-	":trinidad_tobago:":                      "\U0001f1f9\U0001f1f9",
-	":tristan_da_cunha:":                     "\U0001f1f9\U0001f1e6",
-	":triumph:":                              "\U0001f624",
-	":troll:":                                "\U0001f9cc",
-	":trolleybus:":                           "\U0001f68e",
-	":trophy:":                               "\U0001f3c6",
-	":tropical_drink:":                       "\U0001f379",
-	":tropical_fish:":                        "\U0001f420",
-	":truck:":                                "\U0001f69a",
-	":trumpet:":                              "\U0001f3ba",
-	":tshirt:":                               "\U0001f455",
-	":tulip:":                                "\U0001f337",
-	":tumbler_glass:":                        "\U0001f943",
-	":tunisia:":                              "\U0001f1f9\U0001f1f3",
-	":turkey:":                               "\U0001f983",
-	":turkmenistan:":                         "\U0001f1f9\U0001f1f2",
-	":turks_caicos_islands:":                 "\U0001f1f9\U0001f1e8",
-	":turtle:":                               "\U0001f422",
-	":tuvalu:":                               "\U0001f1f9\U0001f1fb",
-	":tv:":                                   "\U0001f4fa",
-	":twelve_o_clock:":                       "\U0001f55b",
-	":twelve_thirty:":                        "\U0001f567",
-	":twisted_rightwards_arrows:":            "\U0001f500",
-	":two:":                                  "2\ufe0f\u20e3",
-	":two_hearts:":                           "\U0001f495",
-	":two_hump_camel:":                       "\U0001f42b",
-	":two_men_holding_hands:":                "\U0001f46c",
-	":two_o_clock:":                          "\U0001f551",
-	":two_thirty:":                           "\U0001f55d",
-	":two_women_holding_hands:":              "\U0001f46d",
-	":u5272:":                                "\U0001f239",
-	":u5408:":                                "\U0001f234",
-	":u55b6:":                                "\U0001f23a",
-	":u6307:":                                "\U0001f22f",
-	":u6708:":                                "\U0001f237\ufe0f",
-	":u6709:":                                "\U0001f236",
-	":u6e80:":                                "\U0001f235",
-	":u7121:":                                "\U0001f21a",
-	":u7533:":                                "\U0001f238",
-	":u7981:":                                "\U0001f232",
-	":u7a7a:":                                "\U0001f233",
-	":uganda:":                               "\U0001f1fa\U0001f1ec",
-	":uk:":                                   "\U0001f1ec\U0001f1e7",
-	":ukraine:":                              "\U0001f1fa\U0001f1e6",
-	":umbrella:":                             "\u2614",
-	":umbrella_on_ground:":                   "\u26f1\ufe0f",
-	":umbrella_with_rain_drops:":             "\u2614",
-	":unamused:":                             "\U0001f612",
-	":unamused_face:":                        "\U0001f612",
-	":underage:":                             "\U0001f51e",
-	":unicorn:":                              "\U0001f984",
-	":united_arab_emirates:":                 "\U0001f1e6\U0001f1ea",
-	":united_nations:":                       "\U0001f1fa\U0001f1f3",
-	":unlock:":                               "\U0001f513",
-	":unlocked:":                             "\U0001f513",
-	":up:":                                   "\U0001f199",
-	":up_arrow:":                             "\u2b06\ufe0f",
-	":up_button:":                            "\U0001f199",
-	":up_down_arrow:":                        "\u2195\ufe0f",
-	":up_left_arrow:":                        "\u2196\ufe0f",
-	":up_right_arrow:":                       "\u2197\ufe0f",
-	":upside_down_face:":                     "\U0001f643",
-	":upwards_button:":                       "\U0001f53c",
-	":uruguay:":                              "\U0001f1fa\U0001f1fe",
-	":us:":                                   "\U0001f1fa\U0001f1f8",
-	":us_outlying_islands:":                  "\U0001f1fa\U0001f1f2",
-	":us_virgin_islands:":                    "\U0001f1fb\U0001f1ee",
-	":uzbekistan:":                           "\U0001f1fa\U0001f1ff",
-	":v:":                                    "\u270c\ufe0f",
-	":vampire:":                              "\U0001f9db",
-	":vampire_man:":                          "\U0001f9db\u200d\u2642\ufe0f",
-	":vampire_woman:":                        "\U0001f9db\u200d\u2640\ufe0f",
-	":vanuatu:":                              "\U0001f1fb\U0001f1fa",
-	":vatican_city:":                         "\U0001f1fb\U0001f1e6",
-	":venezuela:":                            "\U0001f1fb\U0001f1ea",
-	":vertical_traffic_light:":               "\U0001f6a6",
-	":vhs:":                                  "\U0001f4fc",
-	":vibration_mode:":                       "\U0001f4f3",
-	":victory_hand:":                         "\u270c\ufe0f",
-	":video_camera:":                         "\U0001f4f9",
-	":video_game:":                           "\U0001f3ae",
-	":videocassette:":                        "\U0001f4fc",
-	":vietnam:":                              "\U0001f1fb\U0001f1f3",
-	":violin:":                               "\U0001f3bb",
-	":virgo:":                                "\u264d",
-	":volcano:":                              "\U0001f30b",
-	":volleyball:":                           "\U0001f3d0",
-	":vomiting_face:":                        "\U0001f92e",
-	":vs:":                                   "\U0001f19a",
-	":vs_button:":                            "\U0001f19a",
-	":vulcan_salute:":                        "\U0001f596",
-	":waffle:":                               "\U0001f9c7",
-	":wales:":                                "\U0001f3f4\U000e0067\U000e0062\U000e0077\U000e006c\U000e0073\U000e007f",
-	":walking:":                              "\U0001f6b6",
-	":walking_man:":                          "\U0001f6b6\u200d\u2642\ufe0f",
-	":walking_woman:":                        "\U0001f6b6\u200d\u2640\ufe0f",
-	":wallis_futuna:":                        "\U0001f1fc\U0001f1eb",
-	":waning_crescent_moon:":                 "\U0001f318",
-	":waning_gibbous_moon:":                  "\U0001f316",
-	":warning:":                              "\u26a0\ufe0f",
-	":wastebasket:":                          "\U0001f5d1\ufe0f",
-	":watch:":                                "\u231a",
-	":water_buffalo:":                        "\U0001f403",
-	":water_closet:":                         "\U0001f6be",
-	":water_pistol:":                         "\U0001f52b",
-	":water_polo:":                           "\U0001f93d",
-	":water_wave:":                           "\U0001f30a",
-	":watermelon:":                           "\U0001f349",
-	":wave:":                                 "\U0001f44b",
-	":waving_hand:":                          "\U0001f44b",
-	":wavy_dash:":                            "\u3030\ufe0f",
-	":waxing_crescent_moon:":                 "\U0001f312",
-	":waxing_gibbous_moon:":                  "\U0001f314",
-	":wc:":                                   "\U0001f6be",
-	":weary:":                                "\U0001f629",
-	":weary_cat:":                            "\U0001f640",
-	":weary_face:":                           "\U0001f629",
-	":wedding:":                              "\U0001f492",
-	":weight_lifting:":                       "\U0001f3cb\ufe0f",
-	":weight_lifting_man:":                   "\U0001f3cb\ufe0f\u200d\u2642\ufe0f",
-	":weight_lifting_woman:":                 "\U0001f3cb\ufe0f\u200d\u2640\ufe0f",
-	":western_sahara:":                       "\U0001f1ea\U0001f1ed",
-	":whale2:":                               "\U0001f40b",
-	":whale:":                                "\U0001f433",
-	":wheel:":                                "\U0001f6de",
-	":wheel_of_dharma:":                      "\u2638\ufe0f",
-	":wheelchair:":                           "\u267f",
-	":wheelchair_symbol:":                    "\u267f",
-	":white_cane:":                           "\U0001f9af",
-	":white_check_mark:":                     "\u2705",
-	":white_circle:":                         "\u26aa",
-	":white_exclamation_mark:":               "\u2755",
-	":white_flag:":                           "\U0001f3f3\ufe0f",
-	":white_flower:":                         "\U0001f4ae",
-	":white_hair:":                           "\U0001f9b3",
-	":white_haired_man:":                     "\U0001f468\u200d\U0001f9b3",
-	":white_haired_woman:":                   "\U0001f469\u200d\U0001f9b3",
-	":white_heart:":                          "\U0001f90d",
-	":white_large_square:":                   "\u2b1c",
-	":white_medium_small_square:":            "\u25fd",
-	":white_medium_square:":                  "\u25fb\ufe0f",
-	":white_question_mark:":                  "\u2754",
-	":white_small_square:":                   "\u25ab\ufe0f",
-	":white_square_button:":                  "\U0001f533",
-	":wilted_flower:":                        "\U0001f940",
-	":wind_chime:":                           "\U0001f390",
-	":wind_face:":                            "\U0001f32c\ufe0f",
-	":window:":                               "\U0001fa9f",
-	":wine_glass:":                           "\U0001f377",
-	":wink:":                                 "\U0001f609",
-	":winking_face:":                         "\U0001f609",
-	":winking_face_with_tongue:":             "\U0001f61c",
-	":wolf:":                                 "\U0001f43a",
-	":woman:":                                "\U0001f469",
-	":woman_and_man_holding_hands:":          "\U0001f46b",
-	":woman_artist:":                         "\U0001f469\u200d\U0001f3a8",
-	":woman_astronaut:":                      "\U0001f469\u200d\U0001f680",
-	":woman_bald:":                           "\U0001f469\u200d\U0001f9b2",
-	":woman_beard:":                          "\U0001f9d4\u200d\u2640\ufe0f",
-	":woman_biking:":                         "\U0001f6b4\u200d\u2640\ufe0f",
-	":woman_bouncing_ball:":                  "\u26f9\ufe0f\u200d\u2640\ufe0f",
-	":woman_bowing:":                         "\U0001f647\u200d\u2640\ufe0f",
-	":woman_cartwheeling:":                   "\U0001f938\u200d\u2640\ufe0f",
-	":woman_climbing:":                       "\U0001f9d7\u200d\u2640\ufe0f",
-	":woman_construction_worker:":            "\U0001f477\u200d\u2640\ufe0f",
-	":woman_cook:":                           "\U0001f469\u200d\U0001f373",
-	":woman_dancing:":                        "\U0001f483",
-	":woman_detective:":                      "\U0001f575\ufe0f\u200d\u2640\ufe0f",
-	":woman_elf:":                            "\U0001f9dd\u200d\u2640\ufe0f",
-	":woman_facepalming:":                    "\U0001f926\u200d\u2640\ufe0f",
-	":woman_factory_worker:":                 "\U0001f469\u200d\U0001f3ed",
-	":woman_fairy:":                          "\U0001f9da\u200d\u2640\ufe0f",
-	":woman_farmer:":                         "\U0001f469\u200d\U0001f33e",
-	":woman_feeding_baby:":                   "\U0001f469\u200d\U0001f37c",
-	":woman_firefighter:":                    "\U0001f469\u200d\U0001f692",
-	":woman_frowning:":                       "\U0001f64d\u200d\u2640\ufe0f",
-	":woman_genie:":                          "\U0001f9de\u200d\u2640\ufe0f",
-	":woman_gesturing_no:":                   "\U0001f645\u200d\u2640\ufe0f",
-	":woman_gesturing_ok:":                   "\U0001f646\u200d\u2640\ufe0f",
-	":woman_getting_haircut:":                "\U0001f487\u200d\u2640\ufe0f",
-	":woman_getting_massage:":                "\U0001f486\u200d\u2640\ufe0f",
-	":woman_golfing:":                        "\U0001f3cc\ufe0f\u200d\u2640\ufe0f",
-	":woman_guard:":                          "\U0001f482\u200d\u2640\ufe0f",
-	":woman_health_worker:":                  "\U0001f469\u200d\u2695\ufe0f",
-	":woman_in_lotus_position:":              "\U0001f9d8\u200d\u2640\ufe0f",
-	":woman_in_manual_wheelchair:":           "\U0001f469\u200d\U0001f9bd",
-	":woman_in_motorized_wheelchair:":        "\U0001f469\u200d\U0001f9bc",
-	":woman_in_steamy_room:":                 "\U0001f9d6\u200d\u2640\ufe0f",
-	":woman_in_tuxedo:":                      "\U0001f935\u200d\u2640\ufe0f",
-	":woman_judge:":                          "\U0001f469\u200d\u2696\ufe0f",
-	":woman_juggling:":                       "\U0001f939\u200d\u2640\ufe0f",
-	":woman_kneeling:":                       "\U0001f9ce\u200d\u2640\ufe0f",
-	":woman_lifting_weights:":                "\U0001f3cb\ufe0f\u200d\u2640\ufe0f",
-	":woman_mage:":                           "\U0001f9d9\u200d\u2640\ufe0f",
-	":woman_mechanic:":                       "\U0001f469\u200d\U0001f527",
-	":woman_mountain_biking:":                "\U0001f6b5\u200d\u2640\ufe0f",
-	":woman_office_worker:":                  "\U0001f469\u200d\U0001f4bc",
-	":woman_pilot:":                          "\U0001f469\u200d\u2708\ufe0f",
-	":woman_playing_handball:":               "\U0001f93e\u200d\u2640\ufe0f",
-	":woman_playing_water_polo:":             "\U0001f93d\u200d\u2640\ufe0f",
-	":woman_police_officer:":                 "\U0001f46e\u200d\u2640\ufe0f",
-	":woman_pouting:":                        "\U0001f64e\u200d\u2640\ufe0f",
-	":woman_raising_hand:":                   "\U0001f64b\u200d\u2640\ufe0f",
-	":woman_rowing_boat:":                    "\U0001f6a3\u200d\u2640\ufe0f",
-	":woman_running:":                        "\U0001f3c3\u200d\u2640\ufe0f",
-	":woman_s_boot:":                         "\U0001f462",
-	":woman_s_clothes:":                      "\U0001f45a",
-	":woman_s_hat:":                          "\U0001f452",
-	":woman_s_sandal:":                       "\U0001f461",
-	":woman_scientist:":                      "\U0001f469\u200d\U0001f52c",
-	":woman_shrugging:":                      "\U0001f937\u200d\u2640\ufe0f",
-	":woman_singer:":                         "\U0001f469\u200d\U0001f3a4",
-	":woman_standing:":                       "\U0001f9cd\u200d\u2640\ufe0f",
-	":woman_student:":                        "\U0001f469\u200d\U0001f393",
-	":woman_superhero:":                      "\U0001f9b8\u200d\u2640\ufe0f",
-	":woman_supervillain:":                   "\U0001f9b9\u200d\u2640\ufe0f",
-	":woman_surfing:":                        "\U0001f3c4\u200d\u2640\ufe0f",
-	":woman_swimming:":                       "\U0001f3ca\u200d\u2640\ufe0f",
-	":woman_teacher:":                        "\U0001f469\u200d\U0001f3eb",
-	":woman_technologist:":                   "\U0001f469\u200d\U0001f4bb",
-	":woman_tipping_hand:":                   "\U0001f481\u200d\u2640\ufe0f",
-	":woman_vampire:":                        "\U0001f9db\u200d\u2640\ufe0f",
-	":woman_walking:":                        "\U0001f6b6\u200d\u2640\ufe0f",
-	":woman_wearing_turban:":                 "\U0001f473\u200d\u2640\ufe0f",
-	":woman_with_beard:":                     "\U0001f9d4\u200d\u2640\ufe0f",
-	":woman_with_blond_hair:":                "\U0001f471\u200d\u2640\ufe0f",
-	":woman_with_curly_hair:":                "\U0001f469\u200d\U0001f9b1",
-	":woman_with_headscarf:":                 "\U0001f9d5",
-	":woman_with_probing_cane:":              "\U0001f469\u200d\U0001f9af",
-	":woman_with_red_hair:":                  "\U0001f469\u200d\U0001f9b0",
-	":woman_with_turban:":                    "\U0001f473\u200d\u2640\ufe0f",
-	":woman_with_veil:":                      "\U0001f470\u200d\u2640\ufe0f",
-	":woman_with_white_cane:":                "\U0001f469\u200d\U0001f9af",
-	":woman_with_white_hair:":                "\U0001f469\u200d\U0001f9b3",
-	":woman_zombie:":                         "\U0001f9df\u200d\u2640\ufe0f",
-	":womans_clothes:":                       "\U0001f45a",
-	":womans_hat:":                           "\U0001f452",
-	":women_holding_hands:":                  "\U0001f46d",
-	":women_s_room:":                         "\U0001f6ba",
-	":women_with_bunny_ears:":                "\U0001f46f\u200d\u2640\ufe0f",
-	":women_wrestling:":                      "\U0001f93c\u200d\u2640\ufe0f",
-	":womens:":                               "\U0001f6ba",
-	":wood:":                                 "\U0001fab5",
-	":woozy_face:":                           "\U0001f974",
-	":world_map:":                            "\U0001f5fa\ufe0f",
-	":worm:":                                 "\U0001fab1",
-	":worried:":                              "\U0001f61f",
-	":worried_face:":                         "\U0001f61f",
-	":wrapped_gift:":                         "\U0001f381",
-	":wrench:":                               "\U0001f527",
-	":wrestling:":                            "\U0001f93c",
-	":writing_hand:":                         "\u270d\ufe0f",
-	":x:":                                    "\u274c",
-	":x_ray:":                                "\U0001fa7b",
-	":yarn:":                                 "\U0001f9f6",
-	":yawning_face:":                         "\U0001f971",
-	":yellow_circle:":                        "\U0001f7e1",
-	":yellow_heart:":                         "\U0001f49b",
-	":yellow_square:":                        "\U0001f7e8",
-	":yemen:":                                "\U0001f1fe\U0001f1ea",
-	":yen:":                                  "\U0001f4b4",
-	":yen_banknote:":                         "\U0001f4b4",
-	":yin_yang:":                             "\u262f\ufe0f",
-	":yo_yo:":                                "\U0001fa80",
-	":yum:":                                  "\U0001f60b",
-	":zambia:":                               "\U0001f1ff\U0001f1f2",
-	":zany_face:":                            "\U0001f92a",
-	":zap:":                                  "\u26a1",
-	":zebra:":                                "\U0001f993",
-	":zero:":                                 "0\ufe0f\u20e3",
-	":zimbabwe:":                             "\U0001f1ff\U0001f1fc",
-	":zipper_mouth_face:":                    "\U0001f910",
-	":zombie:":                               "\U0001f9df",
-	":zombie_man:":                           "\U0001f9df\u200d\u2642\ufe0f",
-	":zombie_woman:":                         "\U0001f9df\u200d\u2640\ufe0f",
-	":zzz:":                                  "\U0001f4a4",
-}
+// emojiMap is the codes of the emojis by their aliases.
+var emojiMap = &table{parts: []string{aliasData, aliasFlagData}}
+
+// aliasData is the aliases of the emojis other than flags, which are in aliasFlagData.
+const aliasData = "" +
+	":+1:\x00\U0001f44d\x00" +
+	":-1:\x00\U0001f44e\x00" +
+	":100:\x00\U0001f4af\x00" +
+	":1234:\x00\U0001f522\x00" +
+	":1st_place_medal:\x00\U0001f947\x00" +
+	":2nd_place_medal:\x00\U0001f948\x00" +
+	":3rd_place_medal:\x00\U0001f949\x00" +
+	":8ball:\x00\U0001f3b1\x00" +
+	":a:\x00\U0001f170\ufe0f\x00" +
+	":a_button_blood_type:\x00\U0001f170\ufe0f\x00" +
+	":ab:\x00\U0001f18e\x00" +
+	":ab_button_blood_type:\x00\U0001f18e\x00" +
+	":abacus:\x00\U0001f9ee\x00" +
+	":abc:\x00\U0001f524\x00" +
+	":abcd:\x00\U0001f521\x00" +
+	":accept:\x00\U0001f251\x00" +
+	":accordion:\x00\U0001fa97\x00" +
+	":adhesive_bandage:\x00\U0001fa79\x00" +
+	":admission_tickets:\x00\U0001f39f\ufe0f\x00" +
+	":adult:\x00\U0001f9d1\x00" +
+	":aerial_tramway:\x00\U0001f6a1\x00" +
+	":airplane:\x00\u2708\ufe0f\x00" +
+	":airplane_arrival:\x00\U0001f6ec\x00" +
+	":airplane_departure:\x00\U0001f6eb\x00" +
+	":alarm_clock:\x00\u23f0\x00" +
+	":alembic:\x00\u2697\ufe0f\x00" +
+	":alien:\x00\U0001f47d\x00" +
+	":alien_monster:\x00\U0001f47e\x00" +
+	":ambulance:\x00\U0001f691\x00" +
+	":american_football:\x00\U0001f3c8\x00" +
+	":amphora:\x00\U0001f3fa\x00" +
+	":anatomical_heart:\x00\U0001fac0\x00" +
+	":anchor:\x00\u2693\x00" +
+	":angel:\x00\U0001f47c\x00" +
+	":anger:\x00\U0001f4a2\x00" +
+	":anger_symbol:\x00\U0001f4a2\x00" +
+	":angry:\x00\U0001f620\x00" +
+	":angry_face:\x00\U0001f620\x00" +
+	":angry_face_with_horns:\x00\U0001f47f\x00" +
+	":anguished:\x00\U0001f627\x00" +
+	":anguished_face:\x00\U0001f627\x00" +
+	":ant:\x00\U0001f41c\x00" +
+	":antenna_bars:\x00\U0001f4f6\x00" +
+	":anxious_face_with_sweat:\x00\U0001f630\x00" +
+	":apple:\x00\U0001f34e\x00" +
+	":aquarius:\x00\u2652\x00" +
+	":aries:\x00\u2648\x00" +
+	":arrow_backward:\x00\u25c0\ufe0f\x00" +
+	":arrow_double_down:\x00\u23ec\x00" +
+	":arrow_double_up:\x00\u23eb\x00" +
+	":arrow_down:\x00\u2b07\ufe0f\x00" +
+	":arrow_down_small:\x00\U0001f53d\x00" +
+	":arrow_forward:\x00\u25b6\ufe0f\x00" +
+	":arrow_heading_down:\x00\u2935\ufe0f\x00" +
+	":arrow_heading_up:\x00\u2934\ufe0f\x00" +
+	":arrow_left:\x00\u2b05\ufe0f\x00" +
+	":arrow_lower_left:\x00\u2199\ufe0f\x00" +
+	":arrow_lower_right:\x00\u2198\ufe0f\x00" +
+	":arrow_right:\x00\u27a1\ufe0f\x00" +
+	":arrow_right_hook:\x00\u21aa\ufe0f\x00" +
+	":arrow_up:\x00\u2b06\ufe0f\x00" +
+	":arrow_up_down:\x00\u2195\ufe0f\x00" +
+	":arrow_up_small:\x00\U0001f53c\x00" +
+	":arrow_upper_left:\x00\u2196\ufe0f\x00" +
+	":arrow_upper_right:\x00\u2197\ufe0f\x00" +
+	":arrows_clockwise:\x00\U0001f503\x00" +
+	":arrows_counterclockwise:\x00\U0001f504\x00" +
+	":art:\x00\U0001f3a8\x00" +
+	":articulated_lorry:\x00\U0001f69b\x00" +
+	":artificial_satellite:\x00\U0001f6f0\ufe0f\x00" +
+	":artist:\x00\U0001f9d1\u200d\U0001f3a8\x00" +
+	":artist_palette:\x00\U0001f3a8\x00" +
+	":asterisk:\x00*\ufe0f\u20e3\x00" +
+	":astonished:\x00\U0001f632\x00" +
+	":astonished_face:\x00\U0001f632\x00" +
+	":astronaut:\x00\U0001f9d1\u200d\U0001f680\x00" +
+	":athletic_shoe:\x00\U0001f45f\x00" +
+	":atm:\x00\U0001f3e7\x00" +
+	":atm_sign:\x00\U0001f3e7\x00" +
+	":atom_symbol:\x00\u269b\ufe0f\x00" +
+	":auto_rickshaw:\x00\U0001f6fa\x00" +
+	":automobile:\x00\U0001f697\x00" +
+	":avocado:\x00\U0001f951\x00" +
+	":axe:\x00\U0001fa93\x00" +
+	":b:\x00\U0001f171\ufe0f\x00" +
+	":b_button_blood_type:\x00\U0001f171\ufe0f\x00" +
+	":baby:\x00\U0001f476\x00" +
+	":baby_angel:\x00\U0001f47c\x00" +
+	":baby_bottle:\x00\U0001f37c\x00" +
+	":baby_chick:\x00\U0001f424\x00" +
+	":baby_symbol:\x00\U0001f6bc\x00" +
+	":back:\x00\U0001f519\x00" +
+	":back_arrow:\x00\U0001f519\x00" +
+	":backhand_index_pointing_down:\x00\U0001f447\x00" +
+	":backhand_index_pointing_left:\x00\U0001f448\x00" +
+	":backhand_index_pointing_right:\x00\U0001f449\x00" +
+	":backhand_index_pointing_up:\x00\U0001f446\x00" +
+	":backpack:\x00\U0001f392\x00" +
+	":bacon:\x00\U0001f953\x00" +
+	":badger:\x00\U0001f9a1\x00" +
+	":badminton:\x00\U0001f3f8\x00" +
+	":bagel:\x00\U0001f96f\x00" +
+	":baggage_claim:\x00\U0001f6c4\x00" +
+	":baguette_bread:\x00\U0001f956\x00" +
+	":balance_scale:\x00\u2696\ufe0f\x00" +
+	":bald:\x00\U0001f9b2\x00" +
+	":bald_man:\x00\U0001f468\u200d\U0001f9b2\x00" +
+	":bald_woman:\x00\U0001f469\u200d\U0001f9b2\x00" +
+	":ballet_shoes:\x00\U0001fa70\x00" +
+	":balloon:\x00\U0001f388\x00" +
+	":ballot_box:\x00\U0001f5f3\ufe0f\x00" +
+	":ballot_box_with_ballot:\x00\U0001f5f3\ufe0f\x00" +
+	":ballot_box_with_check:\x00\u2611\ufe0f\x00" +
+	":bamboo:\x00\U0001f38d\x00" +
+	":banana:\x00\U0001f34c\x00" +
+	":bangbang:\x00\u203c\ufe0f\x00" +
+	":banjo:\x00\U0001fa95\x00" +
+	":bank:\x00\U0001f3e6\x00" +
+	":bar_chart:\x00\U0001f4ca\x00" +
+	":barber:\x00\U0001f488\x00" +
+	":barber_pole:\x00\U0001f488\x00" +
+	":baseball:\x00\u26be\x00" +
+	":basket:\x00\U0001f9fa\x00" +
+	":basketball:\x00\U0001f3c0\x00" +
+	":basketball_man:\x00\u26f9\ufe0f\u200d\u2642\ufe0f\x00" +
+	":basketball_woman:\x00\u26f9\ufe0f\u200d\u2640\ufe0f\x00" +
+	":bat:\x00\U0001f987\x00" +
+	":bath:\x00\U0001f6c0\x00" +
+	":bathtub:\x00\U0001f6c1\x00" +
+	":battery:\x00\U0001f50b\x00" +
+	":beach_umbrella:\x00\U0001f3d6\ufe0f\x00" +
+	":beach_with_umbrella:\x00\U0001f3d6\ufe0f\x00" +
+	":beaming_face_with_smiling_eyes:\x00\U0001f601\x00" +
+	":beans:\x00\U0001fad8\x00" +
+	":bear:\x00\U0001f43b\x00" +
+	":bearded_person:\x00\U0001f9d4\x00" +
+	":beating_heart:\x00\U0001f493\x00" +
+	":beaver:\x00\U0001f9ab\x00" +
+	":bed:\x00\U0001f6cf\ufe0f\x00" +
+	":bee:\x00\U0001f41d\x00" +
+	":beer:\x00\U0001f37a\x00" +
+	":beer_mug:\x00\U0001f37a\x00" +
+	":beers:\x00\U0001f37b\x00" +
+	":beetle:\x00\U0001fab2\x00" +
+	":beginner:\x00\U0001f530\x00" +
+	":bell:\x00\U0001f514\x00" +
+	":bell_pepper:\x00\U0001fad1\x00" +
+	":bell_with_slash:\x00\U0001f515\x00" +
+	":bellhop_bell:\x00\U0001f6ce\ufe0f\x00" +
+	":bento:\x00\U0001f371\x00" +
+	":bento_box:\x00\U0001f371\x00" +
+	":beverage_box:\x00\U0001f9c3\x00" +
+	":bicycle:\x00\U0001f6b2\x00" +
+	":bicyclist:\x00\U0001f6b4\x00" +
+	":bike:\x00\U0001f6b2\x00" +
+	":biking_man:\x00\U0001f6b4\u200d\u2642\ufe0f\x00" +
+	":biking_woman:\x00\U0001f6b4\u200d\u2640\ufe0f\x00" +
+	":bikini:\x00\U0001f459\x00" +
+	":billed_cap:\x00\U0001f9e2\x00" +
+	":biohazard:\x00\u2623\ufe0f\x00" +
+	":bird:\x00\U0001f426\x00" +
+	":birthday:\x00\U0001f382\x00" +
+	":birthday_cake:\x00\U0001f382\x00" +
+	":bison:\x00\U0001f9ac\x00" +
+	":biting_lip:\x00\U0001fae6\x00" +
+	":black_cat:\x00\U0001f408\u200d\u2b1b\x00" +
+	":black_circle:\x00\u26ab\x00" +
+	":black_heart:\x00\U0001f5a4\x00" +
+	":black_joker:\x00\U0001f0cf\x00" +
+	":black_large_square:\x00\u2b1b\x00" +
+	":black_medium_small_square:\x00\u25fe\x00" +
+	":black_medium_square:\x00\u25fc\ufe0f\x00" +
+	":black_nib:\x00\u2712\ufe0f\x00" +
+	":black_small_square:\x00\u25aa\ufe0f\x00" +
+	":black_square_button:\x00\U0001f532\x00" +
+	":blond_haired_man:\x00\U0001f471\u200d\u2642\ufe0f\x00" +
+	":blond_haired_person:\x00\U0001f471\x00" +
+	":blond_haired_woman:\x00\U0001f471\u200d\u2640\ufe0f\x00" +
+	":blonde_woman:\x00\U0001f471\u200d\u2640\ufe0f\x00" +
+	":blossom:\x00\U0001f33c\x00" +
+	":blowfish:\x00\U0001f421\x00" +
+	":blue_book:\x00\U0001f4d8\x00" +
+	":blue_car:\x00\U0001f699\x00" +
+	":blue_circle:\x00\U0001f535\x00" +
+	":blue_heart:\x00\U0001f499\x00" +
+	":blue_square:\x00\U0001f7e6\x00" +
+	":blueberries:\x00\U0001fad0\x00" +
+	":blush:\x00\U0001f60a\x00" +
+	":boar:\x00\U0001f417\x00" +
+	":boat:\x00\u26f5\x00" +
+	":bomb:\x00\U0001f4a3\x00" +
+	":bone:\x00\U0001f9b4\x00" +
+	":book:\x00\U0001f4d6\x00" +
+	":bookmark:\x00\U0001f516\x00" +
+	":bookmark_tabs:\x00\U0001f4d1\x00" +
+	":books:\x00\U0001f4da\x00" +
+	":boom:\x00\U0001f4a5\x00" +
+	":boomerang:\x00\U0001fa83\x00" +
+	":boot:\x00\U0001f462\x00" +
+	":bottle_with_popping_cork:\x00\U0001f37e\x00" +
+	":bouncing_ball_man:\x00\u26f9\ufe0f\u200d\u2642\ufe0f\x00" +
+	":bouncing_ball_person:\x00\u26f9\ufe0f\x00" +
+	":bouncing_ball_woman:\x00\u26f9\ufe0f\u200d\u2640\ufe0f\x00" +
+	":bouquet:\x00\U0001f490\x00" +
+	":bow:\x00\U0001f647\x00" +
+	":bow_and_arrow:\x00\U0001f3f9\x00" +
+	":bowing_man:\x00\U0001f647\u200d\u2642\ufe0f\x00" +
+	":bowing_woman:\x00\U0001f647\u200d\u2640\ufe0f\x00" +
+	":bowl_with_spoon:\x00\U0001f963\x00" +
+	":bowling:\x00\U0001f3b3\x00" +
+	":boxing_glove:\x00\U0001f94a\x00" +
+	":boy:\x00\U0001f466\x00" +
+	":brain:\x00\U0001f9e0\x00" +
+	":bread:\x00\U0001f35e\x00" +
+	":breast_feeding:\x00\U0001f931\x00" +
+	":brick:\x00\U0001f9f1\x00" +
+	":bricks:\x00\U0001f9f1\x00" +
+	":bride_with_veil:\x00\U0001f470\u200d\u2640\ufe0f\x00" +
+	":bridge_at_night:\x00\U0001f309\x00" +
+	":briefcase:\x00\U0001f4bc\x00" +
+	":briefs:\x00\U0001fa72\x00" +
+	":bright_button:\x00\U0001f506\x00" +
+	":broccoli:\x00\U0001f966\x00" +
+	":broken_heart:\x00\U0001f494\x00" +
+	":broom:\x00\U0001f9f9\x00" +
+	":brown_circle:\x00\U0001f7e4\x00" +
+	":brown_heart:\x00\U0001f90e\x00" +
+	":brown_square:\x00\U0001f7eb\x00" +
+	":bubble_tea:\x00\U0001f9cb\x00" +
+	":bubbles:\x00\U0001fae7\x00" +
+	":bucket:\x00\U0001faa3\x00" +
+	":bug:\x00\U0001f41b\x00" +
+	":building_construction:\x00\U0001f3d7\ufe0f\x00" +
+	":bulb:\x00\U0001f4a1\x00" +
+	":bullet_train:\x00\U0001f685\x00" +
+	":bullettrain_front:\x00\U0001f685\x00" +
+	":bullettrain_side:\x00\U0001f684\x00" +
+	":bullseye:\x00\U0001f3af\x00" +
+	":burrito:\x00\U0001f32f\x00" +
+	":bus:\x00\U0001f68c\x00" +
+	":bus_stop:\x00\U0001f68f\x00" +
+	":business_suit_levitating:\x00\U0001f574\ufe0f\x00" +
+	":busstop:\x00\U0001f68f\x00" +
+	":bust_in_silhouette:\x00\U0001f464\x00" +
+	":busts_in_silhouette:\x00\U0001f465\x00" +
+	":butter:\x00\U0001f9c8\x00" +
+	":butterfly:\x00\U0001f98b\x00" +
+	":cactus:\x00\U0001f335\x00" +
+	":cake:\x00\U0001f370\x00" +
+	":calendar:\x00\U0001f4c6\x00" +
+	":call_me_hand:\x00\U0001f919\x00" +
+	":calling:\x00\U0001f4f2\x00" +
+	":camel:\x00\U0001f42b\x00" +
+	":camera:\x00\U0001f4f7\x00" +
+	":camera_flash:\x00\U0001f4f8\x00" +
+	":camera_with_flash:\x00\U0001f4f8\x00" +
+	":camping:\x00\U0001f3d5\ufe0f\x00" +
+	":cancer:\x00\u264b\x00" +
+	":candle:\x00\U0001f56f\ufe0f\x00" +
+	":candy:\x00\U0001f36c\x00" +
+	":canned_food:\x00\U0001f96b\x00" +
+	":canoe:\x00\U0001f6f6\x00" +
+	":capital_abcd:\x00\U0001f520\x00" +
+	":capricorn:\x00\u2651\x00" +
+	":car:\x00\U0001f697\x00" +
+	":card_file_box:\x00\U0001f5c3\ufe0f\x00" +
+	":card_index:\x00\U0001f4c7\x00" +
+	":card_index_dividers:\x00\U0001f5c2\ufe0f\x00" +
+	":carousel_horse:\x00\U0001f3a0\x00" +
+	":carp_streamer:\x00\U0001f38f\x00" +
+	":carpentry_saw:\x00\U0001fa9a\x00" +
+	":carrot:\x00\U0001f955\x00" +
+	":cartwheeling:\x00\U0001f938\x00" +
+	":castle:\x00\U0001f3f0\x00" +
+	":cat2:\x00\U0001f408\x00" +
+	":cat:\x00\U0001f431\x00" +
+	":cat_face:\x00\U0001f431\x00" +
+	":cat_with_tears_of_joy:\x00\U0001f639\x00" +
+	":cat_with_wry_smile:\x00\U0001f63c\x00" +
+	":cd:\x00\U0001f4bf\x00" +
+	":chains:\x00\u26d3\ufe0f\x00" +
+	":chair:\x00\U0001fa91\x00" +
+	":champagne:\x00\U0001f37e\x00" +
+	":chart:\x00\U0001f4b9\x00" +
+	":chart_decreasing:\x00\U0001f4c9\x00" +
+	":chart_increasing:\x00\U0001f4c8\x00" +
+	":chart_increasing_with_yen:\x00\U0001f4b9\x00" +
+	":chart_with_downwards_trend:\x00\U0001f4c9\x00" +
+	":chart_with_upwards_trend:\x00\U0001f4c8\x00" +
+	":check_box_with_check:\x00\u2611\ufe0f\x00" +
+	":check_mark:\x00\u2714\ufe0f\x00" +
+	":check_mark_button:\x00\u2705\x00" +
+	":cheese:\x00\U0001f9c0\x00" +
+	":cheese_wedge:\x00\U0001f9c0\x00" +
+	":cherries:\x00\U0001f352\x00" +
+	":cherry_blossom:\x00\U0001f338\x00" +
+	":chess_pawn:\x00\u265f\ufe0f\x00" +
+	":chestnut:\x00\U0001f330\x00" +
+	":chicken:\x00\U0001f414\x00" +
+	":child:\x00\U0001f9d2\x00" +
+	":children_crossing:\x00\U0001f6b8\x00" +
+	":chipmunk:\x00\U0001f43f\ufe0f\x00" +
+	":chocolate_bar:\x00\U0001f36b\x00" +
+	":chopsticks:\x00\U0001f962\x00" +
+	":christmas_tree:\x00\U0001f384\x00" +
+	":church:\x00\u26ea\x00" +
+	":cigarette:\x00\U0001f6ac\x00" +
+	":cinema:\x00\U0001f3a6\x00" +
+	":circled_m:\x00\u24c2\ufe0f\x00" +
+	":circus_tent:\x00\U0001f3aa\x00" +
+	":city_sunrise:\x00\U0001f307\x00" +
+	":city_sunset:\x00\U0001f306\x00" +
+	":cityscape:\x00\U0001f3d9\ufe0f\x00" +
+	":cityscape_at_dusk:\x00\U0001f306\x00" +
+	":cl:\x00\U0001f191\x00" +
+	":cl_button:\x00\U0001f191\x00" +
+	":clamp:\x00\U0001f5dc\ufe0f\x00" +
+	":clap:\x00\U0001f44f\x00" +
+	":clapper:\x00\U0001f3ac\x00" +
+	":clapper_board:\x00\U0001f3ac\x00" +
+	":clapping_hands:\x00\U0001f44f\x00" +
+	":classical_building:\x00\U0001f3db\ufe0f\x00" +
+	":climbing:\x00\U0001f9d7\x00" +
+	":climbing_man:\x00\U0001f9d7\u200d\u2642\ufe0f\x00" +
+	":climbing_woman:\x00\U0001f9d7\u200d\u2640\ufe0f\x00" +
+	":clinking_beer_mugs:\x00\U0001f37b\x00" +
+	":clinking_glasses:\x00\U0001f942\x00" +
+	":clipboard:\x00\U0001f4cb\x00" +
+	":clock1030:\x00\U0001f565\x00" +
+	":clock10:\x00\U0001f559\x00" +
+	":clock1130:\x00\U0001f566\x00" +
+	":clock11:\x00\U0001f55a\x00" +
+	":clock1230:\x00\U0001f567\x00" +
+	":clock12:\x00\U0001f55b\x00" +
+	":clock130:\x00\U0001f55c\x00" +
+	":clock1:\x00\U0001f550\x00" +
+	":clock230:\x00\U0001f55d\x00" +
+	":clock2:\x00\U0001f551\x00" +
+	":clock330:\x00\U0001f55e\x00" +
+	":clock3:\x00\U0001f552\x00" +
+	":clock430:\x00\U0001f55f\x00" +
+	":clock4:\x00\U0001f553\x00" +
+	":clock530:\x00\U0001f560\x00" +
+	":clock5:\x00\U0001f554\x00" +
+	":clock630:\x00\U0001f561\x00" +
+	":clock6:\x00\U0001f555\x00" +
+	":clock730:\x00\U0001f562\x00" +
+	":clock7:\x00\U0001f556\x00" +
+	":clock830:\x00\U0001f563\x00" +
+	":clock8:\x00\U0001f557\x00" +
+	":clock930:\x00\U0001f564\x00" +
+	":clock9:\x00\U0001f558\x00" +
+	":clockwise_vertical_arrows:\x00\U0001f503\x00" +
+	":closed_book:\x00\U0001f4d5\x00" +
+	":closed_lock_with_key:\x00\U0001f510\x00" +
+	":closed_mailbox_with_lowered_flag:\x00\U0001f4ea\x00" +
+	":closed_mailbox_with_raised_flag:\x00\U0001f4eb\x00" +
+	":closed_umbrella:\x00\U0001f302\x00" +
+	":cloud:\x00\u2601\ufe0f\x00" +
+	":cloud_with_lightning:\x00\U0001f329\ufe0f\x00" +
+	":cloud_with_lightning_and_rain:\x00\u26c8\ufe0f\x00" +
+	":cloud_with_rain:\x00\U0001f327\ufe0f\x00" +
+	":cloud_with_snow:\x00\U0001f328\ufe0f\x00" +
+	":clown_face:\x00\U0001f921\x00" +
+	":club_suit:\x00\u2663\ufe0f\x00" +
+	":clubs:\x00\u2663\ufe0f\x00" +
+	":clutch_bag:\x00\U0001f45d\x00" +
+	":coat:\x00\U0001f9e5\x00" +
+	":cockroach:\x00\U0001fab3\x00" +
+	":cocktail:\x00\U0001f378\x00" +
+	":cocktail_glass:\x00\U0001f378\x00" +
+	":coconut:\x00\U0001f965\x00" +
+	":coffee:\x00\u2615\x00" +
+	":coffin:\x00\u26b0\ufe0f\x00" +
+	":coin:\x00\U0001fa99\x00" +
+	":cold_face:\x00\U0001f976\x00" +
+	":cold_sweat:\x00\U0001f630\x00" +
+	":collision:\x00\U0001f4a5\x00" +
+	":comet:\x00\u2604\ufe0f\x00" +
+	":compass:\x00\U0001f9ed\x00" +
+	":computer:\x00\U0001f4bb\x00" +
+	":computer_disk:\x00\U0001f4bd\x00" +
+	":computer_mouse:\x00\U0001f5b1\ufe0f\x00" +
+	":confetti_ball:\x00\U0001f38a\x00" +
+	":confounded:\x00\U0001f616\x00" +
+	":confounded_face:\x00\U0001f616\x00" +
+	":confused:\x00\U0001f615\x00" +
+	":confused_face:\x00\U0001f615\x00" +
+	":congratulations:\x00\u3297\ufe0f\x00" +
+	":construction:\x00\U0001f6a7\x00" +
+	":construction_worker:\x00\U0001f477\x00" +
+	":construction_worker_man:\x00\U0001f477\u200d\u2642\ufe0f\x00" +
+	":construction_worker_woman:\x00\U0001f477\u200d\u2640\ufe0f\x00" +
+	":control_knobs:\x00\U0001f39b\ufe0f\x00" +
+	":convenience_store:\x00\U0001f3ea\x00" +
+	":cook:\x00\U0001f9d1\u200d\U0001f373\x00" +
+	":cooked_rice:\x00\U0001f35a\x00" +
+	":cookie:\x00\U0001f36a\x00" +
+	":cooking:\x00\U0001f373\x00" +
+	":cool:\x00\U0001f192\x00" +
+	":cool_button:\x00\U0001f192\x00" +
+	":cop:\x00\U0001f46e\x00" +
+	":copyright:\x00\u00a9\ufe0f\x00" +
+	":coral:\x00\U0001fab8\x00" +
+	":corn:\x00\U0001f33d\x00" +
+	":couch_and_lamp:\x00\U0001f6cb\ufe0f\x00" +
+	":counterclockwise_arrows_button:\x00\U0001f504\x00" +
+	":couple:\x00\U0001f46b\x00" +
+	":couple_with_heart:\x00\U0001f491\x00" +
+	":couple_with_heart_man_man:\x00\U0001f468\u200d\u2764\ufe0f\u200d\U0001f468\x00" +
+	":couple_with_heart_person_person:\x00\U0001f9d1\U0001f3fb\u200d\u2764\ufe0f\u200d\U0001f9d1\U0001f3fc\x00" +
+	":couple_with_heart_woman_man:\x00\U0001f469\u200d\u2764\ufe0f\u200d\U0001f468\x00" +
+	":couple_with_heart_woman_woman:\x00\U0001f469\u200d\u2764\ufe0f\u200d\U0001f469\x00" +
+	":couplekiss:\x00\U0001f48f\x00" +
+	":couplekiss_man_man:\x00\U0001f468\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468\x00" +
+	":couplekiss_man_woman:\x00\U0001f469\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468\x00" +
+	":couplekiss_woman_woman:\x00\U0001f469\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f469\x00" +
+	":cow2:\x00\U0001f404\x00" +
+	":cow:\x00\U0001f42e\x00" +
+	":cow_face:\x00\U0001f42e\x00" +
+	":cowboy_hat_face:\x00\U0001f920\x00" +
+	":crab:\x00\U0001f980\x00" +
+	":crayon:\x00\U0001f58d\ufe0f\x00" +
+	":credit_card:\x00\U0001f4b3\x00" +
+	":crescent_moon:\x00\U0001f319\x00" +
+	":cricket:\x00\U0001f997\x00" +
+	":cricket_game:\x00\U0001f3cf\x00" +
+	":crocodile:\x00\U0001f40a\x00" +
+	":croissant:\x00\U0001f950\x00" +
+	":cross_mark:\x00\u274c\x00" +
+	":cross_mark_button:\x00\u274e\x00" +
+	":crossed_fingers:\x00\U0001f91e\x00" +
+	":crossed_swords:\x00\u2694\ufe0f\x00" +
+	":crown:\x00\U0001f451\x00" +
+	":crutch:\x00\U0001fa7c\x00" +
+	":cry:\x00\U0001f622\x00" +
+	":crying_cat:\x00\U0001f63f\x00" +
+	":crying_cat_face:\x00\U0001f63f\x00" +
+	":crying_face:\x00\U0001f622\x00" +
+	":crystal_ball:\x00\U0001f52e\x00" +
+	":cucumber:\x00\U0001f952\x00" +
+	":cup_with_straw:\x00\U0001f964\x00" +
+	":cupcake:\x00\U0001f9c1\x00" +
+	":cupid:\x00\U0001f498\x00" +
+	":curling_stone:\x00\U0001f94c\x00" +
+	":curly_hair:\x00\U0001f9b1\x00" +
+	":curly_haired_man:\x00\U0001f468\u200d\U0001f9b1\x00" +
+	":curly_haired_woman:\x00\U0001f469\u200d\U0001f9b1\x00" +
+	":curly_loop:\x00\u27b0\x00" +
+	":currency_exchange:\x00\U0001f4b1\x00" +
+	":curry:\x00\U0001f35b\x00" +
+	":curry_rice:\x00\U0001f35b\x00" +
+	":cursing_face:\x00\U0001f92c\x00" +
+	":custard:\x00\U0001f36e\x00" +
+	":customs:\x00\U0001f6c3\x00" +
+	":cut_of_meat:\x00\U0001f969\x00" +
+	":cyclone:\x00\U0001f300\x00" +
+	":dagger:\x00\U0001f5e1\ufe0f\x00" +
+	":dancer:\x00\U0001f483\x00" +
+	":dancers:\x00\U0001f46f\x00" +
+	":dancing_men:\x00\U0001f46f\u200d\u2642\ufe0f\x00" +
+	":dancing_women:\x00\U0001f46f\u200d\u2640\ufe0f\x00" +
+	":dango:\x00\U0001f361\x00" +
+	":dark_skin_tone:\x00\U0001f3ff\x00" +
+	":dark_sunglasses:\x00\U0001f576\ufe0f\x00" +
+	":dart:\x00\U0001f3af\x00" +
+	":dash:\x00\U0001f4a8\x00" +
+	":dashing_away:\x00\U0001f4a8\x00" +
+	":date:\x00\U0001f4c5\x00" +
+	":deaf_man:\x00\U0001f9cf\u200d\u2642\ufe0f\x00" +
+	":deaf_person:\x00\U0001f9cf\x00" +
+	":deaf_woman:\x00\U0001f9cf\u200d\u2640\ufe0f\x00" +
+	":deciduous_tree:\x00\U0001f333\x00" +
+	":deer:\x00\U0001f98c\x00" +
+	":delivery_truck:\x00\U0001f69a\x00" +
+	":department_store:\x00\U0001f3ec\x00" +
+	":derelict_house:\x00\U0001f3da\ufe0f\x00" +
+	":desert:\x00\U0001f3dc\ufe0f\x00" +
+	":desert_island:\x00\U0001f3dd\ufe0f\x00" +
+	":desktop_computer:\x00\U0001f5a5\ufe0f\x00" +
+	":detective:\x00\U0001f575\ufe0f\x00" +
+	":diamond_shape_with_a_dot_inside:\x00\U0001f4a0\x00" +
+	":diamond_suit:\x00\u2666\ufe0f\x00" +
+	":diamond_with_a_dot:\x00\U0001f4a0\x00" +
+	":diamonds:\x00\u2666\ufe0f\x00" +
+	":dim_button:\x00\U0001f505\x00" +
+	":disappointed:\x00\U0001f61e\x00" +
+	":disappointed_face:\x00\U0001f61e\x00" +
+	":disappointed_relieved:\x00\U0001f625\x00" +
+	":disguised_face:\x00\U0001f978\x00" +
+	":divide:\x00\u2797\x00" +
+	":diving_mask:\x00\U0001f93f\x00" +
+	":diya_lamp:\x00\U0001fa94\x00" +
+	":dizzy:\x00\U0001f4ab\x00" +
+	":dizzy_face:\x00\U0001f635\x00" +
+	":dna:\x00\U0001f9ec\x00" +
+	":do_not_litter:\x00\U0001f6af\x00" +
+	":dodo:\x00\U0001f9a4\x00" +
+	":dog2:\x00\U0001f415\x00" +
+	":dog:\x00\U0001f436\x00" +
+	":dog_face:\x00\U0001f436\x00" +
+	":dollar:\x00\U0001f4b5\x00" +
+	":dollar_banknote:\x00\U0001f4b5\x00" +
+	":dolls:\x00\U0001f38e\x00" +
+	":dolphin:\x00\U0001f42c\x00" +
+	":door:\x00\U0001f6aa\x00" +
+	":dotted_line_face:\x00\U0001fae5\x00" +
+	":dotted_six_pointed_star:\x00\U0001f52f\x00" +
+	":double_curly_loop:\x00\u27bf\x00" +
+	":double_exclamation_mark:\x00\u203c\ufe0f\x00" +
+	":doughnut:\x00\U0001f369\x00" +
+	":dove:\x00\U0001f54a\ufe0f\x00" +
+	":down_arrow:\x00\u2b07\ufe0f\x00" +
+	":down_left_arrow:\x00\u2199\ufe0f\x00" +
+	":down_right_arrow:\x00\u2198\ufe0f\x00" +
+	":downcast_face_with_sweat:\x00\U0001f613\x00" +
+	":downwards_button:\x00\U0001f53d\x00" +
+	":dragon:\x00\U0001f409\x00" +
+	":dragon_face:\x00\U0001f432\x00" +
+	":dress:\x00\U0001f457\x00" +
+	":dromedary_camel:\x00\U0001f42a\x00" +
+	":drooling_face:\x00\U0001f924\x00" +
+	":drop_of_blood:\x00\U0001fa78\x00" +
+	":droplet:\x00\U0001f4a7\x00" +
+	":drum:\x00\U0001f941\x00" +
+	":duck:\x00\U0001f986\x00" +
+	":dumpling:\x00\U0001f95f\x00" +
+	":dvd:\x00\U0001f4c0\x00" +
+	":e-mail:\x00\U0001f4e7\x00" +
+	":e_mail:\x00\U0001f4e7\x00" +
+	":eagle:\x00\U0001f985\x00" +
+	":ear:\x00\U0001f442\x00" +
+	":ear_of_corn:\x00\U0001f33d\x00" +
+	":ear_of_rice:\x00\U0001f33e\x00" +
+	":ear_with_hearing_aid:\x00\U0001f9bb\x00" +
+	":earth_africa:\x00\U0001f30d\x00" +
+	":earth_americas:\x00\U0001f30e\x00" +
+	":earth_asia:\x00\U0001f30f\x00" +
+	":egg:\x00\U0001f95a\x00" +
+	":eggplant:\x00\U0001f346\x00" +
+	":eight:\x008\ufe0f\u20e3\x00" +
+	":eight_o_clock:\x00\U0001f557\x00" +
+	":eight_pointed_black_star:\x00\u2734\ufe0f\x00" +
+	":eight_pointed_star:\x00\u2734\ufe0f\x00" +
+	":eight_spoked_asterisk:\x00\u2733\ufe0f\x00" +
+	":eight_thirty:\x00\U0001f563\x00" +
+	":eject_button:\x00\u23cf\ufe0f\x00" +
+	":electric_plug:\x00\U0001f50c\x00" +
+	":elephant:\x00\U0001f418\x00" +
+	":elevator:\x00\U0001f6d7\x00" +
+	":eleven_o_clock:\x00\U0001f55a\x00" +
+	":eleven_thirty:\x00\U0001f566\x00" +
+	":elf:\x00\U0001f9dd\x00" +
+	":elf_man:\x00\U0001f9dd\u200d\u2642\ufe0f\x00" +
+	":elf_woman:\x00\U0001f9dd\u200d\u2640\ufe0f\x00" +
+	":email:\x00\U0001f4e7\x00" +
+	":empty_nest:\x00\U0001fab9\x00" +
+	":end:\x00\U0001f51a\x00" +
+	":end_arrow:\x00\U0001f51a\x00" +
+	":envelope:\x00\u2709\ufe0f\x00" +
+	":envelope_with_arrow:\x00\U0001f4e9\x00" +
+	":euro:\x00\U0001f4b6\x00" +
+	":euro_banknote:\x00\U0001f4b6\x00" +
+	":european_castle:\x00\U0001f3f0\x00" +
+	":european_post_office:\x00\U0001f3e4\x00" +
+	":evergreen_tree:\x00\U0001f332\x00" +
+	":ewe:\x00\U0001f411\x00" +
+	":exclamation:\x00\u2757\x00" +
+	":exclamation_question_mark:\x00\u2049\ufe0f\x00" +
+	":exploding_head:\x00\U0001f92f\x00" +
+	":expressionless:\x00\U0001f611\x00" +
+	":expressionless_face:\x00\U0001f611\x00" +
+	":eye:\x00\U0001f441\ufe0f\x00" +
+	":eye_in_speech_bubble:\x00\U0001f441\ufe0f\u200d\U0001f5e8\ufe0f\x00" +
+	":eye_speech_bubble:\x00\U0001f441\ufe0f\u200d\U0001f5e8\ufe0f\x00" +
+	":eyeglasses:\x00\U0001f453\x00" +
+	":eyes:\x00\U0001f440\x00" +
+	":face_blowing_a_kiss:\x00\U0001f618\x00" +
+	":face_exhaling:\x00\U0001f62e\u200d\U0001f4a8\x00" +
+	":face_holding_back_tears:\x00\U0001f979\x00" +
+	":face_in_clouds:\x00\U0001f636\u200d\U0001f32b\ufe0f\x00" +
+	":face_savoring_food:\x00\U0001f60b\x00" +
+	":face_screaming_in_fear:\x00\U0001f631\x00" +
+	":face_vomiting:\x00\U0001f92e\x00" +
+	":face_with_crossed_out_eyes:\x00\U0001f635\x00" +
+	":face_with_diagonal_mouth:\x00\U0001fae4\x00" +
+	":face_with_hand_over_mouth:\x00\U0001f92d\x00" +
+	":face_with_head_bandage:\x00\U0001f915\x00" +
+	":face_with_medical_mask:\x00\U0001f637\x00" +
+	":face_with_monocle:\x00\U0001f9d0\x00" +
+	":face_with_open_eyes_and_hand_over_mouth:\x00\U0001fae2\x00" +
+	":face_with_open_mouth:\x00\U0001f62e\x00" +
+	":face_with_peeking_eye:\x00\U0001fae3\x00" +
+	":face_with_raised_eyebrow:\x00\U0001f928\x00" +
+	":face_with_rolling_eyes:\x00\U0001f644\x00" +
+	":face_with_spiral_eyes:\x00\U0001f635\u200d\U0001f4ab\x00" +
+	":face_with_steam_from_nose:\x00\U0001f624\x00" +
+	":face_with_symbols_on_mouth:\x00\U0001f92c\x00" +
+	":face_with_tears_of_joy:\x00\U0001f602\x00" +
+	":face_with_thermometer:\x00\U0001f912\x00" +
+	":face_with_tongue:\x00\U0001f61b\x00" +
+	":face_without_mouth:\x00\U0001f636\x00" +
+	":facepalm:\x00\U0001f926\x00" +
+	":facepunch:\x00\U0001f44a\x00" +
+	":factory:\x00\U0001f3ed\x00" +
+	":factory_worker:\x00\U0001f9d1\u200d\U0001f3ed\x00" +
+	":fairy:\x00\U0001f9da\x00" +
+	":fairy_man:\x00\U0001f9da\u200d\u2642\ufe0f\x00" +
+	":fairy_woman:\x00\U0001f9da\u200d\u2640\ufe0f\x00" +
+	":falafel:\x00\U0001f9c6\x00" +
+	":fallen_leaf:\x00\U0001f342\x00" +
+	":family:\x00\U0001f46a\x00" +
+	":family_man_boy:\x00\U0001f468\u200d\U0001f466\x00" +
+	":family_man_boy_boy:\x00\U0001f468\u200d\U0001f466\u200d\U0001f466\x00" +
+	":family_man_girl:\x00\U0001f468\u200d\U0001f467\x00" +
+	":family_man_girl_boy:\x00\U0001f468\u200d\U0001f467\u200d\U0001f466\x00" +
+	":family_man_girl_girl:\x00\U0001f468\u200d\U0001f467\u200d\U0001f467\x00" +
+	":family_man_man_boy:\x00\U0001f468\u200d\U0001f468\u200d\U0001f466\x00" +
+	":family_man_man_boy_boy:\x00\U0001f468\u200d\U0001f468\u200d\U0001f466\u200d\U0001f466\x00" +
+	":family_man_man_girl:\x00\U0001f468\u200d\U0001f468\u200d\U0001f467\x00" +
+	":family_man_man_girl_boy:\x00\U0001f468\u200d\U0001f468\u200d\U0001f467\u200d\U0001f466\x00" +
+	":family_man_man_girl_girl:\x00\U0001f468\u200d\U0001f468\u200d\U0001f467\u200d\U0001f467\x00" +
+	":family_man_woman_boy:\x00\U0001f468\u200d\U0001f469\u200d\U0001f466\x00" +
+	":family_man_woman_boy_boy:\x00\U0001f468\u200d\U0001f469\u200d\U0001f466\u200d\U0001f466\x00" +
+	":family_man_woman_girl:\x00\U0001f468\u200d\U0001f469\u200d\U0001f467\x00" +
+	":family_man_woman_girl_boy:\x00\U0001f468\u200d\U0001f469\u200d\U0001f467\u200d\U0001f466\x00" +
+	":family_man_woman_girl_girl:\x00\U0001f468\u200d\U0001f469\u200d\U0001f467\u200d\U0001f467\x00" +
+	":family_woman_boy:\x00\U0001f469\u200d\U0001f466\x00" +
+	":family_woman_boy_boy:\x00\U0001f469\u200d\U0001f466\u200d\U0001f466\x00" +
+	":family_woman_girl:\x00\U0001f469\u200d\U0001f467\x00" +
+	":family_woman_girl_boy:\x00\U0001f469\u200d\U0001f467\u200d\U0001f466\x00" +
+	":family_woman_girl_girl:\x00\U0001f469\u200d\U0001f467\u200d\U0001f467\x00" +
+	":family_woman_woman_boy:\x00\U0001f469\u200d\U0001f469\u200d\U0001f466\x00" +
+	":family_woman_woman_boy_boy:\x00\U0001f469\u200d\U0001f469\u200d\U0001f466\u200d\U0001f466\x00" +
+	":family_woman_woman_girl:\x00\U0001f469\u200d\U0001f469\u200d\U0001f467\x00" +
+	":family_woman_woman_girl_boy:\x00\U0001f469\u200d\U0001f469\u200d\U0001f467\u200d\U0001f466\x00" +
+	":family_woman_woman_girl_girl:\x00\U0001f469\u200d\U0001f469\u200d\U0001f467\u200d\U0001f467\x00" +
+	":farmer:\x00\U0001f9d1\u200d\U0001f33e\x00" +
+	":fast_down_button:\x00\u23ec\x00" +
+	":fast_forward:\x00\u23e9\x00" +
+	":fast_forward_button:\x00\u23e9\x00" +
+	":fast_reverse_button:\x00\u23ea\x00" +
+	":fast_up_button:\x00\u23eb\x00" +
+	":fax:\x00\U0001f4e0\x00" +
+	":fax_machine:\x00\U0001f4e0\x00" +
+	":fearful:\x00\U0001f628\x00" +
+	":fearful_face:\x00\U0001f628\x00" +
+	":feather:\x00\U0001fab6\x00" +
+	":feet:\x00\U0001f43e\x00" +
+	":female_detective:\x00\U0001f575\ufe0f\u200d\u2640\ufe0f\x00" +
+	":female_sign:\x00\u2640\ufe0f\x00" +
+	":ferris_wheel:\x00\U0001f3a1\x00" +
+	":ferry:\x00\u26f4\ufe0f\x00" +
+	":field_hockey:\x00\U0001f3d1\x00" +
+	":file_cabinet:\x00\U0001f5c4\ufe0f\x00" +
+	":file_folder:\x00\U0001f4c1\x00" +
+	":film_frames:\x00\U0001f39e\ufe0f\x00" +
+	":film_projector:\x00\U0001f4fd\ufe0f\x00" +
+	":film_strip:\x00\U0001f39e\ufe0f\x00" +
+	":fire:\x00\U0001f525\x00" +
+	":fire_engine:\x00\U0001f692\x00" +
+	":fire_extinguisher:\x00\U0001f9ef\x00" +
+	":firecracker:\x00\U0001f9e8\x00" +
+	":firefighter:\x00\U0001f9d1\u200d\U0001f692\x00" +
+	":fireworks:\x00\U0001f386\x00" +
+	":first_place_medal:\x00\U0001f947\x00" +
+	":first_quarter_moon:\x00\U0001f313\x00" +
+	":first_quarter_moon_face:\x00\U0001f31b\x00" +
+	":first_quarter_moon_with_face:\x00\U0001f31b\x00" +
+	":fish:\x00\U0001f41f\x00" +
+	":fish_cake:\x00\U0001f365\x00" +
+	":fish_cake_with_swirl:\x00\U0001f365\x00" +
+	":fishing_pole:\x00\U0001f3a3\x00" +
+	":fishing_pole_and_fish:\x00\U0001f3a3\x00" +
+	":fist:\x00\u270a\x00" +
+	":fist_left:\x00\U0001f91b\x00" +
+	":fist_oncoming:\x00\U0001f44a\x00" +
+	":fist_raised:\x00\u270a\x00" +
+	":fist_right:\x00\U0001f91c\x00" +
+	":five:\x005\ufe0f\u20e3\x00" +
+	":five_o_clock:\x00\U0001f554\x00" +
+	":five_thirty:\x00\U0001f560\x00" +
+	":flag_in_hole:\x00\u26f3\x00" +
+	":flags:\x00\U0001f38f\x00" +
+	":flamingo:\x00\U0001f9a9\x00" +
+	":flashlight:\x00\U0001f526\x00" +
+	":flat_shoe:\x00\U0001f97f\x00" +
+	":flatbread:\x00\U0001fad3\x00" +
+	":fleur_de_lis:\x00\u269c\ufe0f\x00" +
+	":flexed_biceps:\x00\U0001f4aa\x00" +
+	":flight_arrival:\x00\U0001f6ec\x00" +
+	":flight_departure:\x00\U0001f6eb\x00" +
+	":flipper:\x00\U0001f42c\x00" +
+	":floppy_disk:\x00\U0001f4be\x00" +
+	":flower_playing_cards:\x00\U0001f3b4\x00" +
+	":flushed:\x00\U0001f633\x00" +
+	":flushed_face:\x00\U0001f633\x00" +
+	":fly:\x00\U0001fab0\x00" +
+	":flying_disc:\x00\U0001f94f\x00" +
+	":flying_saucer:\x00\U0001f6f8\x00" +
+	":fog:\x00\U0001f32b\ufe0f\x00" +
+	":foggy:\x00\U0001f301\x00" +
+	":folded_hands:\x00\U0001f64f\x00" +
+	":fondue:\x00\U0001fad5\x00" +
+	":foot:\x00\U0001f9b6\x00" +
+	":football:\x00\U0001f3c8\x00" +
+	":footprints:\x00\U0001f463\x00" +
+	":fork_and_knife:\x00\U0001f374\x00" +
+	":fork_and_knife_with_plate:\x00\U0001f37d\ufe0f\x00" +
+	":fortune_cookie:\x00\U0001f960\x00" +
+	":fountain:\x00\u26f2\x00" +
+	":fountain_pen:\x00\U0001f58b\ufe0f\x00" +
+	":four:\x004\ufe0f\u20e3\x00" +
+	":four_leaf_clover:\x00\U0001f340\x00" +
+	":four_o_clock:\x00\U0001f553\x00" +
+	":four_thirty:\x00\U0001f55f\x00" +
+	":fox:\x00\U0001f98a\x00" +
+	":fox_face:\x00\U0001f98a\x00" +
+	":framed_picture:\x00\U0001f5bc\ufe0f\x00" +
+	":free:\x00\U0001f193\x00" +
+	":free_button:\x00\U0001f193\x00" +
+	":french_fries:\x00\U0001f35f\x00" +
+	":fried_egg:\x00\U0001f373\x00" +
+	":fried_shrimp:\x00\U0001f364\x00" +
+	":fries:\x00\U0001f35f\x00" +
+	":frog:\x00\U0001f438\x00" +
+	":front_facing_baby_chick:\x00\U0001f425\x00" +
+	":frowning:\x00\U0001f626\x00" +
+	":frowning_face:\x00\u2639\ufe0f\x00" +
+	":frowning_face_with_open_mouth:\x00\U0001f626\x00" +
+	":frowning_man:\x00\U0001f64d\u200d\u2642\ufe0f\x00" +
+	":frowning_person:\x00\U0001f64d\x00" +
+	":frowning_woman:\x00\U0001f64d\u200d\u2640\ufe0f\x00" +
+	":fu:\x00\U0001f595\x00" +
+	":fuel_pump:\x00\u26fd\x00" +
+	":fuelpump:\x00\u26fd\x00" +
+	":full_moon:\x00\U0001f315\x00" +
+	":full_moon_face:\x00\U0001f31d\x00" +
+	":full_moon_with_face:\x00\U0001f31d\x00" +
+	":funeral_urn:\x00\u26b1\ufe0f\x00" +
+	":game_die:\x00\U0001f3b2\x00" +
+	":garlic:\x00\U0001f9c4\x00" +
+	":gear:\x00\u2699\ufe0f\x00" +
+	":gem:\x00\U0001f48e\x00" +
+	":gem_stone:\x00\U0001f48e\x00" +
+	":gemini:\x00\u264a\x00" +
+	":genie:\x00\U0001f9de\x00" +
+	":genie_man:\x00\U0001f9de\u200d\u2642\ufe0f\x00" +
+	":genie_woman:\x00\U0001f9de\u200d\u2640\ufe0f\x00" +
+	":ghost:\x00\U0001f47b\x00" +
+	":gift:\x00\U0001f381\x00" +
+	":gift_heart:\x00\U0001f49d\x00" +
+	":giraffe:\x00\U0001f992\x00" +
+	":girl:\x00\U0001f467\x00" +
+	":glass_of_milk:\x00\U0001f95b\x00" +
+	":glasses:\x00\U0001f453\x00" +
+	":globe_showing_americas:\x00\U0001f30e\x00" +
+	":globe_showing_asia_australia:\x00\U0001f30f\x00" +
+	":globe_showing_europe_africa:\x00\U0001f30d\x00" +
+	":globe_with_meridians:\x00\U0001f310\x00" +
+	":gloves:\x00\U0001f9e4\x00" +
+	":glowing_star:\x00\U0001f31f\x00" +
+	":goal_net:\x00\U0001f945\x00" +
+	":goat:\x00\U0001f410\x00" +
+	":goblin:\x00\U0001f47a\x00" +
+	":goggles:\x00\U0001f97d\x00" +
+	":golf:\x00\u26f3\x00" +
+	":golfing:\x00\U0001f3cc\ufe0f\x00" +
+	":golfing_man:\x00\U0001f3cc\ufe0f\u200d\u2642\ufe0f\x00" +
+	":golfing_woman:\x00\U0001f3cc\ufe0f\u200d\u2640\ufe0f\x00" +
+	":gorilla:\x00\U0001f98d\x00" +
+	":graduation_cap:\x00\U0001f393\x00" +
+	":grapes:\x00\U0001f347\x00" +
+	":green_apple:\x00\U0001f34f\x00" +
+	":green_book:\x00\U0001f4d7\x00" +
+	":green_circle:\x00\U0001f7e2\x00" +
+	":green_heart:\x00\U0001f49a\x00" +
+	":green_salad:\x00\U0001f957\x00" +
+	":green_square:\x00\U0001f7e9\x00" +
+	":grey_exclamation:\x00\u2755\x00" +
+	":grey_question:\x00\u2754\x00" +
+	":grimacing:\x00\U0001f62c\x00" +
+	":grimacing_face:\x00\U0001f62c\x00" +
+	":grin:\x00\U0001f601\x00" +
+	":grinning:\x00\U0001f600\x00" +
+	":grinning_cat:\x00\U0001f63a\x00" +
+	":grinning_cat_with_smiling_eyes:\x00\U0001f638\x00" +
+	":grinning_face:\x00\U0001f600\x00" +
+	":grinning_face_with_big_eyes:\x00\U0001f603\x00" +
+	":grinning_face_with_smiling_eyes:\x00\U0001f604\x00" +
+	":grinning_face_with_sweat:\x00\U0001f605\x00" +
+	":grinning_squinting_face:\x00\U0001f606\x00" +
+	":growing_heart:\x00\U0001f497\x00" +
+	":guard:\x00\U0001f482\x00" +
+	":guardsman:\x00\U0001f482\u200d\u2642\ufe0f\x00" +
+	":guardswoman:\x00\U0001f482\u200d\u2640\ufe0f\x00" +
+	":guide_dog:\x00\U0001f9ae\x00" +
+	":guitar:\x00\U0001f3b8\x00" +
+	":gun:\x00\U0001f52b\x00" +
+	":haircut:\x00\U0001f487\x00" +
+	":haircut_man:\x00\U0001f487\u200d\u2642\ufe0f\x00" +
+	":haircut_woman:\x00\U0001f487\u200d\u2640\ufe0f\x00" +
+	":hamburger:\x00\U0001f354\x00" +
+	":hammer:\x00\U0001f528\x00" +
+	":hammer_and_pick:\x00\u2692\ufe0f\x00" +
+	":hammer_and_wrench:\x00\U0001f6e0\ufe0f\x00" +
+	":hamsa:\x00\U0001faac\x00" +
+	":hamster:\x00\U0001f439\x00" +
+	":hand:\x00\u270b\x00" +
+	":hand_over_mouth:\x00\U0001f92d\x00" +
+	":hand_with_fingers_splayed:\x00\U0001f590\ufe0f\x00" +
+	":hand_with_index_finger_and_thumb_crossed:\x00\U0001faf0\x00" +
+	":handbag:\x00\U0001f45c\x00" +
+	":handball_person:\x00\U0001f93e\x00" +
+	":handshake:\x00\U0001f91d\x00" +
+	":hankey:\x00\U0001f4a9\x00" +
+	":hash:\x00#\ufe0f\u20e3\x00" +
+	":hatched_chick:\x00\U0001f425\x00" +
+	":hatching_chick:\x00\U0001f423\x00" +
+	":headphone:\x00\U0001f3a7\x00" +
+	":headphones:\x00\U0001f3a7\x00" +
+	":headstone:\x00\U0001faa6\x00" +
+	":health_worker:\x00\U0001f9d1\u200d\u2695\ufe0f\x00" +
+	":hear_no_evil:\x00\U0001f649\x00" +
+	":hear_no_evil_monkey:\x00\U0001f649\x00" +
+	":heart:\x00\u2764\ufe0f\x00" +
+	":heart_decoration:\x00\U0001f49f\x00" +
+	":heart_exclamation:\x00\u2763\ufe0f\x00" +
+	":heart_eyes:\x00\U0001f60d\x00" +
+	":heart_eyes_cat:\x00\U0001f63b\x00" +
+	":heart_hands:\x00\U0001faf6\x00" +
+	":heart_on_fire:\x00\u2764\ufe0f\u200d\U0001f525\x00" +
+	":heart_suit:\x00\u2665\ufe0f\x00" +
+	":heart_with_arrow:\x00\U0001f498\x00" +
+	":heart_with_ribbon:\x00\U0001f49d\x00" +
+	":heartbeat:\x00\U0001f493\x00" +
+	":heartpulse:\x00\U0001f497\x00" +
+	":hearts:\x00\u2665\ufe0f\x00" +
+	":heavy_check_mark:\x00\u2714\ufe0f\x00" +
+	":heavy_division_sign:\x00\u2797\x00" +
+	":heavy_dollar_sign:\x00\U0001f4b2\x00" +
+	":heavy_equals_sign:\x00\U0001f7f0\x00" +
+	":heavy_exclamation_mark:\x00\u2757\x00" +
+	":heavy_heart_exclamation:\x00\u2763\ufe0f\x00" +
+	":heavy_minus_sign:\x00\u2796\x00" +
+	":heavy_multiplication_x:\x00\u2716\ufe0f\x00" +
+	":heavy_plus_sign:\x00\u2795\x00" +
+	":hedgehog:\x00\U0001f994\x00" +
+	":helicopter:\x00\U0001f681\x00" +
+	":herb:\x00\U0001f33f\x00" +
+	":hibiscus:\x00\U0001f33a\x00" +
+	":high_brightness:\x00\U0001f506\x00" +
+	":high_heel:\x00\U0001f460\x00" +
+	":high_heeled_shoe:\x00\U0001f460\x00" +
+	":high_speed_train:\x00\U0001f684\x00" +
+	":high_voltage:\x00\u26a1\x00" +
+	":hiking_boot:\x00\U0001f97e\x00" +
+	":hindu_temple:\x00\U0001f6d5\x00" +
+	":hippopotamus:\x00\U0001f99b\x00" +
+	":hocho:\x00\U0001f52a\x00" +
+	":hole:\x00\U0001f573\ufe0f\x00" +
+	":hollow_red_circle:\x00\u2b55\x00" +
+	":honey_pot:\x00\U0001f36f\x00" +
+	":honeybee:\x00\U0001f41d\x00" +
+	":hook:\x00\U0001fa9d\x00" +
+	":horizontal_traffic_light:\x00\U0001f6a5\x00" +
+	":horse:\x00\U0001f434\x00" +
+	":horse_face:\x00\U0001f434\x00" +
+	":horse_racing:\x00\U0001f3c7\x00" +
+	":hospital:\x00\U0001f3e5\x00" +
+	":hot_beverage:\x00\u2615\x00" +
+	":hot_dog:\x00\U0001f32d\x00" +
+	":hot_face:\x00\U0001f975\x00" +
+	":hot_pepper:\x00\U0001f336\ufe0f\x00" +
+	":hot_springs:\x00\u2668\ufe0f\x00" +
+	":hotdog:\x00\U0001f32d\x00" +
+	":hotel:\x00\U0001f3e8\x00" +
+	":hotsprings:\x00\u2668\ufe0f\x00" +
+	":hourglass:\x00\u231b\x00" +
+	":hourglass_done:\x00\u231b\x00" +
+	":hourglass_flowing_sand:\x00\u23f3\x00" +
+	":hourglass_not_done:\x00\u23f3\x00" +
+	":house:\x00\U0001f3e0\x00" +
+	":house_with_garden:\x00\U0001f3e1\x00" +
+	":houses:\x00\U0001f3d8\ufe0f\x00" +
+	":hugs:\x00\U0001f917\x00" +
+	":hundred_points:\x00\U0001f4af\x00" +
+	":hushed:\x00\U0001f62f\x00" +
+	":hushed_face:\x00\U0001f62f\x00" +
+	":hut:\x00\U0001f6d6\x00" +
+	":ice:\x00\U0001f9ca\x00" +
+	":ice_cream:\x00\U0001f368\x00" +
+	":ice_cube:\x00\U0001f9ca\x00" +
+	":ice_hockey:\x00\U0001f3d2\x00" +
+	":ice_skate:\x00\u26f8\ufe0f\x00" +
+	":icecream:\x00\U0001f366\x00" +
+	":id:\x00\U0001f194\x00" +
+	":id_button:\x00\U0001f194\x00" +
+	":identification_card:\x00\U0001faaa\x00" +
+	":ideograph_advantage:\x00\U0001f250\x00" +
+	":imp:\x00\U0001f47f\x00" +
+	":inbox_tray:\x00\U0001f4e5\x00" +
+	":incoming_envelope:\x00\U0001f4e8\x00" +
+	":index_pointing_at_the_viewer:\x00\U0001faf5\x00" +
+	":index_pointing_up:\x00\u261d\ufe0f\x00" +
+	":infinity:\x00\u267e\ufe0f\x00" +
+	":information:\x00\u2139\ufe0f\x00" +
+	":information_desk_person:\x00\U0001f481\x00" +
+	":information_source:\x00\u2139\ufe0f\x00" +
+	":innocent:\x00\U0001f607\x00" +
+	":input_latin_letters:\x00\U0001f524\x00" +
+	":input_latin_lowercase:\x00\U0001f521\x00" +
+	":input_latin_uppercase:\x00\U0001f520\x00" +
+	":input_numbers:\x00\U0001f522\x00" +
+	":input_symbols:\x00\U0001f523\x00" +
+	":interrobang:\x00\u2049\ufe0f\x00" +
+	":iphone:\x00\U0001f4f1\x00" +
+	":izakaya_lantern:\x00\U0001f3ee\x00" +
+	":jack_o_lantern:\x00\U0001f383\x00" +
+	":japan:\x00\U0001f5fe\x00" +
+	":japanese_acceptable_button:\x00\U0001f251\x00" +
+	":japanese_application_button:\x00\U0001f238\x00" +
+	":japanese_bargain_button:\x00\U0001f250\x00" +
+	":japanese_castle:\x00\U0001f3ef\x00" +
+	":japanese_congratulations_button:\x00\u3297\ufe0f\x00" +
+	":japanese_discount_button:\x00\U0001f239\x00" +
+	":japanese_dolls:\x00\U0001f38e\x00" +
+	":japanese_free_of_charge_button:\x00\U0001f21a\x00" +
+	":japanese_goblin:\x00\U0001f47a\x00" +
+	":japanese_here_button:\x00\U0001f201\x00" +
+	":japanese_monthly_amount_button:\x00\U0001f237\ufe0f\x00" +
+	":japanese_no_vacancy_button:\x00\U0001f235\x00" +
+	":japanese_not_free_of_charge_button:\x00\U0001f236\x00" +
+	":japanese_ogre:\x00\U0001f479\x00" +
+	":japanese_open_for_business_button:\x00\U0001f23a\x00" +
+	":japanese_passing_grade_button:\x00\U0001f234\x00" +
+	":japanese_post_office:\x00\U0001f3e3\x00" +
+	":japanese_prohibited_button:\x00\U0001f232\x00" +
+	":japanese_reserved_button:\x00\U0001f22f\x00" +
+	":japanese_secret_button:\x00\u3299\ufe0f\x00" +
+	":japanese_service_charge_button:\x00\U0001f202\ufe0f\x00" +
+	":japanese_symbol_for_beginner:\x00\U0001f530\x00" +
+	":japanese_vacancy_button:\x00\U0001f233\x00" +
+	":jar:\x00\U0001fad9\x00" +
+	":jeans:\x00\U0001f456\x00" +
+	":jigsaw:\x00\U0001f9e9\x00" +
+	":joker:\x00\U0001f0cf\x00" +
+	":joy:\x00\U0001f602\x00" +
+	":joy_cat:\x00\U0001f639\x00" +
+	":joystick:\x00\U0001f579\ufe0f\x00" +
+	":judge:\x00\U0001f9d1\u200d\u2696\ufe0f\x00" +
+	":juggling_person:\x00\U0001f939\x00" +
+	":kaaba:\x00\U0001f54b\x00" +
+	":kangaroo:\x00\U0001f998\x00" +
+	":key:\x00\U0001f511\x00" +
+	":keyboard:\x00\u2328\ufe0f\x00" +
+	":keycap_0:\x000\ufe0f\u20e3\x00" +
+	":keycap_10:\x00\U0001f51f\x00" +
+	":keycap_1:\x001\ufe0f\u20e3\x00" +
+	":keycap_2:\x002\ufe0f\u20e3\x00" +
+	":keycap_3:\x003\ufe0f\u20e3\x00" +
+	":keycap_4:\x004\ufe0f\u20e3\x00" +
+	":keycap_5:\x005\ufe0f\u20e3\x00" +
+	":keycap_6:\x006\ufe0f\u20e3\x00" +
+	":keycap_7:\x007\ufe0f\u20e3\x00" +
+	":keycap_8:\x008\ufe0f\u20e3\x00" +
+	":keycap_9:\x009\ufe0f\u20e3\x00" +
+	":keycap_asterisk:\x00*\ufe0f\u20e3\x00" +
+	":keycap_hash:\x00#\ufe0f\u20e3\x00" +
+	":keycap_ten:\x00\U0001f51f\x00" +
+	":kick_scooter:\x00\U0001f6f4\x00" +
+	":kimono:\x00\U0001f458\x00" +
+	":kiss:\x00\U0001f48b\x00" +
+	":kiss_man_man:\x00\U0001f468\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468\x00" +
+	":kiss_mark:\x00\U0001f48b\x00" +
+	":kiss_person_person:\x00\U0001f9d1\U0001f3fb\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f9d1\U0001f3fc\x00" +
+	":kiss_woman_man:\x00\U0001f469\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468\x00" +
+	":kiss_woman_woman:\x00\U0001f469\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f469\x00" +
+	":kissing:\x00\U0001f617\x00" +
+	":kissing_cat:\x00\U0001f63d\x00" +
+	":kissing_closed_eyes:\x00\U0001f61a\x00" +
+	":kissing_face:\x00\U0001f617\x00" +
+	":kissing_face_with_closed_eyes:\x00\U0001f61a\x00" +
+	":kissing_face_with_smiling_eyes:\x00\U0001f619\x00" +
+	":kissing_heart:\x00\U0001f618\x00" +
+	":kissing_smiling_eyes:\x00\U0001f619\x00" +
+	":kitchen_knife:\x00\U0001f52a\x00" +
+	":kite:\x00\U0001fa81\x00" +
+	":kiwi_fruit:\x00\U0001f95d\x00" +
+	":kneeling_man:\x00\U0001f9ce\u200d\u2642\ufe0f\x00" +
+	":kneeling_person:\x00\U0001f9ce\x00" +
+	":kneeling_woman:\x00\U0001f9ce\u200d\u2640\ufe0f\x00" +
+	":knife:\x00\U0001f52a\x00" +
+	":knot:\x00\U0001faa2\x00" +
+	":koala:\x00\U0001f428\x00" +
+	":koko:\x00\U0001f201\x00" +
+	":lab_coat:\x00\U0001f97c\x00" +
+	":label:\x00\U0001f3f7\ufe0f\x00" +
+	":lacrosse:\x00\U0001f94d\x00" +
+	":ladder:\x00\U0001fa9c\x00" +
+	":lady_beetle:\x00\U0001f41e\x00" +
+	":lantern:\x00\U0001f3ee\x00" +
+	":laptop:\x00\U0001f4bb\x00" +
+	":large_blue_circle:\x00\U0001f535\x00" +
+	":large_blue_diamond:\x00\U0001f537\x00" +
+	":large_orange_diamond:\x00\U0001f536\x00" +
+	":last_quarter_moon:\x00\U0001f317\x00" +
+	":last_quarter_moon_face:\x00\U0001f31c\x00" +
+	":last_quarter_moon_with_face:\x00\U0001f31c\x00" +
+	":last_track_button:\x00\u23ee\ufe0f\x00" +
+	":latin_cross:\x00\u271d\ufe0f\x00" +
+	":laughing:\x00\U0001f606\x00" +
+	":leaf_fluttering_in_wind:\x00\U0001f343\x00" +
+	":leafy_green:\x00\U0001f96c\x00" +
+	":leaves:\x00\U0001f343\x00" +
+	":ledger:\x00\U0001f4d2\x00" +
+	":left_arrow:\x00\u2b05\ufe0f\x00" +
+	":left_arrow_curving_right:\x00\u21aa\ufe0f\x00" +
+	":left_facing_fist:\x00\U0001f91b\x00" +
+	":left_luggage:\x00\U0001f6c5\x00" +
+	":left_right_arrow:\x00\u2194\ufe0f\x00" +
+	":left_speech_bubble:\x00\U0001f5e8\ufe0f\x00" +
+	":leftwards_arrow_with_hook:\x00\u21a9\ufe0f\x00" +
+	":leftwards_hand:\x00\U0001faf2\x00" +
+	":leg:\x00\U0001f9b5\x00" +
+	":lemon:\x00\U0001f34b\x00" +
+	":leo:\x00\u264c\x00" +
+	":leopard:\x00\U0001f406\x00" +
+	":level_slider:\x00\U0001f39a\ufe0f\x00" +
+	":libra:\x00\u264e\x00" +
+	":light_bulb:\x00\U0001f4a1\x00" +
+	":light_rail:\x00\U0001f688\x00" +
+	":light_skin_tone:\x00\U0001f3fb\x00" +
+	":link:\x00\U0001f517\x00" +
+	":linked_paperclips:\x00\U0001f587\ufe0f\x00" +
+	":lion:\x00\U0001f981\x00" +
+	":lips:\x00\U0001f444\x00" +
+	":lipstick:\x00\U0001f484\x00" +
+	":litter_in_bin_sign:\x00\U0001f6ae\x00" +
+	":lizard:\x00\U0001f98e\x00" +
+	":llama:\x00\U0001f999\x00" +
+	":lobster:\x00\U0001f99e\x00" +
+	":lock:\x00\U0001f512\x00" +
+	":lock_with_ink_pen:\x00\U0001f50f\x00" +
+	":locked:\x00\U0001f512\x00" +
+	":locked_with_key:\x00\U0001f510\x00" +
+	":locked_with_pen:\x00\U0001f50f\x00" +
+	":locomotive:\x00\U0001f682\x00" +
+	":lollipop:\x00\U0001f36d\x00" +
+	":long_drum:\x00\U0001fa98\x00" +
+	":loop:\x00\u27bf\x00" +
+	":lotion_bottle:\x00\U0001f9f4\x00" +
+	":lotus:\x00\U0001fab7\x00" +
+	":lotus_position:\x00\U0001f9d8\x00" +
+	":lotus_position_man:\x00\U0001f9d8\u200d\u2642\ufe0f\x00" +
+	":lotus_position_woman:\x00\U0001f9d8\u200d\u2640\ufe0f\x00" +
+	":loud_sound:\x00\U0001f50a\x00" +
+	":loudly_crying_face:\x00\U0001f62d\x00" +
+	":loudspeaker:\x00\U0001f4e2\x00" +
+	":love_hotel:\x00\U0001f3e9\x00" +
+	":love_letter:\x00\U0001f48c\x00" +
+	":love_you_gesture:\x00\U0001f91f\x00" +
+	":low_battery:\x00\U0001faab\x00" +
+	":low_brightness:\x00\U0001f505\x00" +
+	":luggage:\x00\U0001f9f3\x00" +
+	":lungs:\x00\U0001fac1\x00" +
+	":lying_face:\x00\U0001f925\x00" +
+	":m:\x00\u24c2\ufe0f\x00" +
+	":mag:\x00\U0001f50d\x00" +
+	":mag_right:\x00\U0001f50e\x00" +
+	":mage:\x00\U0001f9d9\x00" +
+	":mage_man:\x00\U0001f9d9\u200d\u2642\ufe0f\x00" +
+	":mage_woman:\x00\U0001f9d9\u200d\u2640\ufe0f\x00" +
+	":magic_wand:\x00\U0001fa84\x00" +
+	":magnet:\x00\U0001f9f2\x00" +
+	":magnifying_glass_tilted_left:\x00\U0001f50d\x00" +
+	":magnifying_glass_tilted_right:\x00\U0001f50e\x00" +
+	":mahjong:\x00\U0001f004\x00" +
+	":mahjong_red_dragon:\x00\U0001f004\x00" +
+	":mailbox:\x00\U0001f4eb\x00" +
+	":mailbox_closed:\x00\U0001f4ea\x00" +
+	":mailbox_with_mail:\x00\U0001f4ec\x00" +
+	":mailbox_with_no_mail:\x00\U0001f4ed\x00" +
+	":male_detective:\x00\U0001f575\ufe0f\u200d\u2642\ufe0f\x00" +
+	":male_sign:\x00\u2642\ufe0f\x00" +
+	":mammoth:\x00\U0001f9a3\x00" +
+	":man:\x00\U0001f468\x00" +
+	":man_artist:\x00\U0001f468\u200d\U0001f3a8\x00" +
+	":man_astronaut:\x00\U0001f468\u200d\U0001f680\x00" +
+	":man_bald:\x00\U0001f468\u200d\U0001f9b2\x00" +
+	":man_beard:\x00\U0001f9d4\u200d\u2642\ufe0f\x00" +
+	":man_biking:\x00\U0001f6b4\u200d\u2642\ufe0f\x00" +
+	":man_bouncing_ball:\x00\u26f9\ufe0f\u200d\u2642\ufe0f\x00" +
+	":man_bowing:\x00\U0001f647\u200d\u2642\ufe0f\x00" +
+	":man_cartwheeling:\x00\U0001f938\u200d\u2642\ufe0f\x00" +
+	":man_climbing:\x00\U0001f9d7\u200d\u2642\ufe0f\x00" +
+	":man_construction_worker:\x00\U0001f477\u200d\u2642\ufe0f\x00" +
+	":man_cook:\x00\U0001f468\u200d\U0001f373\x00" +
+	":man_dancing:\x00\U0001f57a\x00" +
+	":man_detective:\x00\U0001f575\ufe0f\u200d\u2642\ufe0f\x00" +
+	":man_elf:\x00\U0001f9dd\u200d\u2642\ufe0f\x00" +
+	":man_facepalming:\x00\U0001f926\u200d\u2642\ufe0f\x00" +
+	":man_factory_worker:\x00\U0001f468\u200d\U0001f3ed\x00" +
+	":man_fairy:\x00\U0001f9da\u200d\u2642\ufe0f\x00" +
+	":man_farmer:\x00\U0001f468\u200d\U0001f33e\x00" +
+	":man_feeding_baby:\x00\U0001f468\u200d\U0001f37c\x00" +
+	":man_firefighter:\x00\U0001f468\u200d\U0001f692\x00" +
+	":man_frowning:\x00\U0001f64d\u200d\u2642\ufe0f\x00" +
+	":man_genie:\x00\U0001f9de\u200d\u2642\ufe0f\x00" +
+	":man_gesturing_no:\x00\U0001f645\u200d\u2642\ufe0f\x00" +
+	":man_gesturing_ok:\x00\U0001f646\u200d\u2642\ufe0f\x00" +
+	":man_getting_haircut:\x00\U0001f487\u200d\u2642\ufe0f\x00" +
+	":man_getting_massage:\x00\U0001f486\u200d\u2642\ufe0f\x00" +
+	":man_golfing:\x00\U0001f3cc\ufe0f\u200d\u2642\ufe0f\x00" +
+	":man_guard:\x00\U0001f482\u200d\u2642\ufe0f\x00" +
+	":man_health_worker:\x00\U0001f468\u200d\u2695\ufe0f\x00" +
+	":man_in_lotus_position:\x00\U0001f9d8\u200d\u2642\ufe0f\x00" +
+	":man_in_manual_wheelchair:\x00\U0001f468\u200d\U0001f9bd\x00" +
+	":man_in_motorized_wheelchair:\x00\U0001f468\u200d\U0001f9bc\x00" +
+	":man_in_steamy_room:\x00\U0001f9d6\u200d\u2642\ufe0f\x00" +
+	":man_in_tuxedo:\x00\U0001f935\u200d\u2642\ufe0f\x00" +
+	":man_judge:\x00\U0001f468\u200d\u2696\ufe0f\x00" +
+	":man_juggling:\x00\U0001f939\u200d\u2642\ufe0f\x00" +
+	":man_kneeling:\x00\U0001f9ce\u200d\u2642\ufe0f\x00" +
+	":man_lifting_weights:\x00\U0001f3cb\ufe0f\u200d\u2642\ufe0f\x00" +
+	":man_mage:\x00\U0001f9d9\u200d\u2642\ufe0f\x00" +
+	":man_mechanic:\x00\U0001f468\u200d\U0001f527\x00" +
+	":man_mountain_biking:\x00\U0001f6b5\u200d\u2642\ufe0f\x00" +
+	":man_office_worker:\x00\U0001f468\u200d\U0001f4bc\x00" +
+	":man_pilot:\x00\U0001f468\u200d\u2708\ufe0f\x00" +
+	":man_playing_handball:\x00\U0001f93e\u200d\u2642\ufe0f\x00" +
+	":man_playing_water_polo:\x00\U0001f93d\u200d\u2642\ufe0f\x00" +
+	":man_police_officer:\x00\U0001f46e\u200d\u2642\ufe0f\x00" +
+	":man_pouting:\x00\U0001f64e\u200d\u2642\ufe0f\x00" +
+	":man_raising_hand:\x00\U0001f64b\u200d\u2642\ufe0f\x00" +
+	":man_rowing_boat:\x00\U0001f6a3\u200d\u2642\ufe0f\x00" +
+	":man_running:\x00\U0001f3c3\u200d\u2642\ufe0f\x00" +
+	":man_s_shoe:\x00\U0001f45e\x00" +
+	":man_scientist:\x00\U0001f468\u200d\U0001f52c\x00" +
+	":man_shrugging:\x00\U0001f937\u200d\u2642\ufe0f\x00" +
+	":man_singer:\x00\U0001f468\u200d\U0001f3a4\x00" +
+	":man_standing:\x00\U0001f9cd\u200d\u2642\ufe0f\x00" +
+	":man_student:\x00\U0001f468\u200d\U0001f393\x00" +
+	":man_superhero:\x00\U0001f9b8\u200d\u2642\ufe0f\x00" +
+	":man_supervillain:\x00\U0001f9b9\u200d\u2642\ufe0f\x00" +
+	":man_surfing:\x00\U0001f3c4\u200d\u2642\ufe0f\x00" +
+	":man_swimming:\x00\U0001f3ca\u200d\u2642\ufe0f\x00" +
+	":man_teacher:\x00\U0001f468\u200d\U0001f3eb\x00" +
+	":man_technologist:\x00\U0001f468\u200d\U0001f4bb\x00" +
+	":man_tipping_hand:\x00\U0001f481\u200d\u2642\ufe0f\x00" +
+	":man_vampire:\x00\U0001f9db\u200d\u2642\ufe0f\x00" +
+	":man_walking:\x00\U0001f6b6\u200d\u2642\ufe0f\x00" +
+	":man_wearing_turban:\x00\U0001f473\u200d\u2642\ufe0f\x00" +
+	":man_with_beard:\x00\U0001f9d4\u200d\u2642\ufe0f\x00" +
+	":man_with_blond_hair:\x00\U0001f471\u200d\u2642\ufe0f\x00" +
+	":man_with_curly_hair:\x00\U0001f468\u200d\U0001f9b1\x00" +
+	":man_with_gua_pi_mao:\x00\U0001f472\x00" +
+	":man_with_probing_cane:\x00\U0001f468\u200d\U0001f9af\x00" +
+	":man_with_red_hair:\x00\U0001f468\u200d\U0001f9b0\x00" +
+	":man_with_turban:\x00\U0001f473\u200d\u2642\ufe0f\x00" +
+	":man_with_veil:\x00\U0001f470\u200d\u2642\ufe0f\x00" +
+	":man_with_white_cane:\x00\U0001f468\u200d\U0001f9af\x00" +
+	":man_with_white_hair:\x00\U0001f468\u200d\U0001f9b3\x00" +
+	":man_zombie:\x00\U0001f9df\u200d\u2642\ufe0f\x00" +
+	":mandarin:\x00\U0001f34a\x00" +
+	":mango:\x00\U0001f96d\x00" +
+	":mans_shoe:\x00\U0001f45e\x00" +
+	":mantelpiece_clock:\x00\U0001f570\ufe0f\x00" +
+	":manual_wheelchair:\x00\U0001f9bd\x00" +
+	":map_of_japan:\x00\U0001f5fe\x00" +
+	":maple_leaf:\x00\U0001f341\x00" +
+	":martial_arts_uniform:\x00\U0001f94b\x00" +
+	":mask:\x00\U0001f637\x00" +
+	":massage:\x00\U0001f486\x00" +
+	":massage_man:\x00\U0001f486\u200d\u2642\ufe0f\x00" +
+	":massage_woman:\x00\U0001f486\u200d\u2640\ufe0f\x00" +
+	":mate:\x00\U0001f9c9\x00" +
+	":meat_on_bone:\x00\U0001f356\x00" +
+	":mechanic:\x00\U0001f9d1\u200d\U0001f527\x00" +
+	":mechanical_arm:\x00\U0001f9be\x00" +
+	":mechanical_leg:\x00\U0001f9bf\x00" +
+	":medal_military:\x00\U0001f396\ufe0f\x00" +
+	":medal_sports:\x00\U0001f3c5\x00" +
+	":medical_symbol:\x00\u2695\ufe0f\x00" +
+	":medium_dark_skin_tone:\x00\U0001f3fe\x00" +
+	":medium_light_skin_tone:\x00\U0001f3fc\x00" +
+	":medium_skin_tone:\x00\U0001f3fd\x00" +
+	":mega:\x00\U0001f4e3\x00" +
+	":megaphone:\x00\U0001f4e3\x00" +
+	":melon:\x00\U0001f348\x00" +
+	":melting_face:\x00\U0001fae0\x00" +
+	":memo:\x00\U0001f4dd\x00" +
+	":men_holding_hands:\x00\U0001f46c\x00" +
+	":men_s_room:\x00\U0001f6b9\x00" +
+	":men_with_bunny_ears:\x00\U0001f46f\u200d\u2642\ufe0f\x00" +
+	":men_wrestling:\x00\U0001f93c\u200d\u2642\ufe0f\x00" +
+	":mending_heart:\x00\u2764\ufe0f\u200d\U0001fa79\x00" +
+	":menorah:\x00\U0001f54e\x00" +
+	":mens:\x00\U0001f6b9\x00" +
+	":mermaid:\x00\U0001f9dc\u200d\u2640\ufe0f\x00" +
+	":merman:\x00\U0001f9dc\u200d\u2642\ufe0f\x00" +
+	":merperson:\x00\U0001f9dc\x00" +
+	":metal:\x00\U0001f918\x00" +
+	":metro:\x00\U0001f687\x00" +
+	":microbe:\x00\U0001f9a0\x00" +
+	":microphone:\x00\U0001f3a4\x00" +
+	":microscope:\x00\U0001f52c\x00" +
+	":middle_finger:\x00\U0001f595\x00" +
+	":military_helmet:\x00\U0001fa96\x00" +
+	":military_medal:\x00\U0001f396\ufe0f\x00" +
+	":milk_glass:\x00\U0001f95b\x00" +
+	":milky_way:\x00\U0001f30c\x00" +
+	":minibus:\x00\U0001f690\x00" +
+	":minidisc:\x00\U0001f4bd\x00" +
+	":minus:\x00\u2796\x00" +
+	":mirror:\x00\U0001fa9e\x00" +
+	":mirror_ball:\x00\U0001faa9\x00" +
+	":moai:\x00\U0001f5ff\x00" +
+	":mobile_phone:\x00\U0001f4f1\x00" +
+	":mobile_phone_off:\x00\U0001f4f4\x00" +
+	":mobile_phone_with_arrow:\x00\U0001f4f2\x00" +
+	":money_bag:\x00\U0001f4b0\x00" +
+	":money_mouth_face:\x00\U0001f911\x00" +
+	":money_with_wings:\x00\U0001f4b8\x00" +
+	":moneybag:\x00\U0001f4b0\x00" +
+	":monkey:\x00\U0001f412\x00" +
+	":monkey_face:\x00\U0001f435\x00" +
+	":monocle_face:\x00\U0001f9d0\x00" +
+	":monorail:\x00\U0001f69d\x00" +
+	":moon:\x00\U0001f314\x00" +
+	":moon_cake:\x00\U0001f96e\x00" +
+	":moon_viewing_ceremony:\x00\U0001f391\x00" +
+	":mortar_board:\x00\U0001f393\x00" +
+	":mosque:\x00\U0001f54c\x00" +
+	":mosquito:\x00\U0001f99f\x00" +
+	":motor_boat:\x00\U0001f6e5\ufe0f\x00" +
+	":motor_scooter:\x00\U0001f6f5\x00" +
+	":motorcycle:\x00\U0001f3cd\ufe0f\x00" +
+	":motorized_wheelchair:\x00\U0001f9bc\x00" +
+	":motorway:\x00\U0001f6e3\ufe0f\x00" +
+	":mount_fuji:\x00\U0001f5fb\x00" +
+	":mountain:\x00\u26f0\ufe0f\x00" +
+	":mountain_bicyclist:\x00\U0001f6b5\x00" +
+	":mountain_biking_man:\x00\U0001f6b5\u200d\u2642\ufe0f\x00" +
+	":mountain_biking_woman:\x00\U0001f6b5\u200d\u2640\ufe0f\x00" +
+	":mountain_cableway:\x00\U0001f6a0\x00" +
+	":mountain_railway:\x00\U0001f69e\x00" +
+	":mountain_snow:\x00\U0001f3d4\ufe0f\x00" +
+	":mouse2:\x00\U0001f401\x00" +
+	":mouse:\x00\U0001f42d\x00" +
+	":mouse_face:\x00\U0001f42d\x00" +
+	":mouse_trap:\x00\U0001faa4\x00" +
+	":mouth:\x00\U0001f444\x00" +
+	":movie_camera:\x00\U0001f3a5\x00" +
+	":moyai:\x00\U0001f5ff\x00" +
+	":mrs_claus:\x00\U0001f936\x00" +
+	":multiply:\x00\u2716\ufe0f\x00" +
+	":muscle:\x00\U0001f4aa\x00" +
+	":mushroom:\x00\U0001f344\x00" +
+	":musical_keyboard:\x00\U0001f3b9\x00" +
+	":musical_note:\x00\U0001f3b5\x00" +
+	":musical_notes:\x00\U0001f3b6\x00" +
+	":musical_score:\x00\U0001f3bc\x00" +
+	":mute:\x00\U0001f507\x00" +
+	":muted_speaker:\x00\U0001f507\x00" +
+	":mx_claus:\x00\U0001f9d1\u200d\U0001f384\x00" +
+	":nail_care:\x00\U0001f485\x00" +
+	":nail_polish:\x00\U0001f485\x00" +
+	":name_badge:\x00\U0001f4db\x00" +
+	":national_park:\x00\U0001f3de\ufe0f\x00" +
+	":nauseated_face:\x00\U0001f922\x00" +
+	":nazar_amulet:\x00\U0001f9ff\x00" +
+	":necktie:\x00\U0001f454\x00" +
+	":negative_squared_cross_mark:\x00\u274e\x00" +
+	":nerd_face:\x00\U0001f913\x00" +
+	":nest_with_eggs:\x00\U0001faba\x00" +
+	":nesting_dolls:\x00\U0001fa86\x00" +
+	":neutral_face:\x00\U0001f610\x00" +
+	":new:\x00\U0001f195\x00" +
+	":new_button:\x00\U0001f195\x00" +
+	":new_moon:\x00\U0001f311\x00" +
+	":new_moon_face:\x00\U0001f31a\x00" +
+	":new_moon_with_face:\x00\U0001f31a\x00" +
+	":newspaper:\x00\U0001f4f0\x00" +
+	":newspaper_roll:\x00\U0001f5de\ufe0f\x00" +
+	":next_track_button:\x00\u23ed\ufe0f\x00" +
+	":ng:\x00\U0001f196\x00" +
+	":ng_button:\x00\U0001f196\x00" +
+	":ng_man:\x00\U0001f645\u200d\u2642\ufe0f\x00" +
+	":ng_woman:\x00\U0001f645\u200d\u2640\ufe0f\x00" +
+	":night_with_stars:\x00\U0001f303\x00" +
+	":nine:\x009\ufe0f\u20e3\x00" +
+	":nine_o_clock:\x00\U0001f558\x00" +
+	":nine_thirty:\x00\U0001f564\x00" +
+	":ninja:\x00\U0001f977\x00" +
+	":no_bell:\x00\U0001f515\x00" +
+	":no_bicycles:\x00\U0001f6b3\x00" +
+	":no_entry:\x00\u26d4\x00" +
+	":no_entry_sign:\x00\U0001f6ab\x00" +
+	":no_good:\x00\U0001f645\x00" +
+	":no_good_man:\x00\U0001f645\u200d\u2642\ufe0f\x00" +
+	":no_good_woman:\x00\U0001f645\u200d\u2640\ufe0f\x00" +
+	":no_littering:\x00\U0001f6af\x00" +
+	":no_mobile_phones:\x00\U0001f4f5\x00" +
+	":no_mouth:\x00\U0001f636\x00" +
+	":no_one_under_eighteen:\x00\U0001f51e\x00" +
+	":no_pedestrians:\x00\U0001f6b7\x00" +
+	":no_smoking:\x00\U0001f6ad\x00" +
+	":non-potable_water:\x00\U0001f6b1\x00" +
+	":non_potable_water:\x00\U0001f6b1\x00" +
+	":nose:\x00\U0001f443\x00" +
+	":notebook:\x00\U0001f4d3\x00" +
+	":notebook_with_decorative_cover:\x00\U0001f4d4\x00" +
+	":notes:\x00\U0001f3b6\x00" +
+	":nut_and_bolt:\x00\U0001f529\x00" +
+	":o2:\x00\U0001f17e\ufe0f\x00" +
+	":o:\x00\u2b55\x00" +
+	":o_button_blood_type:\x00\U0001f17e\ufe0f\x00" +
+	":ocean:\x00\U0001f30a\x00" +
+	":octopus:\x00\U0001f419\x00" +
+	":oden:\x00\U0001f362\x00" +
+	":office:\x00\U0001f3e2\x00" +
+	":office_building:\x00\U0001f3e2\x00" +
+	":office_worker:\x00\U0001f9d1\u200d\U0001f4bc\x00" +
+	":ogre:\x00\U0001f479\x00" +
+	":oil_drum:\x00\U0001f6e2\ufe0f\x00" +
+	":ok:\x00\U0001f197\x00" +
+	":ok_button:\x00\U0001f197\x00" +
+	":ok_hand:\x00\U0001f44c\x00" +
+	":ok_man:\x00\U0001f646\u200d\u2642\ufe0f\x00" +
+	":ok_person:\x00\U0001f646\x00" +
+	":ok_woman:\x00\U0001f646\u200d\u2640\ufe0f\x00" +
+	":old_key:\x00\U0001f5dd\ufe0f\x00" +
+	":old_man:\x00\U0001f474\x00" +
+	":old_woman:\x00\U0001f475\x00" +
+	":older_adult:\x00\U0001f9d3\x00" +
+	":older_man:\x00\U0001f474\x00" +
+	":older_person:\x00\U0001f9d3\x00" +
+	":older_woman:\x00\U0001f475\x00" +
+	":olive:\x00\U0001fad2\x00" +
+	":om:\x00\U0001f549\ufe0f\x00" +
+	":on:\x00\U0001f51b\x00" +
+	":on_arrow:\x00\U0001f51b\x00" +
+	":oncoming_automobile:\x00\U0001f698\x00" +
+	":oncoming_bus:\x00\U0001f68d\x00" +
+	":oncoming_fist:\x00\U0001f44a\x00" +
+	":oncoming_police_car:\x00\U0001f694\x00" +
+	":oncoming_taxi:\x00\U0001f696\x00" +
+	":one:\x001\ufe0f\u20e3\x00" +
+	":one_o_clock:\x00\U0001f550\x00" +
+	":one_piece_swimsuit:\x00\U0001fa71\x00" +
+	":one_thirty:\x00\U0001f55c\x00" +
+	":onion:\x00\U0001f9c5\x00" +
+	":open_book:\x00\U0001f4d6\x00" +
+	":open_file_folder:\x00\U0001f4c2\x00" +
+	":open_hands:\x00\U0001f450\x00" +
+	":open_mailbox_with_lowered_flag:\x00\U0001f4ed\x00" +
+	":open_mailbox_with_raised_flag:\x00\U0001f4ec\x00" +
+	":open_mouth:\x00\U0001f62e\x00" +
+	":open_umbrella:\x00\u2602\ufe0f\x00" +
+	":ophiuchus:\x00\u26ce\x00" +
+	":optical_disk:\x00\U0001f4bf\x00" +
+	":orange:\x00\U0001f34a\x00" +
+	":orange_book:\x00\U0001f4d9\x00" +
+	":orange_circle:\x00\U0001f7e0\x00" +
+	":orange_heart:\x00\U0001f9e1\x00" +
+	":orange_square:\x00\U0001f7e7\x00" +
+	":orangutan:\x00\U0001f9a7\x00" +
+	":orthodox_cross:\x00\u2626\ufe0f\x00" +
+	":otter:\x00\U0001f9a6\x00" +
+	":outbox_tray:\x00\U0001f4e4\x00" +
+	":owl:\x00\U0001f989\x00" +
+	":ox:\x00\U0001f402\x00" +
+	":oyster:\x00\U0001f9aa\x00" +
+	":p_button:\x00\U0001f17f\ufe0f\x00" +
+	":package:\x00\U0001f4e6\x00" +
+	":page_facing_up:\x00\U0001f4c4\x00" +
+	":page_with_curl:\x00\U0001f4c3\x00" +
+	":pager:\x00\U0001f4df\x00" +
+	":paintbrush:\x00\U0001f58c\ufe0f\x00" +
+	":palm_down_hand:\x00\U0001faf3\x00" +
+	":palm_tree:\x00\U0001f334\x00" +
+	":palm_up_hand:\x00\U0001faf4\x00" +
+	":palms_up_together:\x00\U0001f932\x00" +
+	":pancakes:\x00\U0001f95e\x00" +
+	":panda:\x00\U0001f43c\x00" +
+	":panda_face:\x00\U0001f43c\x00" +
+	":paperclip:\x00\U0001f4ce\x00" +
+	":paperclips:\x00\U0001f587\ufe0f\x00" +
+	":parachute:\x00\U0001fa82\x00" +
+	":parasol_on_ground:\x00\u26f1\ufe0f\x00" +
+	":parking:\x00\U0001f17f\ufe0f\x00" +
+	":parrot:\x00\U0001f99c\x00" +
+	":part_alternation_mark:\x00\u303d\ufe0f\x00" +
+	":partly_sunny:\x00\u26c5\x00" +
+	":party_popper:\x00\U0001f389\x00" +
+	":partying_face:\x00\U0001f973\x00" +
+	":passenger_ship:\x00\U0001f6f3\ufe0f\x00" +
+	":passport_control:\x00\U0001f6c2\x00" +
+	":pause_button:\x00\u23f8\ufe0f\x00" +
+	":paw_prints:\x00\U0001f43e\x00" +
+	":peace_symbol:\x00\u262e\ufe0f\x00" +
+	":peach:\x00\U0001f351\x00" +
+	":peacock:\x00\U0001f99a\x00" +
+	":peanuts:\x00\U0001f95c\x00" +
+	":pear:\x00\U0001f350\x00" +
+	":pen:\x00\U0001f58a\ufe0f\x00" +
+	":pencil2:\x00\u270f\ufe0f\x00" +
+	":pencil:\x00\U0001f4dd\x00" +
+	":penguin:\x00\U0001f427\x00" +
+	":pensive:\x00\U0001f614\x00" +
+	":pensive_face:\x00\U0001f614\x00" +
+	":people_holding_hands:\x00\U0001f9d1\u200d\U0001f91d\u200d\U0001f9d1\x00" +
+	":people_hugging:\x00\U0001fac2\x00" +
+	":people_with_bunny_ears:\x00\U0001f46f\x00" +
+	":people_wrestling:\x00\U0001f93c\x00" +
+	":performing_arts:\x00\U0001f3ad\x00" +
+	":persevere:\x00\U0001f623\x00" +
+	":persevering_face:\x00\U0001f623\x00" +
+	":person:\x00\U0001f9d1\x00" +
+	":person_bald:\x00\U0001f9d1\u200d\U0001f9b2\x00" +
+	":person_biking:\x00\U0001f6b4\x00" +
+	":person_bouncing_ball:\x00\u26f9\ufe0f\x00" +
+	":person_bowing:\x00\U0001f647\x00" +
+	":person_cartwheeling:\x00\U0001f938\x00" +
+	":person_climbing:\x00\U0001f9d7\x00" +
+	":person_curly_hair:\x00\U0001f9d1\u200d\U0001f9b1\x00" +
+	":person_facepalming:\x00\U0001f926\x00" +
+	":person_feeding_baby:\x00\U0001f9d1\u200d\U0001f37c\x00" +
+	":person_fencing:\x00\U0001f93a\x00" +
+	":person_frowning:\x00\U0001f64d\x00" +
+	":person_gesturing_no:\x00\U0001f645\x00" +
+	":person_gesturing_ok:\x00\U0001f646\x00" +
+	":person_getting_haircut:\x00\U0001f487\x00" +
+	":person_getting_massage:\x00\U0001f486\x00" +
+	":person_golfing:\x00\U0001f3cc\ufe0f\x00" +
+	":person_in_bed:\x00\U0001f6cc\x00" +
+	":person_in_lotus_position:\x00\U0001f9d8\x00" +
+	":person_in_manual_wheelchair:\x00\U0001f9d1\u200d\U0001f9bd\x00" +
+	":person_in_motorized_wheelchair:\x00\U0001f9d1\u200d\U0001f9bc\x00" +
+	":person_in_steamy_room:\x00\U0001f9d6\x00" +
+	":person_in_suit_levitating:\x00\U0001f574\ufe0f\x00" +
+	":person_in_tuxedo:\x00\U0001f935\x00" +
+	":person_juggling:\x00\U0001f939\x00" +
+	":person_kneeling:\x00\U0001f9ce\x00" +
+	":person_lifting_weights:\x00\U0001f3cb\ufe0f\x00" +
+	":person_mountain_biking:\x00\U0001f6b5\x00" +
+	":person_playing_handball:\x00\U0001f93e\x00" +
+	":person_playing_water_polo:\x00\U0001f93d\x00" +
+	":person_pouting:\x00\U0001f64e\x00" +
+	":person_raising_hand:\x00\U0001f64b\x00" +
+	":person_red_hair:\x00\U0001f9d1\u200d\U0001f9b0\x00" +
+	":person_rowing_boat:\x00\U0001f6a3\x00" +
+	":person_running:\x00\U0001f3c3\x00" +
+	":person_shrugging:\x00\U0001f937\x00" +
+	":person_standing:\x00\U0001f9cd\x00" +
+	":person_surfing:\x00\U0001f3c4\x00" +
+	":person_swimming:\x00\U0001f3ca\x00" +
+	":person_taking_bath:\x00\U0001f6c0\x00" +
+	":person_tipping_hand:\x00\U0001f481\x00" +
+	":person_walking:\x00\U0001f6b6\x00" +
+	":person_wearing_turban:\x00\U0001f473\x00" +
+	":person_white_hair:\x00\U0001f9d1\u200d\U0001f9b3\x00" +
+	":person_with_beard:\x00\U0001f9d4\x00" +
+	":person_with_blond_hair:\x00\U0001f471\x00" +
+	":person_with_crown:\x00\U0001fac5\x00" +
+	":person_with_curly_hair:\x00\U0001f9d1\u200d\U0001f9b1\x00" +
+	":person_with_probing_cane:\x00\U0001f9d1\u200d\U0001f9af\x00" +
+	":person_with_red_hair:\x00\U0001f9d1\u200d\U0001f9b0\x00" +
+	":person_with_skullcap:\x00\U0001f472\x00" +
+	":person_with_turban:\x00\U0001f473\x00" +
+	":person_with_veil:\x00\U0001f470\x00" +
+	":person_with_white_cane:\x00\U0001f9d1\u200d\U0001f9af\x00" +
+	":person_with_white_hair:\x00\U0001f9d1\u200d\U0001f9b3\x00" +
+	":petri_dish:\x00\U0001f9eb\x00" +
+	":phone:\x00\u260e\ufe0f\x00" +
+	":pick:\x00\u26cf\ufe0f\x00" +
+	":pickup_truck:\x00\U0001f6fb\x00" +
+	":pie:\x00\U0001f967\x00" +
+	":pig2:\x00\U0001f416\x00" +
+	":pig:\x00\U0001f437\x00" +
+	":pig_face:\x00\U0001f437\x00" +
+	":pig_nose:\x00\U0001f43d\x00" +
+	":pile_of_poo:\x00\U0001f4a9\x00" +
+	":pill:\x00\U0001f48a\x00" +
+	":pilot:\x00\U0001f9d1\u200d\u2708\ufe0f\x00" +
+	":pinata:\x00\U0001fa85\x00" +
+	":pinched_fingers:\x00\U0001f90c\x00" +
+	":pinching_hand:\x00\U0001f90f\x00" +
+	":pine_decoration:\x00\U0001f38d\x00" +
+	":pineapple:\x00\U0001f34d\x00" +
+	":ping_pong:\x00\U0001f3d3\x00" +
+	":pisces:\x00\u2653\x00" +
+	":pizza:\x00\U0001f355\x00" +
+	":placard:\x00\U0001faa7\x00" +
+	":place_of_worship:\x00\U0001f6d0\x00" +
+	":plate_with_cutlery:\x00\U0001f37d\ufe0f\x00" +
+	":play_button:\x00\u25b6\ufe0f\x00" +
+	":play_or_pause_button:\x00\u23ef\ufe0f\x00" +
+	":playground_slide:\x00\U0001f6dd\x00" +
+	":pleading_face:\x00\U0001f97a\x00" +
+	":plunger:\x00\U0001faa0\x00" +
+	":plus:\x00\u2795\x00" +
+	":point_down:\x00\U0001f447\x00" +
+	":point_left:\x00\U0001f448\x00" +
+	":point_right:\x00\U0001f449\x00" +
+	":point_up:\x00\u261d\ufe0f\x00" +
+	":point_up_2:\x00\U0001f446\x00" +
+	":polar_bear:\x00\U0001f43b\u200d\u2744\ufe0f\x00" +
+	":police_car:\x00\U0001f693\x00" +
+	":police_car_light:\x00\U0001f6a8\x00" +
+	":police_officer:\x00\U0001f46e\x00" +
+	":policeman:\x00\U0001f46e\u200d\u2642\ufe0f\x00" +
+	":policewoman:\x00\U0001f46e\u200d\u2640\ufe0f\x00" +
+	":poodle:\x00\U0001f429\x00" +
+	":pool_8_ball:\x00\U0001f3b1\x00" +
+	":poop:\x00\U0001f4a9\x00" +
+	":popcorn:\x00\U0001f37f\x00" +
+	":post_office:\x00\U0001f3e3\x00" +
+	":postal_horn:\x00\U0001f4ef\x00" +
+	":postbox:\x00\U0001f4ee\x00" +
+	":pot_of_food:\x00\U0001f372\x00" +
+	":potable_water:\x00\U0001f6b0\x00" +
+	":potato:\x00\U0001f954\x00" +
+	":potted_plant:\x00\U0001fab4\x00" +
+	":pouch:\x00\U0001f45d\x00" +
+	":poultry_leg:\x00\U0001f357\x00" +
+	":pound:\x00\U0001f4b7\x00" +
+	":pound_banknote:\x00\U0001f4b7\x00" +
+	":pouring_liquid:\x00\U0001fad7\x00" +
+	":pout:\x00\U0001f621\x00" +
+	":pouting_cat:\x00\U0001f63e\x00" +
+	":pouting_face:\x00\U0001f64e\x00" +
+	":pouting_man:\x00\U0001f64e\u200d\u2642\ufe0f\x00" +
+	":pouting_woman:\x00\U0001f64e\u200d\u2640\ufe0f\x00" +
+	":pray:\x00\U0001f64f\x00" +
+	":prayer_beads:\x00\U0001f4ff\x00" +
+	":pregnant_man:\x00\U0001fac3\x00" +
+	":pregnant_person:\x00\U0001fac4\x00" +
+	":pregnant_woman:\x00\U0001f930\x00" +
+	":pretzel:\x00\U0001f968\x00" +
+	":previous_track_button:\x00\u23ee\ufe0f\x00" +
+	":prince:\x00\U0001f934\x00" +
+	":princess:\x00\U0001f478\x00" +
+	":printer:\x00\U0001f5a8\ufe0f\x00" +
+	":probing_cane:\x00\U0001f9af\x00" +
+	":prohibited:\x00\U0001f6ab\x00" +
+	":punch:\x00\U0001f44a\x00" +
+	":purple_circle:\x00\U0001f7e3\x00" +
+	":purple_heart:\x00\U0001f49c\x00" +
+	":purple_square:\x00\U0001f7ea\x00" +
+	":purse:\x00\U0001f45b\x00" +
+	":pushpin:\x00\U0001f4cc\x00" +
+	":put_litter_in_its_place:\x00\U0001f6ae\x00" +
+	":puzzle_piece:\x00\U0001f9e9\x00" +
+	":question:\x00\u2753\x00" +
+	":rabbit2:\x00\U0001f407\x00" +
+	":rabbit:\x00\U0001f430\x00" +
+	":rabbit_face:\x00\U0001f430\x00" +
+	":raccoon:\x00\U0001f99d\x00" +
+	":racehorse:\x00\U0001f40e\x00" +
+	":racing_car:\x00\U0001f3ce\ufe0f\x00" +
+	":radio:\x00\U0001f4fb\x00" +
+	":radio_button:\x00\U0001f518\x00" +
+	":radioactive:\x00\u2622\ufe0f\x00" +
+	":rage:\x00\U0001f621\x00" +
+	":railway_car:\x00\U0001f683\x00" +
+	":railway_track:\x00\U0001f6e4\ufe0f\x00" +
+	":rainbow:\x00\U0001f308\x00" +
+	":raised_back_of_hand:\x00\U0001f91a\x00" +
+	":raised_eyebrow:\x00\U0001f928\x00" +
+	":raised_fist:\x00\u270a\x00" +
+	":raised_hand:\x00\u270b\x00" +
+	":raised_hand_with_fingers_splayed:\x00\U0001f590\ufe0f\x00" +
+	":raised_hands:\x00\U0001f64c\x00" +
+	":raising_hand:\x00\U0001f64b\x00" +
+	":raising_hand_man:\x00\U0001f64b\u200d\u2642\ufe0f\x00" +
+	":raising_hand_woman:\x00\U0001f64b\u200d\u2640\ufe0f\x00" +
+	":raising_hands:\x00\U0001f64c\x00" +
+	":ram:\x00\U0001f40f\x00" +
+	":ramen:\x00\U0001f35c\x00" +
+	":rat:\x00\U0001f400\x00" +
+	":razor:\x00\U0001fa92\x00" +
+	":receipt:\x00\U0001f9fe\x00" +
+	":record_button:\x00\u23fa\ufe0f\x00" +
+	":recycle:\x00\u267b\ufe0f\x00" +
+	":recycling_symbol:\x00\u267b\ufe0f\x00" +
+	":red_apple:\x00\U0001f34e\x00" +
+	":red_car:\x00\U0001f697\x00" +
+	":red_circle:\x00\U0001f534\x00" +
+	":red_envelope:\x00\U0001f9e7\x00" +
+	":red_exclamation_mark:\x00\u2757\x00" +
+	":red_hair:\x00\U0001f9b0\x00" +
+	":red_haired_man:\x00\U0001f468\u200d\U0001f9b0\x00" +
+	":red_haired_woman:\x00\U0001f469\u200d\U0001f9b0\x00" +
+	":red_heart:\x00\u2764\ufe0f\x00" +
+	":red_paper_lantern:\x00\U0001f3ee\x00" +
+	":red_question_mark:\x00\u2753\x00" +
+	":red_square:\x00\U0001f7e5\x00" +
+	":red_triangle_pointed_down:\x00\U0001f53b\x00" +
+	":red_triangle_pointed_up:\x00\U0001f53a\x00" +
+	":registered:\x00\u00ae\ufe0f\x00" +
+	":relaxed:\x00\u263a\ufe0f\x00" +
+	":relieved:\x00\U0001f60c\x00" +
+	":relieved_face:\x00\U0001f60c\x00" +
+	":reminder_ribbon:\x00\U0001f397\ufe0f\x00" +
+	":repeat:\x00\U0001f501\x00" +
+	":repeat_button:\x00\U0001f501\x00" +
+	":repeat_one:\x00\U0001f502\x00" +
+	":repeat_single_button:\x00\U0001f502\x00" +
+	":rescue_worker_helmet:\x00\u26d1\ufe0f\x00" +
+	":rescue_worker_s_helmet:\x00\u26d1\ufe0f\x00" +
+	":restroom:\x00\U0001f6bb\x00" +
+	":reverse_button:\x00\u25c0\ufe0f\x00" +
+	":revolving_hearts:\x00\U0001f49e\x00" +
+	":rewind:\x00\u23ea\x00" +
+	":rhinoceros:\x00\U0001f98f\x00" +
+	":ribbon:\x00\U0001f380\x00" +
+	":rice:\x00\U0001f35a\x00" +
+	":rice_ball:\x00\U0001f359\x00" +
+	":rice_cracker:\x00\U0001f358\x00" +
+	":rice_scene:\x00\U0001f391\x00" +
+	":right_anger_bubble:\x00\U0001f5ef\ufe0f\x00" +
+	":right_arrow:\x00\u27a1\ufe0f\x00" +
+	":right_arrow_curving_down:\x00\u2935\ufe0f\x00" +
+	":right_arrow_curving_left:\x00\u21a9\ufe0f\x00" +
+	":right_arrow_curving_up:\x00\u2934\ufe0f\x00" +
+	":right_facing_fist:\x00\U0001f91c\x00" +
+	":rightwards_hand:\x00\U0001faf1\x00" +
+	":ring:\x00\U0001f48d\x00" +
+	":ring_buoy:\x00\U0001f6df\x00" +
+	":ringed_planet:\x00\U0001fa90\x00" +
+	":roasted_sweet_potato:\x00\U0001f360\x00" +
+	":robot:\x00\U0001f916\x00" +
+	":robot_face:\x00\U0001f916\x00" +
+	":rock:\x00\U0001faa8\x00" +
+	":rocket:\x00\U0001f680\x00" +
+	":rofl:\x00\U0001f923\x00" +
+	":roll_eyes:\x00\U0001f644\x00" +
+	":roll_of_paper:\x00\U0001f9fb\x00" +
+	":rolled_up_newspaper:\x00\U0001f5de\ufe0f\x00" +
+	":roller_coaster:\x00\U0001f3a2\x00" +
+	":roller_skate:\x00\U0001f6fc\x00" +
+	":rolling_on_the_floor_laughing:\x00\U0001f923\x00" +
+	":rooster:\x00\U0001f413\x00" +
+	":rose:\x00\U0001f339\x00" +
+	":rosette:\x00\U0001f3f5\ufe0f\x00" +
+	":rotating_light:\x00\U0001f6a8\x00" +
+	":round_pushpin:\x00\U0001f4cd\x00" +
+	":rowboat:\x00\U0001f6a3\x00" +
+	":rowing_man:\x00\U0001f6a3\u200d\u2642\ufe0f\x00" +
+	":rowing_woman:\x00\U0001f6a3\u200d\u2640\ufe0f\x00" +
+	":rugby_football:\x00\U0001f3c9\x00" +
+	":runner:\x00\U0001f3c3\x00" +
+	":running:\x00\U0001f3c3\x00" +
+	":running_man:\x00\U0001f3c3\u200d\u2642\ufe0f\x00" +
+	":running_shirt:\x00\U0001f3bd\x00" +
+	":running_shirt_with_sash:\x00\U0001f3bd\x00" +
+	":running_shoe:\x00\U0001f45f\x00" +
+	":running_woman:\x00\U0001f3c3\u200d\u2640\ufe0f\x00" +
+	":sa:\x00\U0001f202\ufe0f\x00" +
+	":sad_but_relieved_face:\x00\U0001f625\x00" +
+	":safety_pin:\x00\U0001f9f7\x00" +
+	":safety_vest:\x00\U0001f9ba\x00" +
+	":sagittarius:\x00\u2650\x00" +
+	":sailboat:\x00\u26f5\x00" +
+	":sake:\x00\U0001f376\x00" +
+	":salt:\x00\U0001f9c2\x00" +
+	":saluting_face:\x00\U0001fae1\x00" +
+	":sandal:\x00\U0001f461\x00" +
+	":sandwich:\x00\U0001f96a\x00" +
+	":santa:\x00\U0001f385\x00" +
+	":santa_claus:\x00\U0001f385\x00" +
+	":sari:\x00\U0001f97b\x00" +
+	":sassy_man:\x00\U0001f481\u200d\u2642\ufe0f\x00" +
+	":sassy_woman:\x00\U0001f481\u200d\u2640\ufe0f\x00" +
+	":satellite:\x00\U0001f4e1\x00" +
+	":satellite_antenna:\x00\U0001f4e1\x00" +
+	":satisfied:\x00\U0001f606\x00" +
+	":sauna_man:\x00\U0001f9d6\u200d\u2642\ufe0f\x00" +
+	":sauna_person:\x00\U0001f9d6\x00" +
+	":sauna_woman:\x00\U0001f9d6\u200d\u2640\ufe0f\x00" +
+	":sauropod:\x00\U0001f995\x00" +
+	":saxophone:\x00\U0001f3b7\x00" +
+	":scarf:\x00\U0001f9e3\x00" +
+	":school:\x00\U0001f3eb\x00" +
+	":school_satchel:\x00\U0001f392\x00" +
+	":scientist:\x00\U0001f9d1\u200d\U0001f52c\x00" +
+	":scissors:\x00\u2702\ufe0f\x00" +
+	":scorpio:\x00\u264f\x00" +
+	":scorpion:\x00\U0001f982\x00" +
+	":scorpius:\x00\u264f\x00" +
+	":scream:\x00\U0001f631\x00" +
+	":scream_cat:\x00\U0001f640\x00" +
+	":screwdriver:\x00\U0001fa9b\x00" +
+	":scroll:\x00\U0001f4dc\x00" +
+	":seal:\x00\U0001f9ad\x00" +
+	":seat:\x00\U0001f4ba\x00" +
+	":second_place_medal:\x00\U0001f948\x00" +
+	":secret:\x00\u3299\ufe0f\x00" +
+	":see_no_evil:\x00\U0001f648\x00" +
+	":see_no_evil_monkey:\x00\U0001f648\x00" +
+	":seedling:\x00\U0001f331\x00" +
+	":selfie:\x00\U0001f933\x00" +
+	":service_dog:\x00\U0001f415\u200d\U0001f9ba\x00" +
+	":seven:\x007\ufe0f\u20e3\x00" +
+	":seven_o_clock:\x00\U0001f556\x00" +
+	":seven_thirty:\x00\U0001f562\x00" +
+	":sewing_needle:\x00\U0001faa1\x00" +
+	":shallow_pan_of_food:\x00\U0001f958\x00" +
+	":shamrock:\x00\u2618\ufe0f\x00" +
+	":shark:\x00\U0001f988\x00" +
+	":shaved_ice:\x00\U0001f367\x00" +
+	":sheaf_of_rice:\x00\U0001f33e\x00" +
+	":sheep:\x00\U0001f411\x00" +
+	":shell:\x00\U0001f41a\x00" +
+	":shield:\x00\U0001f6e1\ufe0f\x00" +
+	":shinto_shrine:\x00\u26e9\ufe0f\x00" +
+	":ship:\x00\U0001f6a2\x00" +
+	":shirt:\x00\U0001f455\x00" +
+	":shit:\x00\U0001f4a9\x00" +
+	":shoe:\x00\U0001f45e\x00" +
+	":shooting_star:\x00\U0001f320\x00" +
+	":shopping:\x00\U0001f6cd\ufe0f\x00" +
+	":shopping_bags:\x00\U0001f6cd\ufe0f\x00" +
+	":shopping_cart:\x00\U0001f6d2\x00" +
+	":shortcake:\x00\U0001f370\x00" +
+	":shorts:\x00\U0001fa73\x00" +
+	":shower:\x00\U0001f6bf\x00" +
+	":shrimp:\x00\U0001f990\x00" +
+	":shrug:\x00\U0001f937\x00" +
+	":shuffle_tracks_button:\x00\U0001f500\x00" +
+	":shushing_face:\x00\U0001f92b\x00" +
+	":sign_of_the_horns:\x00\U0001f918\x00" +
+	":signal_strength:\x00\U0001f4f6\x00" +
+	":singer:\x00\U0001f9d1\u200d\U0001f3a4\x00" +
+	":six:\x006\ufe0f\u20e3\x00" +
+	":six_o_clock:\x00\U0001f555\x00" +
+	":six_pointed_star:\x00\U0001f52f\x00" +
+	":six_thirty:\x00\U0001f561\x00" +
+	":skateboard:\x00\U0001f6f9\x00" +
+	":ski:\x00\U0001f3bf\x00" +
+	":skier:\x00\u26f7\ufe0f\x00" +
+	":skis:\x00\U0001f3bf\x00" +
+	":skull:\x00\U0001f480\x00" +
+	":skull_and_crossbones:\x00\u2620\ufe0f\x00" +
+	":skunk:\x00\U0001f9a8\x00" +
+	":sled:\x00\U0001f6f7\x00" +
+	":sleeping:\x00\U0001f634\x00" +
+	":sleeping_bed:\x00\U0001f6cc\x00" +
+	":sleeping_face:\x00\U0001f634\x00" +
+	":sleepy:\x00\U0001f62a\x00" +
+	":sleepy_face:\x00\U0001f62a\x00" +
+	":slightly_frowning_face:\x00\U0001f641\x00" +
+	":slightly_smiling_face:\x00\U0001f642\x00" +
+	":slot_machine:\x00\U0001f3b0\x00" +
+	":sloth:\x00\U0001f9a5\x00" +
+	":small_airplane:\x00\U0001f6e9\ufe0f\x00" +
+	":small_blue_diamond:\x00\U0001f539\x00" +
+	":small_orange_diamond:\x00\U0001f538\x00" +
+	":small_red_triangle:\x00\U0001f53a\x00" +
+	":small_red_triangle_down:\x00\U0001f53b\x00" +
+	":smile:\x00\U0001f604\x00" +
+	":smile_cat:\x00\U0001f638\x00" +
+	":smiley:\x00\U0001f603\x00" +
+	":smiley_cat:\x00\U0001f63a\x00" +
+	":smiling_cat_with_heart_eyes:\x00\U0001f63b\x00" +
+	":smiling_face:\x00\u263a\ufe0f\x00" +
+	":smiling_face_with_halo:\x00\U0001f607\x00" +
+	":smiling_face_with_heart_eyes:\x00\U0001f60d\x00" +
+	":smiling_face_with_hearts:\x00\U0001f970\x00" +
+	":smiling_face_with_horns:\x00\U0001f608\x00" +
+	":smiling_face_with_open_hands:\x00\U0001f917\x00" +
+	":smiling_face_with_smiling_eyes:\x00\U0001f60a\x00" +
+	":smiling_face_with_sunglasses:\x00\U0001f60e\x00" +
+	":smiling_face_with_tear:\x00\U0001f972\x00" +
+	":smiling_face_with_three_hearts:\x00\U0001f970\x00" +
+	":smiling_imp:\x00\U0001f608\x00" +
+	":smirk:\x00\U0001f60f\x00" +
+	":smirk_cat:\x00\U0001f63c\x00" +
+	":smirking_face:\x00\U0001f60f\x00" +
+	":smoking:\x00\U0001f6ac\x00" +
+	":snail:\x00\U0001f40c\x00" +
+	":snake:\x00\U0001f40d\x00" +
+	":sneezing_face:\x00\U0001f927\x00" +
+	":snow_capped_mountain:\x00\U0001f3d4\ufe0f\x00" +
+	":snowboarder:\x00\U0001f3c2\x00" +
+	":snowflake:\x00\u2744\ufe0f\x00" +
+	":snowman:\x00\u26c4\x00" +
+	":snowman_with_snow:\x00\u2603\ufe0f\x00" +
+	":snowman_without_snow:\x00\u26c4\x00" +
+	":soap:\x00\U0001f9fc\x00" +
+	":sob:\x00\U0001f62d\x00" +
+	":soccer:\x00\u26bd\x00" +
+	":soccer_ball:\x00\u26bd\x00" +
+	":socks:\x00\U0001f9e6\x00" +
+	":soft_ice_cream:\x00\U0001f366\x00" +
+	":softball:\x00\U0001f94e\x00" +
+	":soon:\x00\U0001f51c\x00" +
+	":soon_arrow:\x00\U0001f51c\x00" +
+	":sos:\x00\U0001f198\x00" +
+	":sos_button:\x00\U0001f198\x00" +
+	":sound:\x00\U0001f509\x00" +
+	":space_invader:\x00\U0001f47e\x00" +
+	":spade_suit:\x00\u2660\ufe0f\x00" +
+	":spades:\x00\u2660\ufe0f\x00" +
+	":spaghetti:\x00\U0001f35d\x00" +
+	":sparkle:\x00\u2747\ufe0f\x00" +
+	":sparkler:\x00\U0001f387\x00" +
+	":sparkles:\x00\u2728\x00" +
+	":sparkling_heart:\x00\U0001f496\x00" +
+	":speak_no_evil:\x00\U0001f64a\x00" +
+	":speak_no_evil_monkey:\x00\U0001f64a\x00" +
+	":speaker:\x00\U0001f508\x00" +
+	":speaker_high_volume:\x00\U0001f50a\x00" +
+	":speaker_low_volume:\x00\U0001f508\x00" +
+	":speaker_medium_volume:\x00\U0001f509\x00" +
+	":speaking_head:\x00\U0001f5e3\ufe0f\x00" +
+	":speech_balloon:\x00\U0001f4ac\x00" +
+	":speedboat:\x00\U0001f6a4\x00" +
+	":spider:\x00\U0001f577\ufe0f\x00" +
+	":spider_web:\x00\U0001f578\ufe0f\x00" +
+	":spiral_calendar:\x00\U0001f5d3\ufe0f\x00" +
+	":spiral_notepad:\x00\U0001f5d2\ufe0f\x00" +
+	":spiral_shell:\x00\U0001f41a\x00" +
+	":sponge:\x00\U0001f9fd\x00" +
+	":spoon:\x00\U0001f944\x00" +
+	":sport_utility_vehicle:\x00\U0001f699\x00" +
+	":sports_medal:\x00\U0001f3c5\x00" +
+	":spouting_whale:\x00\U0001f433\x00" +
+	":squid:\x00\U0001f991\x00" +
+	":squinting_face_with_tongue:\x00\U0001f61d\x00" +
+	":stadium:\x00\U0001f3df\ufe0f\x00" +
+	":standing_man:\x00\U0001f9cd\u200d\u2642\ufe0f\x00" +
+	":standing_person:\x00\U0001f9cd\x00" +
+	":standing_woman:\x00\U0001f9cd\u200d\u2640\ufe0f\x00" +
+	":star2:\x00\U0001f31f\x00" +
+	":star:\x00\u2b50\x00" +
+	":star_and_crescent:\x00\u262a\ufe0f\x00" +
+	":star_of_david:\x00\u2721\ufe0f\x00" +
+	":star_struck:\x00\U0001f929\x00" +
+	":stars:\x00\U0001f320\x00" +
+	":station:\x00\U0001f689\x00" +
+	":statue_of_liberty:\x00\U0001f5fd\x00" +
+	":steam_locomotive:\x00\U0001f682\x00" +
+	":steaming_bowl:\x00\U0001f35c\x00" +
+	":stethoscope:\x00\U0001fa7a\x00" +
+	":stew:\x00\U0001f372\x00" +
+	":stop_button:\x00\u23f9\ufe0f\x00" +
+	":stop_sign:\x00\U0001f6d1\x00" +
+	":stopwatch:\x00\u23f1\ufe0f\x00" +
+	":straight_ruler:\x00\U0001f4cf\x00" +
+	":strawberry:\x00\U0001f353\x00" +
+	":stuck_out_tongue:\x00\U0001f61b\x00" +
+	":stuck_out_tongue_closed_eyes:\x00\U0001f61d\x00" +
+	":stuck_out_tongue_winking_eye:\x00\U0001f61c\x00" +
+	":student:\x00\U0001f9d1\u200d\U0001f393\x00" +
+	":studio_microphone:\x00\U0001f399\ufe0f\x00" +
+	":stuffed_flatbread:\x00\U0001f959\x00" +
+	":sun:\x00\u2600\ufe0f\x00" +
+	":sun_behind_cloud:\x00\u26c5\x00" +
+	":sun_behind_large_cloud:\x00\U0001f325\ufe0f\x00" +
+	":sun_behind_rain_cloud:\x00\U0001f326\ufe0f\x00" +
+	":sun_behind_small_cloud:\x00\U0001f324\ufe0f\x00" +
+	":sun_with_face:\x00\U0001f31e\x00" +
+	":sunflower:\x00\U0001f33b\x00" +
+	":sunglasses:\x00\U0001f60e\x00" +
+	":sunny:\x00\u2600\ufe0f\x00" +
+	":sunrise:\x00\U0001f305\x00" +
+	":sunrise_over_mountains:\x00\U0001f304\x00" +
+	":sunset:\x00\U0001f307\x00" +
+	":superhero:\x00\U0001f9b8\x00" +
+	":superhero_man:\x00\U0001f9b8\u200d\u2642\ufe0f\x00" +
+	":superhero_woman:\x00\U0001f9b8\u200d\u2640\ufe0f\x00" +
+	":supervillain:\x00\U0001f9b9\x00" +
+	":supervillain_man:\x00\U0001f9b9\u200d\u2642\ufe0f\x00" +
+	":supervillain_woman:\x00\U0001f9b9\u200d\u2640\ufe0f\x00" +
+	":surfer:\x00\U0001f3c4\x00" +
+	":surfing_man:\x00\U0001f3c4\u200d\u2642\ufe0f\x00" +
+	":surfing_woman:\x00\U0001f3c4\u200d\u2640\ufe0f\x00" +
+	":sushi:\x00\U0001f363\x00" +
+	":suspension_railway:\x00\U0001f69f\x00" +
+	":swan:\x00\U0001f9a2\x00" +
+	":sweat:\x00\U0001f613\x00" +
+	":sweat_droplets:\x00\U0001f4a6\x00" +
+	":sweat_drops:\x00\U0001f4a6\x00" +
+	":sweat_smile:\x00\U0001f605\x00" +
+	":sweet_potato:\x00\U0001f360\x00" +
+	":swim_brief:\x00\U0001fa72\x00" +
+	":swimmer:\x00\U0001f3ca\x00" +
+	":swimming_man:\x00\U0001f3ca\u200d\u2642\ufe0f\x00" +
+	":swimming_woman:\x00\U0001f3ca\u200d\u2640\ufe0f\x00" +
+	":symbols:\x00\U0001f523\x00" +
+	":synagogue:\x00\U0001f54d\x00" +
+	":syringe:\x00\U0001f489\x00" +
+	":t-rex:\x00\U0001f996\x00" +
+	":t_rex:\x00\U0001f996\x00" +
+	":t_shirt:\x00\U0001f455\x00" +
+	":taco:\x00\U0001f32e\x00" +
+	":tada:\x00\U0001f389\x00" +
+	":takeout_box:\x00\U0001f961\x00" +
+	":tamale:\x00\U0001fad4\x00" +
+	":tanabata_tree:\x00\U0001f38b\x00" +
+	":tangerine:\x00\U0001f34a\x00" +
+	":taurus:\x00\u2649\x00" +
+	":taxi:\x00\U0001f695\x00" +
+	":tea:\x00\U0001f375\x00" +
+	":teacher:\x00\U0001f9d1\u200d\U0001f3eb\x00" +
+	":teacup_without_handle:\x00\U0001f375\x00" +
+	":teapot:\x00\U0001fad6\x00" +
+	":tear_off_calendar:\x00\U0001f4c6\x00" +
+	":technologist:\x00\U0001f9d1\u200d\U0001f4bb\x00" +
+	":teddy_bear:\x00\U0001f9f8\x00" +
+	":telephone:\x00\u260e\ufe0f\x00" +
+	":telephone_receiver:\x00\U0001f4de\x00" +
+	":telescope:\x00\U0001f52d\x00" +
+	":television:\x00\U0001f4fa\x00" +
+	":ten_o_clock:\x00\U0001f559\x00" +
+	":ten_thirty:\x00\U0001f565\x00" +
+	":tennis:\x00\U0001f3be\x00" +
+	":tent:\x00\u26fa\x00" +
+	":test_tube:\x00\U0001f9ea\x00" +
+	":thermometer:\x00\U0001f321\ufe0f\x00" +
+	":thinking:\x00\U0001f914\x00" +
+	":thinking_face:\x00\U0001f914\x00" +
+	":third_place_medal:\x00\U0001f949\x00" +
+	":thong_sandal:\x00\U0001fa74\x00" +
+	":thought_balloon:\x00\U0001f4ad\x00" +
+	":thread:\x00\U0001f9f5\x00" +
+	":three:\x003\ufe0f\u20e3\x00" +
+	":three_o_clock:\x00\U0001f552\x00" +
+	":three_thirty:\x00\U0001f55e\x00" +
+	":thumbs_down:\x00\U0001f44e\x00" +
+	":thumbs_up:\x00\U0001f44d\x00" +
+	":thumbsdown:\x00\U0001f44e\x00" +
+	":thumbsup:\x00\U0001f44d\x00" +
+	":ticket:\x00\U0001f3ab\x00" +
+	":tickets:\x00\U0001f39f\ufe0f\x00" +
+	":tiger2:\x00\U0001f405\x00" +
+	":tiger:\x00\U0001f42f\x00" +
+	":tiger_face:\x00\U0001f42f\x00" +
+	":timer_clock:\x00\u23f2\ufe0f\x00" +
+	":tipping_hand_man:\x00\U0001f481\u200d\u2642\ufe0f\x00" +
+	":tipping_hand_person:\x00\U0001f481\x00" +
+	":tipping_hand_woman:\x00\U0001f481\u200d\u2640\ufe0f\x00" +
+	":tired_face:\x00\U0001f62b\x00" +
+	":tm:\x00\u2122\ufe0f\x00" +
+	":toilet:\x00\U0001f6bd\x00" +
+	":tokyo_tower:\x00\U0001f5fc\x00" +
+	":tomato:\x00\U0001f345\x00" +
+	":tongue:\x00\U0001f445\x00" +
+	":toolbox:\x00\U0001f9f0\x00" +
+	":tooth:\x00\U0001f9b7\x00" +
+	":toothbrush:\x00\U0001faa5\x00" +
+	":top:\x00\U0001f51d\x00" +
+	":top_arrow:\x00\U0001f51d\x00" +
+	":top_hat:\x00\U0001f3a9\x00" +
+	":tophat:\x00\U0001f3a9\x00" +
+	":tornado:\x00\U0001f32a\ufe0f\x00" +
+	":trackball:\x00\U0001f5b2\ufe0f\x00" +
+	":tractor:\x00\U0001f69c\x00" +
+	":trade_mark:\x00\u2122\ufe0f\x00" +
+	":traffic_light:\x00\U0001f6a5\x00" +
+	":train2:\x00\U0001f686\x00" +
+	":train:\x00\U0001f68b\x00" +
+	":tram:\x00\U0001f68a\x00" +
+	":tram_car:\x00\U0001f68b\x00" +
+	":transgender_symbol:\x00\u26a7\ufe0f\x00" +
+	":triangular_ruler:\x00\U0001f4d0\x00" +
+	":trident:\x00\U0001f531\x00" +
+	":trident_emblem:\x00\U0001f531\x00" +
+	":triumph:\x00\U0001f624\x00" +
+	":troll:\x00\U0001f9cc\x00" +
+	":trolleybus:\x00\U0001f68e\x00" +
+	":trophy:\x00\U0001f3c6\x00" +
+	":tropical_drink:\x00\U0001f379\x00" +
+	":tropical_fish:\x00\U0001f420\x00" +
+	":truck:\x00\U0001f69a\x00" +
+	":trumpet:\x00\U0001f3ba\x00" +
+	":tshirt:\x00\U0001f455\x00" +
+	":tulip:\x00\U0001f337\x00" +
+	":tumbler_glass:\x00\U0001f943\x00" +
+	":turkey:\x00\U0001f983\x00" +
+	":turtle:\x00\U0001f422\x00" +
+	":tv:\x00\U0001f4fa\x00" +
+	":twelve_o_clock:\x00\U0001f55b\x00" +
+	":twelve_thirty:\x00\U0001f567\x00" +
+	":twisted_rightwards_arrows:\x00\U0001f500\x00" +
+	":two:\x002\ufe0f\u20e3\x00" +
+	":two_hearts:\x00\U0001f495\x00" +
+	":two_hump_camel:\x00\U0001f42b\x00" +
+	":two_men_holding_hands:\x00\U0001f46c\x00" +
+	":two_o_clock:\x00\U0001f551\x00" +
+	":two_thirty:\x00\U0001f55d\x00" +
+	":two_women_holding_hands:\x00\U0001f46d\x00" +
+	":u5272:\x00\U0001f239\x00" +
+	":u5408:\x00\U0001f234\x00" +
+	":u55b6:\x00\U0001f23a\x00" +
+	":u6307:\x00\U0001f22f\x00" +
+	":u6708:\x00\U0001f237\ufe0f\x00" +
+	":u6709:\x00\U0001f236\x00" +
+	":u6e80:\x00\U0001f235\x00" +
+	":u7121:\x00\U0001f21a\x00" +
+	":u7533:\x00\U0001f238\x00" +
+	":u7981:\x00\U0001f232\x00" +
+	":u7a7a:\x00\U0001f233\x00" +
+	":umbrella:\x00\u2614\x00" +
+	":umbrella_on_ground:\x00\u26f1\ufe0f\x00" +
+	":umbrella_with_rain_drops:\x00\u2614\x00" +
+	":unamused:\x00\U0001f612\x00" +
+	":unamused_face:\x00\U0001f612\x00" +
+	":underage:\x00\U0001f51e\x00" +
+	":unicorn:\x00\U0001f984\x00" +
+	":unlock:\x00\U0001f513\x00" +
+	":unlocked:\x00\U0001f513\x00" +
+	":up:\x00\U0001f199\x00" +
+	":up_arrow:\x00\u2b06\ufe0f\x00" +
+	":up_button:\x00\U0001f199\x00" +
+	":up_down_arrow:\x00\u2195\ufe0f\x00" +
+	":up_left_arrow:\x00\u2196\ufe0f\x00" +
+	":up_right_arrow:\x00\u2197\ufe0f\x00" +
+	":upside_down_face:\x00\U0001f643\x00" +
+	":upwards_button:\x00\U0001f53c\x00" +
+	":v:\x00\u270c\ufe0f\x00" +
+	":vampire:\x00\U0001f9db\x00" +
+	":vampire_man:\x00\U0001f9db\u200d\u2642\ufe0f\x00" +
+	":vampire_woman:\x00\U0001f9db\u200d\u2640\ufe0f\x00" +
+	":vertical_traffic_light:\x00\U0001f6a6\x00" +
+	":vhs:\x00\U0001f4fc\x00" +
+	":vibration_mode:\x00\U0001f4f3\x00" +
+	":victory_hand:\x00\u270c\ufe0f\x00" +
+	":video_camera:\x00\U0001f4f9\x00" +
+	":video_game:\x00\U0001f3ae\x00" +
+	":videocassette:\x00\U0001f4fc\x00" +
+	":violin:\x00\U0001f3bb\x00" +
+	":virgo:\x00\u264d\x00" +
+	":volcano:\x00\U0001f30b\x00" +
+	":volleyball:\x00\U0001f3d0\x00" +
+	":vomiting_face:\x00\U0001f92e\x00" +
+	":vs:\x00\U0001f19a\x00" +
+	":vs_button:\x00\U0001f19a\x00" +
+	":vulcan_salute:\x00\U0001f596\x00" +
+	":waffle:\x00\U0001f9c7\x00" +
+	":walking:\x00\U0001f6b6\x00" +
+	":walking_man:\x00\U0001f6b6\u200d\u2642\ufe0f\x00" +
+	":walking_woman:\x00\U0001f6b6\u200d\u2640\ufe0f\x00" +
+	":waning_crescent_moon:\x00\U0001f318\x00" +
+	":waning_gibbous_moon:\x00\U0001f316\x00" +
+	":warning:\x00\u26a0\ufe0f\x00" +
+	":wastebasket:\x00\U0001f5d1\ufe0f\x00" +
+	":watch:\x00\u231a\x00" +
+	":water_buffalo:\x00\U0001f403\x00" +
+	":water_closet:\x00\U0001f6be\x00" +
+	":water_pistol:\x00\U0001f52b\x00" +
+	":water_polo:\x00\U0001f93d\x00" +
+	":water_wave:\x00\U0001f30a\x00" +
+	":watermelon:\x00\U0001f349\x00" +
+	":wave:\x00\U0001f44b\x00" +
+	":waving_hand:\x00\U0001f44b\x00" +
+	":wavy_dash:\x00\u3030\ufe0f\x00" +
+	":waxing_crescent_moon:\x00\U0001f312\x00" +
+	":waxing_gibbous_moon:\x00\U0001f314\x00" +
+	":wc:\x00\U0001f6be\x00" +
+	":weary:\x00\U0001f629\x00" +
+	":weary_cat:\x00\U0001f640\x00" +
+	":weary_face:\x00\U0001f629\x00" +
+	":wedding:\x00\U0001f492\x00" +
+	":weight_lifting:\x00\U0001f3cb\ufe0f\x00" +
+	":weight_lifting_man:\x00\U0001f3cb\ufe0f\u200d\u2642\ufe0f\x00" +
+	":weight_lifting_woman:\x00\U0001f3cb\ufe0f\u200d\u2640\ufe0f\x00" +
+	":whale2:\x00\U0001f40b\x00" +
+	":whale:\x00\U0001f433\x00" +
+	":wheel:\x00\U0001f6de\x00" +
+	":wheel_of_dharma:\x00\u2638\ufe0f\x00" +
+	":wheelchair:\x00\u267f\x00" +
+	":wheelchair_symbol:\x00\u267f\x00" +
+	":white_cane:\x00\U0001f9af\x00" +
+	":white_check_mark:\x00\u2705\x00" +
+	":white_circle:\x00\u26aa\x00" +
+	":white_exclamation_mark:\x00\u2755\x00" +
+	":white_flower:\x00\U0001f4ae\x00" +
+	":white_hair:\x00\U0001f9b3\x00" +
+	":white_haired_man:\x00\U0001f468\u200d\U0001f9b3\x00" +
+	":white_haired_woman:\x00\U0001f469\u200d\U0001f9b3\x00" +
+	":white_heart:\x00\U0001f90d\x00" +
+	":white_large_square:\x00\u2b1c\x00" +
+	":white_medium_small_square:\x00\u25fd\x00" +
+	":white_medium_square:\x00\u25fb\ufe0f\x00" +
+	":white_question_mark:\x00\u2754\x00" +
+	":white_small_square:\x00\u25ab\ufe0f\x00" +
+	":white_square_button:\x00\U0001f533\x00" +
+	":wilted_flower:\x00\U0001f940\x00" +
+	":wind_chime:\x00\U0001f390\x00" +
+	":wind_face:\x00\U0001f32c\ufe0f\x00" +
+	":window:\x00\U0001fa9f\x00" +
+	":wine_glass:\x00\U0001f377\x00" +
+	":wink:\x00\U0001f609\x00" +
+	":winking_face:\x00\U0001f609\x00" +
+	":winking_face_with_tongue:\x00\U0001f61c\x00" +
+	":wolf:\x00\U0001f43a\x00" +
+	":woman:\x00\U0001f469\x00" +
+	":woman_and_man_holding_hands:\x00\U0001f46b\x00" +
+	":woman_artist:\x00\U0001f469\u200d\U0001f3a8\x00" +
+	":woman_astronaut:\x00\U0001f469\u200d\U0001f680\x00" +
+	":woman_bald:\x00\U0001f469\u200d\U0001f9b2\x00" +
+	":woman_beard:\x00\U0001f9d4\u200d\u2640\ufe0f\x00" +
+	":woman_biking:\x00\U0001f6b4\u200d\u2640\ufe0f\x00" +
+	":woman_bouncing_ball:\x00\u26f9\ufe0f\u200d\u2640\ufe0f\x00" +
+	":woman_bowing:\x00\U0001f647\u200d\u2640\ufe0f\x00" +
+	":woman_cartwheeling:\x00\U0001f938\u200d\u2640\ufe0f\x00" +
+	":woman_climbing:\x00\U0001f9d7\u200d\u2640\ufe0f\x00" +
+	":woman_construction_worker:\x00\U0001f477\u200d\u2640\ufe0f\x00" +
+	":woman_cook:\x00\U0001f469\u200d\U0001f373\x00" +
+	":woman_dancing:\x00\U0001f483\x00" +
+	":woman_detective:\x00\U0001f575\ufe0f\u200d\u2640\ufe0f\x00" +
+	":woman_elf:\x00\U0001f9dd\u200d\u2640\ufe0f\x00" +
+	":woman_facepalming:\x00\U0001f926\u200d\u2640\ufe0f\x00" +
+	":woman_factory_worker:\x00\U0001f469\u200d\U0001f3ed\x00" +
+	":woman_fairy:\x00\U0001f9da\u200d\u2640\ufe0f\x00" +
+	":woman_farmer:\x00\U0001f469\u200d\U0001f33e\x00" +
+	":woman_feeding_baby:\x00\U0001f469\u200d\U0001f37c\x00" +
+	":woman_firefighter:\x00\U0001f469\u200d\U0001f692\x00" +
+	":woman_frowning:\x00\U0001f64d\u200d\u2640\ufe0f\x00" +
+	":woman_genie:\x00\U0001f9de\u200d\u2640\ufe0f\x00" +
+	":woman_gesturing_no:\x00\U0001f645\u200d\u2640\ufe0f\x00" +
+	":woman_gesturing_ok:\x00\U0001f646\u200d\u2640\ufe0f\x00" +
+	":woman_getting_haircut:\x00\U0001f487\u200d\u2640\ufe0f\x00" +
+	":woman_getting_massage:\x00\U0001f486\u200d\u2640\ufe0f\x00" +
+	":woman_golfing:\x00\U0001f3cc\ufe0f\u200d\u2640\ufe0f\x00" +
+	":woman_guard:\x00\U0001f482\u200d\u2640\ufe0f\x00" +
+	":woman_health_worker:\x00\U0001f469\u200d\u2695\ufe0f\x00" +
+	":woman_in_lotus_position:\x00\U0001f9d8\u200d\u2640\ufe0f\x00" +
+	":woman_in_manual_wheelchair:\x00\U0001f469\u200d\U0001f9bd\x00" +
+	":woman_in_motorized_wheelchair:\x00\U0001f469\u200d\U0001f9bc\x00" +
+	":woman_in_steamy_room:\x00\U0001f9d6\u200d\u2640\ufe0f\x00" +
+	":woman_in_tuxedo:\x00\U0001f935\u200d\u2640\ufe0f\x00" +
+	":woman_judge:\x00\U0001f469\u200d\u2696\ufe0f\x00" +
+	":woman_juggling:\x00\U0001f939\u200d\u2640\ufe0f\x00" +
+	":woman_kneeling:\x00\U0001f9ce\u200d\u2640\ufe0f\x00" +
+	":woman_lifting_weights:\x00\U0001f3cb\ufe0f\u200d\u2640\ufe0f\x00" +
+	":woman_mage:\x00\U0001f9d9\u200d\u2640\ufe0f\x00" +
+	":woman_mechanic:\x00\U0001f469\u200d\U0001f527\x00" +
+	":woman_mountain_biking:\x00\U0001f6b5\u200d\u2640\ufe0f\x00" +
+	":woman_office_worker:\x00\U0001f469\u200d\U0001f4bc\x00" +
+	":woman_pilot:\x00\U0001f469\u200d\u2708\ufe0f\x00" +
+	":woman_playing_handball:\x00\U0001f93e\u200d\u2640\ufe0f\x00" +
+	":woman_playing_water_polo:\x00\U0001f93d\u200d\u2640\ufe0f\x00" +
+	":woman_police_officer:\x00\U0001f46e\u200d\u2640\ufe0f\x00" +
+	":woman_pouting:\x00\U0001f64e\u200d\u2640\ufe0f\x00" +
+	":woman_raising_hand:\x00\U0001f64b\u200d\u2640\ufe0f\x00" +
+	":woman_rowing_boat:\x00\U0001f6a3\u200d\u2640\ufe0f\x00" +
+	":woman_running:\x00\U0001f3c3\u200d\u2640\ufe0f\x00" +
+	":woman_s_boot:\x00\U0001f462\x00" +
+	":woman_s_clothes:\x00\U0001f45a\x00" +
+	":woman_s_hat:\x00\U0001f452\x00" +
+	":woman_s_sandal:\x00\U0001f461\x00" +
+	":woman_scientist:\x00\U0001f469\u200d\U0001f52c\x00" +
+	":woman_shrugging:\x00\U0001f937\u200d\u2640\ufe0f\x00" +
+	":woman_singer:\x00\U0001f469\u200d\U0001f3a4\x00" +
+	":woman_standing:\x00\U0001f9cd\u200d\u2640\ufe0f\x00" +
+	":woman_student:\x00\U0001f469\u200d\U0001f393\x00" +
+	":woman_superhero:\x00\U0001f9b8\u200d\u2640\ufe0f\x00" +
+	":woman_supervillain:\x00\U0001f9b9\u200d\u2640\ufe0f\x00" +
+	":woman_surfing:\x00\U0001f3c4\u200d\u2640\ufe0f\x00" +
+	":woman_swimming:\x00\U0001f3ca\u200d\u2640\ufe0f\x00" +
+	":woman_teacher:\x00\U0001f469\u200d\U0001f3eb\x00" +
+	":woman_technologist:\x00\U0001f469\u200d\U0001f4bb\x00" +
+	":woman_tipping_hand:\x00\U0001f481\u200d\u2640\ufe0f\x00" +
+	":woman_vampire:\x00\U0001f9db\u200d\u2640\ufe0f\x00" +
+	":woman_walking:\x00\U0001f6b6\u200d\u2640\ufe0f\x00" +
+	":woman_wearing_turban:\x00\U0001f473\u200d\u2640\ufe0f\x00" +
+	":woman_with_beard:\x00\U0001f9d4\u200d\u2640\ufe0f\x00" +
+	":woman_with_blond_hair:\x00\U0001f471\u200d\u2640\ufe0f\x00" +
+	":woman_with_curly_hair:\x00\U0001f469\u200d\U0001f9b1\x00" +
+	":woman_with_headscarf:\x00\U0001f9d5\x00" +
+	":woman_with_probing_cane:\x00\U0001f469\u200d\U0001f9af\x00" +
+	":woman_with_red_hair:\x00\U0001f469\u200d\U0001f9b0\x00" +
+	":woman_with_turban:\x00\U0001f473\u200d\u2640\ufe0f\x00" +
+	":woman_with_veil:\x00\U0001f470\u200d\u2640\ufe0f\x00" +
+	":woman_with_white_cane:\x00\U0001f469\u200d\U0001f9af\x00" +
+	":woman_with_white_hair:\x00\U0001f469\u200d\U0001f9b3\x00" +
+	":woman_zombie:\x00\U0001f9df\u200d\u2640\ufe0f\x00" +
+	":womans_clothes:\x00\U0001f45a\x00" +
+	":womans_hat:\x00\U0001f452\x00" +
+	":women_holding_hands:\x00\U0001f46d\x00" +
+	":women_s_room:\x00\U0001f6ba\x00" +
+	":women_with_bunny_ears:\x00\U0001f46f\u200d\u2640\ufe0f\x00" +
+	":women_wrestling:\x00\U0001f93c\u200d\u2640\ufe0f\x00" +
+	":womens:\x00\U0001f6ba\x00" +
+	":wood:\x00\U0001fab5\x00" +
+	":woozy_face:\x00\U0001f974\x00" +
+	":world_map:\x00\U0001f5fa\ufe0f\x00" +
+	":worm:\x00\U0001fab1\x00" +
+	":worried:\x00\U0001f61f\x00" +
+	":worried_face:\x00\U0001f61f\x00" +
+	":wrapped_gift:\x00\U0001f381\x00" +
+	":wrench:\x00\U0001f527\x00" +
+	":wrestling:\x00\U0001f93c\x00" +
+	":writing_hand:\x00\u270d\ufe0f\x00" +
+	":x:\x00\u274c\x00" +
+	":x_ray:\x00\U0001fa7b\x00" +
+	":yarn:\x00\U0001f9f6\x00" +
+	":yawning_face:\x00\U0001f971\x00" +
+	":yellow_circle:\x00\U0001f7e1\x00" +
+	":yellow_heart:\x00\U0001f49b\x00" +
+	":yellow_square:\x00\U0001f7e8\x00" +
+	":yen:\x00\U0001f4b4\x00" +
+	":yen_banknote:\x00\U0001f4b4\x00" +
+	":yin_yang:\x00\u262f\ufe0f\x00" +
+	":yo_yo:\x00\U0001fa80\x00" +
+	":yum:\x00\U0001f60b\x00" +
+	":zany_face:\x00\U0001f92a\x00" +
+	":zap:\x00\u26a1\x00" +
+	":zebra:\x00\U0001f993\x00" +
+	":zero:\x000\ufe0f\u20e3\x00" +
+	":zipper_mouth_face:\x00\U0001f910\x00" +
+	":zombie:\x00\U0001f9df\x00" +
+	":zombie_man:\x00\U0001f9df\u200d\u2642\ufe0f\x00" +
+	":zombie_woman:\x00\U0001f9df\u200d\u2640\ufe0f\x00" +
+	":zzz:\x00\U0001f4a4\x00"
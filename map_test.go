@@ -5,10 +5,10 @@ import (
 )
 
 func TestEmojiMap(t *testing.T) {
-	for name, code := range emojiMap {
+	emojiMap.each(func(name, code string) {
 		got := Replace(name)
 		if got != code {
 			t.Fatalf("test case %q fail: got: %v, expected: %v", name, got, code)
 		}
-	}
+	})
 }
@@ -0,0 +1,214 @@
+package emoji
+
+import (
+	"sync"
+	"unicode/utf8"
+)
+
+// Code points that take part in emoji sequences.
+const (
+	zeroWidthJoiner       = '\u200d'
+	textPresentation      = '\ufe0e'
+	emojiPresentation     = '\ufe0f'
+	combiningEnclosingKey = '\u20e3'
+	regionalIndicatorA    = '\U0001F1E6'
+	regionalIndicatorZ    = '\U0001F1FF'
+	tagFirst              = '\U000E0020'
+	tagLast               = '\U000E007E'
+	cancelTag             = '\U000E007F'
+)
+
+// Match describes an emoji found in a string.
+type Match struct {
+	// Code is the emoji as found in the string.
+	Code string
+	// Alias is the alias of the emoji, e.g. ":pizza:". It's empty if the emoji has no known alias.
+	Alias string
+	// Start and End are the byte offsets of the emoji in the string.
+	Start, End int
+}
+
+// runeKind describes how a rune may start an emoji sequence.
+type runeKind uint8
+
+const (
+	notEmoji     runeKind = iota
+	textDefault           // needs a variation selector, a tone or a joiner to be an emoji
+	emojiDefault          // is an emoji on its own
+)
+
+var (
+	emojiRunesOnce sync.Once
+	emojiRunes     map[rune]runeKind
+)
+
+// loadEmojiRunes collects the runes that start a known emoji sequence.
+func loadEmojiRunes() {
+	emojiRunes = make(map[rune]runeKind)
+	add := func(code string) {
+		r, size := utf8.DecodeRuneInString(code)
+		if isKeycapBase(r) || isRegionalIndicator(r) {
+			return
+		}
+		if size == len(code) {
+			emojiRunes[r] = emojiDefault
+		} else if emojiRunes[r] == notEmoji {
+			emojiRunes[r] = textDefault
+		}
+	}
+
+	reverseEmojiMap.each(func(code, _ string) {
+		add(code)
+	})
+	emojiMap.each(func(_, code string) {
+		add(code)
+	})
+}
+
+func kindOf(r rune) runeKind {
+	emojiRunesOnce.Do(loadEmojiRunes)
+	return emojiRunes[r]
+}
+
+// emojiLen returns the length in bytes of the emoji sequence at the beginning of s.
+// It returns 0 if s doesn't start with an emoji.
+func emojiLen(s string) int {
+	n := elementLen(s, false)
+	if n == 0 {
+		return 0
+	}
+
+	// join following elements with zero width joiners, e.g. 👩‍❤️‍👨
+	for {
+		r, size := utf8.DecodeRuneInString(s[n:])
+		if r != zeroWidthJoiner {
+			return n
+		}
+
+		next := elementLen(s[n+size:], true)
+		if next == 0 {
+			return n
+		}
+		n += size + next
+	}
+}
+
+// elementLen returns the length in bytes of a single emoji element at the beginning of s:
+// a keycap, a flag or an emoji with its optional variation selector, skin tone and tags.
+// Elements following a joiner don't need to be fully qualified.
+func elementLen(s string, joined bool) int {
+	r, n := utf8.DecodeRuneInString(s)
+
+	switch {
+	case isKeycapBase(r):
+		return keycapLen(s)
+	case isRegionalIndicator(r):
+		if next, size := utf8.DecodeRuneInString(s[n:]); isRegionalIndicator(next) {
+			return n + size
+		}
+		return 0
+	}
+
+	kind := kindOf(r)
+	if kind == notEmoji {
+		return 0
+	}
+	qualified := kind == emojiDefault || joined
+
+	next, size := utf8.DecodeRuneInString(s[n:])
+	switch {
+	case next == textPresentation:
+		return 0
+	case next == emojiPresentation, isToneRune(next):
+		n += size
+		qualified = true
+	}
+
+	// subdivision flags such as England's are black flags followed by tag characters
+	if tags := tagsLen(s[n:]); tags > 0 {
+		n += tags
+		qualified = true
+	}
+
+	if !qualified {
+		if next, _ := utf8.DecodeRuneInString(s[n:]); next != zeroWidthJoiner {
+			return 0
+		}
+	}
+
+	return n
+}
+
+// keycapLen returns the length in bytes of the keycap sequence at the beginning of s, e.g. 7️⃣.
+// It returns 0 if s doesn't start with a keycap.
+func keycapLen(s string) int {
+	r, n := utf8.DecodeRuneInString(s)
+	if !isKeycapBase(r) {
+		return 0
+	}
+
+	next, size := utf8.DecodeRuneInString(s[n:])
+	if next == emojiPresentation {
+		n += size
+		next, size = utf8.DecodeRuneInString(s[n:])
+	}
+	if next != combiningEnclosingKey {
+		return 0
+	}
+
+	return n + size
+}
+
+// tagsLen returns the length in bytes of the tag sequence at the beginning of s.
+// It returns 0 if s doesn't start with a terminated tag sequence.
+func tagsLen(s string) int {
+	n := 0
+	for {
+		r, size := utf8.DecodeRuneInString(s[n:])
+		switch {
+		case r >= tagFirst && r <= tagLast:
+			n += size
+		case r == cancelTag && n > 0:
+			return n + size
+		default:
+			return 0
+		}
+	}
+}
+
+// nextEmoji returns the byte offsets of the first emoji sequence in s starting from i.
+// If there is no emoji, it returns -1, -1.
+func nextEmoji(s string, i int) (start, end int) {
+	for i < len(s) {
+		if n := emojiLen(s[i:]); n > 0 {
+			return i, i + n
+		}
+		_, size := utf8.DecodeRuneInString(s[i:])
+		i += size
+	}
+
+	return -1, -1
+}
+
+// unitLen returns the length in bytes of the unit at the beginning of s
+// that can't be split: either an emoji sequence or a single rune.
+func unitLen(s string) int {
+	if n := emojiLen(s); n > 0 {
+		return n
+	}
+	_, size := utf8.DecodeRuneInString(s)
+
+	return size
+}
+
+func isKeycapBase(r rune) bool {
+	return r == '#' || r == '*' || (r >= '0' && r <= '9')
+}
+
+func isRegionalIndicator(r rune) bool {
+	return r >= regionalIndicatorA && r <= regionalIndicatorZ
+}
+
+func isToneRune(r rune) bool {
+	return r >= '\U0001F3FB' && r <= '\U0001F3FF'
+}
@@ -0,0 +1,32 @@
+package emoji
+
+import "testing"
+
+func TestEmojiLen(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want int
+	}{
+		{name: "plain text", in: "hello", want: 0},
+		{name: "simple emoji", in: "😀 hi", want: len("😀")},
+		{name: "variation selector", in: "❤️ you", want: len("❤️")},
+		{name: "text presentation", in: "❤︎ you", want: 0},
+		{name: "bare text default symbol", in: "© 2022", want: 0},
+		{name: "skin tone", in: "👍🏿!", want: len("👍🏿")},
+		{name: "zwj sequence", in: "👩🏽‍❤️‍💋‍👨🏿 kiss", want: len("👩🏽‍❤️‍💋‍👨🏿")},
+		{name: "trailing joiner", in: "👨‍", want: len("👨")},
+		{name: "flag", in: "🇹🇷🇺🇸", want: len("🇹🇷")},
+		{name: "lone regional indicator", in: "\U0001F1F9 tr", want: 0},
+		{name: "keycap", in: "7️⃣ seven", want: len("7️⃣")},
+		{name: "keycap without variation selector", in: "#⃣", want: len("#⃣")},
+		{name: "digit", in: "7 seven", want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := emojiLen(tt.in); got != tt.want {
+				t.Errorf("emojiLen() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,8 @@
+//go:build emoji_minimal
+// +build emoji_minimal
+
+package emoji
+
+// Names and keywords of emojis from CLDR are left out with the emoji_minimal build tag, like locales
+// and flags, so e.g. Name and Search don't find them. Aliases, reversed aliases, groups and versions are kept.
+var emojiAnnotations = map[string]annotation{}
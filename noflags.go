@@ -0,0 +1,18 @@
+//go:build emoji_noflags || emoji_minimal
+// +build emoji_noflags emoji_minimal
+
+package emoji
+
+// The data of flags is left out with the emoji_noflags build tag.
+// CountryFlag and :flag-XX: aliases still work without it.
+const (
+	aliasFlagData   = ""
+	reverseFlagData = ""
+	groupFlagData   = ""
+	versionFlagData = ""
+)
+
+var flagAnnotations map[string]annotation
+
+// Flags is empty with the emoji_noflags build tag, like GroupFlags.Emojis().
+var Flags []Emoji
@@ -1,16 +0,0 @@
-package emoji
-
-var NumberMap = map[string]bool{
-	"0": true,
-	"1": true,
-	"2": true,
-	"3": true,
-	"4": true,
-	"5": true,
-	"6": true,
-	"7": true,
-	"8": true,
-	"9": true,
-	"*": true,
-	"#": true,
-}
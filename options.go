@@ -0,0 +1,337 @@
+package emoji
+
+import (
+	"bytes"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+	"unsafe"
+)
+
+// ReplacerOption configures a Replacer.
+type ReplacerOption func(*Replacer)
+
+// SkipCodeSpans leaves aliases inside Markdown code spans (`:smile:`) as they are.
+func SkipCodeSpans() ReplacerOption {
+	return func(p *Replacer) {
+		p.skipCodeSpans = true
+	}
+}
+
+// SkipFencedBlocks leaves aliases inside Markdown fenced code blocks (```) as they are.
+func SkipFencedBlocks() ReplacerOption {
+	return func(p *Replacer) {
+		p.skipFencedBlocks = true
+	}
+}
+
+// SkipURLs leaves aliases inside URLs (http://host:8080:) as they are.
+func SkipURLs() ReplacerOption {
+	return func(p *Replacer) {
+		p.skipURLs = true
+	}
+}
+
+// RequireWordBoundary replaces only aliases which are not preceded or followed by
+// a letter, a digit or an underscore, e.g. `:smile:` in `a:smile:b` is left as it is.
+func RequireWordBoundary() ReplacerOption {
+	return func(p *Replacer) {
+		p.wordBoundary = true
+	}
+}
+
+// WithDelimiters sets the opening and closing delimiters of aliases, e.g. "{{emoji:" and "}}".
+// Aliases are written between ":" by default.
+func WithDelimiters(open, close string) ReplacerOption {
+	return func(p *Replacer) {
+		p.open = open
+		p.close = close
+	}
+}
+
+// IgnoreCase matches aliases case-insensitively, e.g. `:Smile:` is `:smile:`.
+func IgnoreCase() ReplacerOption {
+	return func(p *Replacer) {
+		p.ignoreCase = true
+	}
+}
+
+// FoldSeparators treats `-` and `_` in aliases as equivalent, e.g. `:thumbs-up:` is `:thumbs_up:`.
+func FoldSeparators() ReplacerOption {
+	return func(p *Replacer) {
+		p.foldSeparators = true
+	}
+}
+
+// WithLocale replaces the localized aliases of the language too, e.g. `:daumen_hoch:` for "de".
+// Localized aliases are made of the localized names of emojis. See Locales for the available languages.
+func WithLocale(lang string) ReplacerOption {
+	return func(p *Replacer) {
+		p.locale = findLocale(lang)
+	}
+}
+
+// WithMaxVersion replaces only the aliases of emojis introduced in the v Emoji version or before,
+// e.g. to avoid sending emojis that older systems can't render. Other aliases are unknown.
+func WithMaxVersion(v Version) ReplacerOption {
+	return func(p *Replacer) {
+		p.maxVersion = v
+	}
+}
+
+// UnknownMode defines what a Replacer does with unknown aliases.
+type UnknownMode int
+
+// Unknown alias modes
+const (
+	KeepUnknown   UnknownMode = iota // leave unknown aliases as they are
+	StripUnknown                     // remove unknown aliases
+	FailOnUnknown                    // leave unknown aliases and report them with ReplaceStrict
+)
+
+// OnUnknown sets what the Replacer does with unknown aliases.
+// Only names having a letter and no punctuation other than `_`, `-` and `+` are considered aliases,
+// so times like 10:30:45 are left as they are. Use with RequireWordBoundary for the best results.
+func OnUnknown(mode UnknownMode) ReplacerOption {
+	return func(p *Replacer) {
+		p.unknown = mode
+	}
+}
+
+// delimiters returns the opening and closing delimiters of aliases.
+func (p *Replacer) delimiters() (string, string) {
+	if p.open == "" || p.close == "" {
+		return ":", ":"
+	}
+
+	return p.open, p.close
+}
+
+// aliasEnd returns the offset of the closing delimiter of the alias name starting at i.
+// It returns -1 if the name is empty or it has a space, an escaped delimiter or another opening delimiter.
+func (p *Replacer) aliasEnd(input string, i int) int {
+	open, close := p.delimiters()
+	for n := i; n < len(input); {
+		if strings.HasPrefix(input[n:], close) {
+			if n == i {
+				return -1
+			}
+			return n
+		}
+		if open != close && strings.HasPrefix(input[n:], open) {
+			return -1
+		}
+
+		r, size := utf8.DecodeRuneInString(input[n:])
+		if unicode.IsSpace(r) || r == '\\' {
+			return -1
+		}
+		n += size
+	}
+
+	return -1
+}
+
+// find returns the code of the emoji by its name, e.g. "pizza", and the name variation it's found with.
+func (p *Replacer) find(name string) (found, code string, ok bool) {
+	candidates := []string{name}
+	if p.ignoreCase {
+		candidates = append(candidates, strings.ToLower(name))
+	}
+	if p.foldSeparators {
+		for _, c := range candidates {
+			candidates = append(candidates, strings.ReplaceAll(c, "-", "_"), strings.ReplaceAll(c, "_", "-"))
+		}
+	}
+
+	for _, c := range candidates {
+		p.matched.Reset()
+		p.matched.WriteByte(':')
+		p.matched.WriteString(c)
+		p.matched.WriteByte(':')
+
+		if code, ok := Find(unsafeString(&p.matched)); ok && p.allowed(code) {
+			return c, code, true
+		}
+		if p.locale != nil {
+			if code, ok := p.locale.aliases[unsafeString(&p.matched)]; ok && p.allowed(code) {
+				return c, code, true
+			}
+		}
+	}
+
+	return "", "", false
+}
+
+// unsafeString returns the contents of the buffer without copying them.
+// The string must not be used after the buffer is changed.
+func unsafeString(matched *bytes.Buffer) string {
+	buf := matched.Bytes()
+	return *(*string)(unsafe.Pointer(&buf))
+}
+
+// allowed checks whether the emoji is not newer than the maximum version.
+func (p *Replacer) allowed(code string) bool {
+	return p.maxVersion.IsZero() || !Since(code).After(p.maxVersion)
+}
+
+// isUnknownAlias checks whether name looks like an alias, so it can be treated as an unknown one.
+func isUnknownAlias(name string) bool {
+	letter := false
+	for _, r := range name {
+		switch {
+		case unicode.IsLetter(r):
+			letter = true
+		case unicode.IsDigit(r), r == '_', r == '-', r == '+':
+		default:
+			return false
+		}
+	}
+
+	return letter
+}
+
+// protectedLen returns the length in bytes of the part of the input starting at i
+// that must be left as it is. It returns 0 if there is no such part.
+func (p *Replacer) protectedLen(input string, i int) int {
+	switch c := input[i]; {
+	case (c == '`' || c == '~') && p.skipFencedBlocks:
+		if n := fencedBlockLen(input, i); n > 0 {
+			return n
+		}
+		if c == '`' && p.skipCodeSpans {
+			return codeSpanLen(input, i)
+		}
+	case c == '`' && p.skipCodeSpans:
+		return codeSpanLen(input, i)
+	case isASCIILetter(c) && p.skipURLs:
+		return urlLen(input, i)
+	}
+
+	return 0
+}
+
+// atWordBoundary checks whether the alias between start and end offsets is on word boundaries.
+func (p *Replacer) atWordBoundary(input string, start, end int) bool {
+	if !p.wordBoundary {
+		return true
+	}
+
+	before, _ := utf8.DecodeLastRuneInString(input[:start])
+	after, _ := utf8.DecodeRuneInString(input[end:])
+
+	return !isWordRune(before) && !isWordRune(after)
+}
+
+// fencedBlockLen returns the length in bytes of the Markdown fenced code block starting at i,
+// including its closing fence line. An unclosed block lasts until the end of the input.
+func fencedBlockLen(input string, i int) int {
+	if i > 0 && input[i-1] != '\n' {
+		return 0
+	}
+
+	fence := fenceLen(input[i:])
+	if fence == 0 {
+		return 0
+	}
+
+	marker := input[i : i+fence]
+	for n := strings.IndexByte(input[i:], '\n'); n >= 0; {
+		line := input[i+n+1:]
+		if strings.HasPrefix(line, marker) {
+			end := strings.IndexByte(line, '\n')
+			if end < 0 {
+				return len(input) - i
+			}
+			return n + 1 + end + 1
+		}
+
+		next := strings.IndexByte(line, '\n')
+		if next < 0 {
+			break
+		}
+		n += next + 1
+	}
+
+	return len(input) - i
+}
+
+// fenceLen returns the length of the fence (``` or ~~~) at the beginning of s.
+func fenceLen(s string) int {
+	if s == "" || (s[0] != '`' && s[0] != '~') {
+		return 0
+	}
+
+	n := 0
+	for n < len(s) && s[n] == s[0] {
+		n++
+	}
+	if n < 3 {
+		return 0
+	}
+
+	return n
+}
+
+// codeSpanLen returns the length in bytes of the Markdown code span starting at i.
+// It returns 0 if there is no code span, e.g. the backticks are not closed.
+func codeSpanLen(input string, i int) int {
+	if i > 0 && input[i-1] == '`' {
+		return 0
+	}
+
+	ticks := 0
+	for i+ticks < len(input) && input[i+ticks] == '`' {
+		ticks++
+	}
+
+	for n := i + ticks; n < len(input); {
+		open := strings.IndexByte(input[n:], '`')
+		if open < 0 {
+			return 0
+		}
+
+		n += open
+		run := 0
+		for n+run < len(input) && input[n+run] == '`' {
+			run++
+		}
+		if run == ticks {
+			return n + run - i
+		}
+		n += run
+	}
+
+	return 0
+}
+
+// urlLen returns the length in bytes of the URL (scheme://...) starting at i.
+// The URL lasts until the next whitespace.
+func urlLen(input string, i int) int {
+	if before, _ := utf8.DecodeLastRuneInString(input[:i]); i > 0 && isWordRune(before) {
+		return 0
+	}
+
+	n := i
+	for n < len(input) && (isASCIILetter(input[n]) || strings.IndexByte("0123456789+.-", input[n]) >= 0) {
+		n++
+	}
+	if !strings.HasPrefix(input[n:], "://") {
+		return 0
+	}
+
+	end := strings.IndexFunc(input[n:], unicode.IsSpace)
+	if end < 0 {
+		return len(input) - i
+	}
+
+	return n + end - i
+}
+
+func isASCIILetter(c byte) bool {
+	return ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z')
+}
+
+func isWordRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
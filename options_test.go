@@ -0,0 +1,233 @@
+package emoji
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestReplacerOptions(t *testing.T) {
+	tt := []struct {
+		name     string
+		opts     []ReplacerOption
+		input    string
+		expected string
+	}{
+		{
+			name:     "no options",
+			input:    "`:smile:` http://host:smile: a:smile:b",
+			expected: fmt.Sprintf("`%v` http://host%v a%vb", GrinningFaceWithSmilingEyes, GrinningFaceWithSmilingEyes, GrinningFaceWithSmilingEyes),
+		},
+		{
+			name:     "code spans",
+			opts:     []ReplacerOption{SkipCodeSpans()},
+			input:    "run `echo :smile:` or ``a `:smile:` b`` :smile:",
+			expected: fmt.Sprintf("run `echo :smile:` or ``a `:smile:` b`` %v", GrinningFaceWithSmilingEyes),
+		},
+		{
+			name:     "unclosed code span",
+			opts:     []ReplacerOption{SkipCodeSpans()},
+			input:    "a `b :smile:",
+			expected: fmt.Sprintf("a `b %v", GrinningFaceWithSmilingEyes),
+		},
+		{
+			name:     "fenced blocks",
+			opts:     []ReplacerOption{SkipFencedBlocks()},
+			input:    "look :eyes:\n```go\nx := m[:smile:]\n```\ndone :tada:",
+			expected: fmt.Sprintf("look %v\n```go\nx := m[:smile:]\n```\ndone %v", Eyes, PartyPopper),
+		},
+		{
+			name:     "unclosed fenced block",
+			opts:     []ReplacerOption{SkipFencedBlocks()},
+			input:    ":eyes:\n~~~\n:smile:",
+			expected: fmt.Sprintf("%v\n~~~\n:smile:", Eyes),
+		},
+		{
+			name:     "urls",
+			opts:     []ReplacerOption{SkipURLs()},
+			input:    "see http://host:8080:smile: :smile:",
+			expected: fmt.Sprintf("see http://host:8080:smile: %v", GrinningFaceWithSmilingEyes),
+		},
+		{
+			name:     "word boundary",
+			opts:     []ReplacerOption{RequireWordBoundary()},
+			input:    "a:smile:b (:smile:) :pizza::sushi:",
+			expected: fmt.Sprintf("a:smile:b (%v) %v%v", GrinningFaceWithSmilingEyes, Pizza, Sushi),
+		},
+		{
+			name:     "escaped alias",
+			input:    `\:smile: and :smile: and :a\:smile:`,
+			expected: fmt.Sprintf(":smile: and %v and :a:smile:", GrinningFaceWithSmilingEyes),
+		},
+		{
+			name:     "escaped alias before an alias",
+			input:    `\:smile:joy: \:smile::joy:`,
+			expected: fmt.Sprintf(":smile:joy: :smile:%v", FaceWithTearsOfJoy),
+		},
+		{
+			name:     "escaped backslash",
+			input:    `\\:smile: \\\:smile: C:\\dir\\:`,
+			expected: fmt.Sprintf(`\%v \:smile: C:\\dir\:`, GrinningFaceWithSmilingEyes),
+		},
+		{
+			name:     "escaped delimiters of an invalid alias",
+			input:    `\:not valid:`,
+			expected: ":not valid:",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			got := NewReplacer(tc.opts...).Replace(tc.input)
+			if got != tc.expected {
+				t.Fatalf("test case %v fail: got: %v, expected: %v", tc.name, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestReplacerOptionsEmoticons(t *testing.T) {
+	var (
+		input    = "`:)` :) http://host/:( :("
+		expected = fmt.Sprintf("`:)` %v http://host/:( %v", SlightlySmilingFace, SlightlyFrowningFace)
+	)
+
+	got := NewReplacer(SkipCodeSpans(), SkipURLs()).ReplaceEmoticons(input)
+	if got != expected {
+		t.Fatalf("test case fail: got: %v, expected: %v", got, expected)
+	}
+}
+
+func TestReplacerAliasOptions(t *testing.T) {
+	tt := []struct {
+		name     string
+		opts     []ReplacerOption
+		input    string
+		expected string
+	}{
+		{
+			name:     "template delimiters",
+			opts:     []ReplacerOption{WithDelimiters("{{emoji:", "}}")},
+			input:    "hi {{emoji:wave}} :smile: {{emoji:not_exist}} {{emoji:}}",
+			expected: fmt.Sprintf("hi %v :smile: {{emoji:not_exist}} {{emoji:}}", WavingHand),
+		},
+		{
+			name:     "bracket delimiters",
+			opts:     []ReplacerOption{WithDelimiters("[", "]")},
+			input:    "[[pizza]] [sushi][no emoji] [flag-tr]",
+			expected: fmt.Sprintf("[%v] %v[no emoji] %v", Pizza, Sushi, "\U0001F1F9\U0001F1F7"),
+		},
+		{
+			name:     "escaped delimiter",
+			opts:     []ReplacerOption{WithDelimiters("[", "]")},
+			input:    `\[pizza] [pizza]`,
+			expected: fmt.Sprintf("[pizza] %v", Pizza),
+		},
+		{
+			name:     "case sensitive",
+			input:    ":Pizza: :SUSHI:",
+			expected: ":Pizza: :SUSHI:",
+		},
+		{
+			name:     "ignore case",
+			opts:     []ReplacerOption{IgnoreCase()},
+			input:    ":Pizza: :SUSHI: :Flag-TR:",
+			expected: fmt.Sprintf("%v %v %v", Pizza, Sushi, "\U0001F1F9\U0001F1F7"),
+		},
+		{
+			name:     "fold separators",
+			opts:     []ReplacerOption{FoldSeparators()},
+			input:    ":thumbs-up: :flag-tr: :party-popper:",
+			expected: fmt.Sprintf("%v %v %v", ThumbsUp, "\U0001F1F9\U0001F1F7", PartyPopper),
+		},
+		{
+			name:     "ignore case and fold separators",
+			opts:     []ReplacerOption{IgnoreCase(), FoldSeparators()},
+			input:    ":Thumbs-Up:",
+			expected: ThumbsUp.String(),
+		},
+		{
+			name:     "strip unknown",
+			opts:     []ReplacerOption{OnUnknown(StripUnknown)},
+			input:    "a :not_exist_emoji: b :pizza: at 10:30:45",
+			expected: fmt.Sprintf("a  b %v at 10:30:45", Pizza),
+		},
+		{
+			name:     "strip unknown with delimiters",
+			opts:     []ReplacerOption{WithDelimiters("{{emoji:", "}}"), OnUnknown(StripUnknown)},
+			input:    "a{{emoji:not_exist}}b",
+			expected: "ab",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			got := NewReplacer(tc.opts...).Replace(tc.input)
+			if got != tc.expected {
+				t.Fatalf("test case %v fail: got: %v, expected: %v", tc.name, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestReplacerReplaceStrict(t *testing.T) {
+	tt := []struct {
+		name     string
+		opts     []ReplacerOption
+		input    string
+		expected string
+		err      bool
+	}{
+		{
+			name:     "keep unknown",
+			input:    ":pizza: :not_exist_emoji:",
+			expected: fmt.Sprintf("%v :not_exist_emoji:", Pizza),
+		},
+		{
+			name:     "fail on unknown",
+			opts:     []ReplacerOption{OnUnknown(FailOnUnknown)},
+			input:    ":pizza: :not_exist_emoji:",
+			expected: fmt.Sprintf("%v :not_exist_emoji:", Pizza),
+			err:      true,
+		},
+		{
+			name:     "fail on unknown without unknown aliases",
+			opts:     []ReplacerOption{OnUnknown(FailOnUnknown)},
+			input:    ":pizza: at 10:30:45",
+			expected: fmt.Sprintf("%v at 10:30:45", Pizza),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := NewReplacer(tc.opts...).ReplaceStrict(tc.input)
+			if (err != nil) != tc.err {
+				t.Fatalf("test case %v fail: got: %v, expected: %v", tc.name, err, tc.err)
+			}
+			if got != tc.expected {
+				t.Fatalf("test case %v fail: got: %v, expected: %v", tc.name, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestReplacerSuggestionDelimiters(t *testing.T) {
+	tt := []struct {
+		opts     []ReplacerOption
+		input    string
+		expected string
+	}{
+		{input: ":nope:", expected: ":nose:"},
+		{opts: []ReplacerOption{WithDelimiters("[", "]")}, input: "[nope]", expected: "[nose]"},
+		{opts: []ReplacerOption{WithDelimiters("{{emoji:", "}}")}, input: "{{emoji:nope}}", expected: "{{emoji:nose}}"},
+	}
+
+	for i, tc := range tt {
+		t.Run(fmt.Sprintf("test #%d", i), func(t *testing.T) {
+			_, err := NewReplacer(append(tc.opts, OnUnknown(FailOnUnknown))...).ReplaceStrict(tc.input)
+			errs, ok := err.(UnknownAliasesError)
+			if !ok || len(errs) != 1 || errs[0].Suggestion != tc.expected {
+				t.Fatalf("test case %v fail: got: %v, expected suggestion: %v", i+1, err, tc.expected)
+			}
+		})
+	}
+}
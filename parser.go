@@ -7,38 +7,145 @@ import (
 	"strings"
 	"unicode"
 	"unicode/utf8"
-	"unsafe"
 )
 
 var (
 	flagRegex = regexp.MustCompile(`^:flag-([a-zA-Z]{2}):$`)
-	// numRegex  = regexp.MustCompile(`\x{FE0F}|\x{20E3}`) // match digits based on unique sequence
-	// numRegex = regexp.MustCompile(`\x{FE0F}|\x{20E3}|(?i)20E3|(?i)FE0F`) // case insensitive
-	// numRegex = regexp.MustCompile(`[0-9-]\x{FE0F}|\x{20E3}|(?i)20E3|(?i)FE0F`)
-	// numRegex = regexp.MustCompile(`(?P<digit>\d)(\x{FE0F}|\x{20E3}|(?i)20E3|(?i)FE0F<other>)`) //
-	// numRegex = regexp.MustCompile(`(?P<digit>\d)(\x{FE0F}\x{20E3}|(?i)20E3|(?i)FE0F<other>)`)        // named :match any digit emoji
-	numRegex    = regexp.MustCompile(`(?P<digit>\*|\#|\d)(\x{FE0F}\x{20E3}|(?i)20E3|(?i)FE0F<other>)`) // named: match any digit emoji and #️⃣*️⃣
-	joinerRegex = regexp.MustCompile(`(?i)\x{200d}`)                                                   // for complex emojis such as 👩🏾‍❤️‍👨🏿 which ias actually an amagam of 3 emojis 🧔🏿‍♀️,❤️, and  with "\u200d" inbetween the characters👨🏿
-	toneRegex   = regexp.MustCompile(`\x{1F3FB}|\x{1F3FC}|\x{1F3FC}|\x{1F3FD}|\x{1F3FE}|\x{1F3FF}`)
+	toneRegex = regexp.MustCompile(`\x{1F3FB}|\x{1F3FC}|\x{1F3FC}|\x{1F3FD}|\x{1F3FE}|\x{1F3FF}`)
 )
 
+// Replacer replaces emoji aliases with a reusable buffer and its options.
 type Replacer struct {
+	// matched is the alias which is looked up, reused by find for each name variation.
 	matched bytes.Buffer
+
+	skipCodeSpans    bool
+	skipFencedBlocks bool
+	skipURLs         bool
+	wordBoundary     bool
+	open, close      string
+	ignoreCase       bool
+	foldSeparators   bool
+	unknown          UnknownMode
+	locale           *locale
+	maxVersion       Version
 }
 
-func NewReplacer() *Replacer {
-	return &Replacer{matched: bytes.Buffer{}}
+// NewReplacer constructs a new Replacer with the given options.
+func NewReplacer(opts ...ReplacerOption) *Replacer {
+	p := &Replacer{matched: bytes.Buffer{}}
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	return p
 }
 
 // Replace replaces emoji aliases (:pizza:) with unicode representation.
 func (p *Replacer) Replace(input string) string {
-	p.matched.Reset()
-	return replaceInternal(input, &p.matched)
+	return p.ReplaceFunc(input, replaceWithCode)
+}
+
+// ReplaceFunc replaces emoji aliases (:pizza:) with the return value of repl.
+func (p *Replacer) ReplaceFunc(input string, repl func(alias, code string) string) string {
+	output, _ := replaceInternal(input, p, repl)
+	return output
+}
+
+// ReplaceStrict replaces emoji aliases (:pizza:) with unicode representation.
+// If the Replacer fails on unknown aliases, it returns an UnknownAliasesError listing them.
+func (p *Replacer) ReplaceStrict(input string) (string, error) {
+	return replaceInternal(input, p, replaceWithCode)
 }
 
 // Replace replaces emoji aliases (:pizza:) with unicode representation.
 func Replace(input string) string {
-	return replaceInternal(input, &bytes.Buffer{})
+	output, _ := replaceInternal(input, &Replacer{}, replaceWithCode)
+	return output
+}
+
+// ReplaceFunc replaces emoji aliases (:pizza:) with the return value of repl.
+// repl is called with the alias and the unicode representation of the emoji.
+func ReplaceFunc(input string, repl func(alias, code string) string) string {
+	output, _ := replaceInternal(input, &Replacer{}, repl)
+	return output
+}
+
+// ReplaceStrict replaces emoji aliases (:pizza:) with unicode representation.
+// It returns an UnknownAliasesError listing every unknown alias.
+func ReplaceStrict(input string) (string, error) {
+	return NewReplacer(OnUnknown(FailOnUnknown)).ReplaceStrict(input)
+}
+
+// Unknown returns the unknown emoji aliases in the input, in order of appearance and without duplicates.
+func Unknown(input string) []string {
+	aliases := make([]string, 0)
+
+	_, err := ReplaceStrict(input)
+	if errs, ok := err.(UnknownAliasesError); ok {
+		seen := make(map[string]bool)
+		for _, e := range errs {
+			if !seen[e.Alias] {
+				seen[e.Alias] = true
+				aliases = append(aliases, e.Alias)
+			}
+		}
+	}
+
+	return aliases
+}
+
+// UnknownAliasError reports an unknown emoji alias.
+type UnknownAliasError struct {
+	// Alias is the unknown alias with its delimiters, e.g. ":thumbsup_:".
+	Alias string
+	// Offset is the byte offset of the alias in the input.
+	Offset int
+	// Suggestion is the most similar known alias with the delimiters of the Replacer, e.g. ":thumbsup:".
+	// It's empty if there is no similar alias.
+	Suggestion string
+}
+
+func (e *UnknownAliasError) Error() string {
+	if e.Suggestion != "" {
+		return fmt.Sprintf("unknown emoji alias %q at offset %d, did you mean %q?", e.Alias, e.Offset, e.Suggestion)
+	}
+
+	return fmt.Sprintf("unknown emoji alias %q at offset %d", e.Alias, e.Offset)
+}
+
+// UnknownAliasesError lists unknown emoji aliases.
+type UnknownAliasesError []*UnknownAliasError
+
+func (e UnknownAliasesError) Error() string {
+	msgs := make([]string, 0, len(e))
+	for _, err := range e {
+		msgs = append(msgs, err.Error())
+	}
+
+	return strings.Join(msgs, "; ")
+}
+
+// ReplaceEmojiFunc replaces emojis (🍕) with the return value of repl.
+func ReplaceEmojiFunc(input string, repl func(m Match) string) string {
+	var output strings.Builder
+	output.Grow(len(input))
+
+	for i := 0; i < len(input); {
+		start, end := nextEmoji(input, i)
+		if start < 0 {
+			output.WriteString(input[i:])
+			break
+		}
+
+		code := input[start:end]
+		alias, _ := FindReverse(code)
+		output.WriteString(input[i:start])
+		output.WriteString(repl(Match{Code: code, Alias: alias, Start: start, End: end}))
+		i = end
+	}
+
+	return output.String()
 }
 
 // Parse is an alias for Replace
@@ -46,76 +153,204 @@ func Parse(input string) string {
 	return Replace(input)
 }
 
-// replaceInternal replaces emoji aliases (:pizza:) with unicode representation.
-func replaceInternal(input string, matched *bytes.Buffer) string {
+// ReplaceEmoticons replaces ASCII emoticons (:-D) with unicode representation.
+func (p *Replacer) ReplaceEmoticons(input string) string {
+	return replaceEmoticons(input, p)
+}
+
+// ReplaceEmoticons replaces ASCII emoticons (:-D) with unicode representation.
+// Emoticons must be separated from other text by whitespace or trailing punctuation,
+// so times (12:30) and URLs (http://host) are left as they are.
+func ReplaceEmoticons(input string) string {
+	return replaceEmoticons(input, &Replacer{})
+}
+
+// ToEmoticons replaces emojis which have an ASCII emoticon (🙂) with the emoticon.
+func ToEmoticons(input string) string {
+	return ReplaceEmojiFunc(input, func(m Match) string {
+		if emoticon, ok := reverseEmoticonMap[m.Code]; ok {
+			return emoticon
+		}
+		return m.Code
+	})
+}
+
+// replaceEmoticons replaces ASCII emoticons (:-D) with unicode representation.
+func replaceEmoticons(input string, p *Replacer) string {
 	var output strings.Builder
 	output.Grow(len(input))
 
-	for _, r := range input {
-		// when it's not `:`, it might be inner or outer of the emoji alias
-		if r != ':' {
-			// if matched is empty, it's the outer of the emoji alias
-			if matched.Len() == 0 {
-				output.WriteRune(r)
+	afterSpace := true
+	for i := 0; i < len(input); {
+		if n := p.protectedLen(input, i); n > 0 {
+			output.WriteString(input[i : i+n])
+			afterSpace = false
+			i += n
+			continue
+		}
+
+		if afterSpace {
+			if emoticon, code := matchEmoticon(input[i:]); emoticon != "" && p.allowed(code) {
+				output.WriteString(code)
+				i += len(emoticon)
+				afterSpace = false
 				continue
 			}
+		}
 
-			matched.WriteRune(r)
+		r, size := utf8.DecodeRuneInString(input[i:])
+		output.WriteString(input[i : i+size])
+		afterSpace = unicode.IsSpace(r)
+		i += size
+	}
+
+	return output.String()
+}
 
-			// if it's space, the alias's not valid.
-			// reset matched for breaking the emoji alias
-			if unicode.IsSpace(r) {
-				output.WriteString(unsafeString(matched))
-				matched.Reset()
+// matchEmoticon returns the longest emoticon at the beginning of s and its code.
+// The emoticon must be followed by whitespace, punctuation or the end of s.
+func matchEmoticon(s string) (emoticon, code string) {
+	end := strings.IndexFunc(s, unicode.IsSpace)
+	if end < 0 {
+		end = len(s)
+	}
+
+	for n := end; n > 0; n-- {
+		code, ok := emoticonMap[s[:n]]
+		if !ok {
+			continue
+		}
+
+		rest := s[n:end]
+		if rest == "" || strings.Trim(rest, ".,!?;") == "" {
+			return s[:n], code
+		}
+	}
+
+	return "", ""
+}
+
+func replaceWithCode(_, code string) string {
+	return code
+}
+
+// replaceInternal replaces emoji aliases (:pizza:) with the return value of repl.
+func replaceInternal(input string, p *Replacer, repl func(alias, code string) string) (string, error) {
+	var output strings.Builder
+	output.Grow(len(input))
+
+	var unknown UnknownAliasesError
+	open, close := p.delimiters()
+
+	for i := 0; i < len(input); {
+		// skip the parts that must be left as they are, such as code
+		if n := p.protectedLen(input, i); n > 0 {
+			output.WriteString(input[i : i+n])
+			i += n
+			continue
+		}
+
+		// backslashes before a delimiter escape each other, and an odd one escapes the alias, which is
+		// copied with its delimiters, so they are never the beginning or the end of another alias
+		if input[i] == '\\' {
+			n := i
+			for n < len(input) && input[n] == '\\' {
+				n++
+			}
+			if !strings.HasPrefix(input[n:], open) {
+				output.WriteString(input[i:n])
+				i = n
+				continue
+			}
+
+			escaped := (n-i)%2 == 1
+			output.WriteString(input[i : i+(n-i)/2])
+			i = n
+			if !escaped {
+				continue
+			}
+
+			if end := p.aliasEnd(input, i+len(open)); end >= 0 {
+				output.WriteString(input[i : end+len(close)])
+				i = end + len(close)
+				continue
 			}
+			output.WriteString(open)
+			i += len(open)
+			continue
+		}
+
+		// when it's not the opening delimiter, it's the outer of the emoji alias
+		if !strings.HasPrefix(input[i:], open) {
+			_, size := utf8.DecodeRuneInString(input[i:])
+			output.WriteString(input[i : i+size])
+			i += size
 			continue
 		}
 
-		// r is `:` now
-		// if matched is empty, it's the beginning of the emoji alias
-		if matched.Len() == 0 {
-			matched.WriteByte(':')
+		end := p.aliasEnd(input, i+len(open))
+		if end < 0 {
+			// the alias's not valid, e.g. it has a space
+			_, size := utf8.DecodeRuneInString(input[i:])
+			output.WriteString(input[i : i+size])
+			i += size
 			continue
 		}
 
-		// it's the end of the emoji alias
-		match := unsafeString(matched)
-		matched.WriteByte(':')
-		alias := unsafeString(matched)
+		name := input[i+len(open) : end]
+		next := end + len(close)
+		boundary := p.atWordBoundary(input, i, next)
 
 		// check for emoji alias
-		if code, ok := Find(alias); ok {
-			output.WriteString(code)
-			matched.Reset()
+		if found, code, ok := p.find(name); ok && boundary {
+			alias := input[i:next]
+			if found != name || open != ":" || close != ":" {
+				alias = ":" + found + ":"
+			}
+			output.WriteString(repl(alias, code))
+			i = next
 			continue
 		}
 
+		if boundary && isUnknownAlias(name) {
+			if p.unknown == FailOnUnknown {
+				unknown = append(unknown, &UnknownAliasError{Alias: input[i:next], Offset: i, Suggestion: p.suggestion(name)})
+			}
+			if p.unknown == StripUnknown {
+				i = next
+				continue
+			}
+		}
+
 		// not found any emoji
-		output.WriteString(match)
-		// it might be the beginning of the another emoji alias
-		matched.Reset()
-		matched.WriteByte(':')
+		// when delimiters are the same, the closing one might be the beginning of the another emoji alias
+		if open == close {
+			output.WriteString(input[i:end])
+			i = end
+			continue
+		}
+		output.WriteString(input[i:next])
+		i = next
 	}
 
-	// if matched not empty, add it to output
-	if matched.Len() != 0 {
-		output.WriteString(unsafeString(matched))
-		matched.Reset()
+	if len(unknown) > 0 {
+		return output.String(), unknown
 	}
 
-	return output.String()
+	return output.String(), nil
 }
 
 // Map returns the emojis map.
 // Key is the alias of the emoji.
 // Value is the code of the emoji.
 func Map() map[string]string {
-	return emojiMap
+	return emojiMap.toMap()
 }
 
 // AppendAlias adds new emoji pair to the emojis map.
 func AppendAlias(alias, code string) error {
-	if c, ok := emojiMap[alias]; ok {
+	m := emojiMap.toMap()
+	if c, ok := m[alias]; ok {
 		return fmt.Errorf("emoji already exist: %q => %+q", alias, c)
 	}
 
@@ -125,7 +360,32 @@ func AppendAlias(alias, code string) error {
 		}
 	}
 
-	emojiMap[alias] = code
+	m[alias] = code
+
+	return nil
+}
+
+// Emoticons returns the emoticons map.
+// Key is the ASCII emoticon.
+// Value is the code of the emoji.
+func Emoticons() map[string]string {
+	return emoticonMap
+}
+
+// AppendEmoticon adds new emoticon pair to the emoticons map.
+func AppendEmoticon(emoticon, code string) error {
+	if c, ok := emoticonMap[emoticon]; ok {
+		return fmt.Errorf("emoticon already exist: %q => %+q", emoticon, c)
+	}
+
+	if emoticon == "" || strings.IndexFunc(emoticon, unicode.IsSpace) >= 0 {
+		return fmt.Errorf("emoticon is not valid: %q", emoticon)
+	}
+
+	emoticonMap[emoticon] = code
+	if _, ok := reverseEmoticonMap[code]; !ok {
+		reverseEmoticonMap[code] = emoticon
+	}
 
 	return nil
 }
@@ -139,7 +399,7 @@ func Exist(alias string) bool {
 
 // Find returns the emoji code by alias.
 func Find(alias string) (string, bool) {
-	if code, ok := emojiMap[alias]; ok {
+	if code, ok := emojiMap.find(alias); ok {
 		return code, true
 	}
 
@@ -167,68 +427,45 @@ func checkFlag(alias string) string {
 // 	return ""
 // }
 
-func unsafeString(matched *bytes.Buffer) string {
-	buf := matched.Bytes()
-	return *(*string)(unsafe.Pointer(&buf))
-}
+// Deparse replaces emojis with their aliases (:pizza:). Skin tones are removed.
 func Deparse(in string) string {
-	var cRunes []rune
-	var output strings.Builder
 	in = toneRegex.ReplaceAllString(in, "") // strip tones away
-	for len(in) > 0 {
-		// dealing with a number emoji is convoluted
-		result := ReplaceAllStringSubmatchFunc(numRegex, in, func(groups []string) string {
-			//groups[1] is the digit portion of an emoji
-			// E.g 7️⃣ is represented as "7\ufe0f\u20e3", the regex seperates 7 out and discards the rest (groups[2])
-			// groups[0] is the original emoji. in this exapmle 7️⃣
-			return reverseEmojiMap[groups[0]]
-		})
-		in = result
-
-		r, size := utf8.DecodeRuneInString(in)
-		cRunes = append(cRunes, r)
-		c := string(cRunes)
-
-		if alias, ok := reverseEmojiMap[c]; ok && !NumberMap[c] {
-			// Found alias
-			normalizedStr := normalizedString(in)
-			lge, s := longestEmoji(normalizedStr)
-			if lge != "" {
-				output.WriteString(lge)
-				size = s
-			} else {
-				output.WriteString(alias)
-			}
-			// Reset current rune
-			cRunes = nil
 
-		}
-		if s := RunesToHexKey([]rune{r}); len(s) >= 4 {
-			in = in[size:]
-			continue
+	return ReplaceEmojiFunc(in, func(m Match) string {
+		if m.Alias != "" {
+			return m.Alias
 		}
 
-		// Flush cRunes if any
-		if len(cRunes) > 0 {
-			output.WriteString(string(cRunes))
-			cRunes = nil
+		// unknown combination of emojis, e.g. 👨‍👩‍👧‍🧑
+		if alias, size := longestEmoji(m.Code); alias != "" {
+			return alias + Deparse(m.Code[size:])
 		}
-		in = in[size:]
-	}
-	return output.String()
+
+		return m.Code
+	})
 }
 
 // ReversedMap returns the reversed emoji map of aliases
 // Key is the code of the emoji
 // Value is the alias
 func ReversedMap() map[string]string {
-	return reverseEmojiMap
+	return reverseEmojiMap.toMap()
 }
 
+// FindReverse returns the alias of the emoji by its code.
 func FindReverse(unicode string) (string, bool) {
-	if alias, ok := reverseEmojiMap[unicode]; ok {
+	if alias, ok := reverseEmojiMap.find(unicode); ok {
 		return alias, true
 	}
+
+	// keycaps are known by their fully-qualified form only, e.g. 7️⃣
+	if n := keycapLen(unicode); n > 0 && n == len(unicode) {
+		r, _ := utf8.DecodeRuneInString(unicode)
+		keycap, _ := Keycap(r)
+		alias, ok := reverseEmojiMap.find(keycap.String())
+		return alias, ok
+	}
+
 	return "", false
 }
 
@@ -245,22 +482,12 @@ func RunesToHexKey(runes []rune) (output string) {
 	return
 }
 
-func normalizedString(input string) string {
-	runes := []rune(input)
-	for idx, r := range runes {
-		if hk := RunesToHexKey([]rune{r}); len(hk) < 4 {
-			return string(runes[:idx+1])
-		}
-	}
-	return input
-}
-
 func longestEmoji(normalizedStr string) (string, int) {
 	runes := []rune(normalizedStr)
 	size := 0
 	for len(runes) > 0 {
 		emoji := string(runes)
-		if alias, ok := reverseEmojiMap[emoji]; ok {
+		if alias, ok := reverseEmojiMap.find(emoji); ok {
 			for _, r := range runes {
 				size += utf8.RuneLen(r)
 			}
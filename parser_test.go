@@ -2,6 +2,7 @@ package emoji
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 )
 
@@ -11,8 +12,8 @@ func TestReplace(t *testing.T) {
 		expected string
 	}{
 		{
-			input:    "I am :man_technologist: from :flag_for_turkey:. Tests are :thumbs_up:",
-			expected: fmt.Sprintf("I am %v from %v. Tests are %v", ManTechnologist, FlagForTurkey, ThumbsUp),
+			input:    "I am :man_technologist: from :flag-tr:. Tests are :thumbs_up:",
+			expected: fmt.Sprintf("I am %v from %v. Tests are %v", ManTechnologist, "\U0001F1F9\U0001F1F7", ThumbsUp),
 		},
 		{
 			input:    "consecutive emojis :pizza::sushi::sweat:",
@@ -56,7 +57,7 @@ func TestReplace(t *testing.T) {
 		},
 		{
 			input:    "flag testing :flag-tr: done",
-			expected: fmt.Sprintf("flag testing %v done", FlagForTurkey),
+			expected: fmt.Sprintf("flag testing %v done", "\U0001F1F9\U0001F1F7"),
 		},
 		{
 			input:    "not valid flags :flag-tra: :flag-t: testing",
@@ -79,8 +80,249 @@ func TestReplace(t *testing.T) {
 	}
 }
 
+func TestReplaceFunc(t *testing.T) {
+	img := func(alias, code string) string {
+		return fmt.Sprintf("<img alt=%q title=%q>", code, alias)
+	}
+
+	tt := []struct {
+		input    string
+		expected string
+	}{
+		{
+			input:    "Tests are :thumbs_up:",
+			expected: fmt.Sprintf("Tests are <img alt=%q title=\":thumbs_up:\">", ThumbsUp),
+		},
+		{
+			input:    ":flag-tr::not_exist_emoji:",
+			expected: fmt.Sprintf("<img alt=%q title=\":flag-tr:\">:not_exist_emoji:", "\U0001F1F9\U0001F1F7"),
+		},
+	}
+
+	for i, tc := range tt {
+		t.Run(fmt.Sprintf("test #%d", i), func(t *testing.T) {
+			if got := ReplaceFunc(tc.input, img); got != tc.expected {
+				t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got, tc.expected)
+			}
+			if got := NewReplacer().ReplaceFunc(tc.input, img); got != tc.expected {
+				t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestReplaceEmojiFunc(t *testing.T) {
+	span := func(m Match) string {
+		return fmt.Sprintf("<span title=%q>%v</span>", m.Alias, m.Code)
+	}
+	dropPizza := func(m Match) string {
+		if m.Code == Pizza.String() {
+			return ""
+		}
+		return m.Code
+	}
+	offsets := func(m Match) string {
+		return fmt.Sprintf("[%d:%d]", m.Start, m.End)
+	}
+
+	tt := []struct {
+		input    string
+		repl     func(Match) string
+		expected string
+	}{
+		{
+			input:    "I ❤️ you",
+			repl:     span,
+			expected: "I <span title=\":red_heart:\">❤️</span> you",
+		},
+		{
+			input:    "no emoji",
+			repl:     span,
+			expected: "no emoji",
+		},
+		{
+			input:    "🍕🍣 and 🍕",
+			repl:     dropPizza,
+			expected: "🍣 and ",
+		},
+		{
+			input:    "a🇹🇷b7️⃣",
+			repl:     offsets,
+			expected: "a[1:9]b[10:17]",
+		},
+	}
+
+	for i, tc := range tt {
+		t.Run(fmt.Sprintf("test #%d", i), func(t *testing.T) {
+			if got := ReplaceEmojiFunc(tc.input, tc.repl); got != tc.expected {
+				t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestReplaceEmoticons(t *testing.T) {
+	tt := []struct {
+		input    string
+		expected string
+	}{
+		{
+			input:    "hi :) how are you :-D",
+			expected: fmt.Sprintf("hi %v how are you %v", SlightlySmilingFace, GrinningFaceWithBigEyes),
+		},
+		{
+			input:    ";) I <3 you. </3",
+			expected: fmt.Sprintf("%v I %v you. %v", WinkingFace, RedHeart, BrokenHeart),
+		},
+		{
+			input:    "great :P! ok :(, bye >:(",
+			expected: fmt.Sprintf("great %v! ok %v, bye %v", FaceWithTongue, SlightlyFrowningFace, AngryFace),
+		},
+		{
+			input:    "meet at 12:30 on http://host:8080/path :/",
+			expected: fmt.Sprintf("meet at 12:30 on http://host:8080/path %v", ConfusedFace),
+		},
+		{
+			input:    "no emoticons:) :)x :-)-",
+			expected: "no emoticons:) :)x :-)-",
+		},
+		{
+			input:    ":smile: stays",
+			expected: ":smile: stays",
+		},
+	}
+
+	for i, tc := range tt {
+		t.Run(fmt.Sprintf("test #%d", i), func(t *testing.T) {
+			if got := ReplaceEmoticons(tc.input); got != tc.expected {
+				t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got, tc.expected)
+			}
+			if got := NewReplacer().ReplaceEmoticons(tc.input); got != tc.expected {
+				t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestToEmoticons(t *testing.T) {
+	tt := []struct {
+		input    string
+		expected string
+	}{
+		{input: "hi 🙂 😃", expected: "hi :) :D"},
+		{input: "I ❤️ 🍕", expected: "I <3 🍕"},
+		{input: "no emoji", expected: "no emoji"},
+	}
+
+	for i, tc := range tt {
+		t.Run(fmt.Sprintf("test #%d", i), func(t *testing.T) {
+			if got := ToEmoticons(tc.input); got != tc.expected {
+				t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestAppendEmoticon(t *testing.T) {
+	tt := []struct {
+		emoticon string
+		code     string
+		err      bool
+	}{
+		{emoticon: "o/", code: "\U0001f44b", err: false},
+		{emoticon: ":)", code: "\U0001f600", err: true},
+		{emoticon: "o /", code: "\U0001f44b", err: true},
+		{emoticon: "", code: "\U0001f44b", err: true},
+	}
+
+	reversed, reversedOK := reverseEmoticonMap["\U0001f44b"]
+	t.Cleanup(func() {
+		delete(emoticonMap, "o/")
+		if reversedOK {
+			reverseEmoticonMap["\U0001f44b"] = reversed
+		} else {
+			delete(reverseEmoticonMap, "\U0001f44b")
+		}
+	})
+
+	for i, tc := range tt {
+		err := AppendEmoticon(tc.emoticon, tc.code)
+		if (err != nil) != tc.err {
+			t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, err, tc.err)
+		}
+
+		if _, ok := Emoticons()[tc.emoticon]; !ok && !tc.err {
+			t.Fatalf("test case %v fail: emoticon %q not found", i+1, tc.emoticon)
+		}
+	}
+}
+
+func TestReplaceStrict(t *testing.T) {
+	tt := []struct {
+		input    string
+		expected string
+		err      string
+	}{
+		{
+			input:    "Tests are :thumbs_up:",
+			expected: fmt.Sprintf("Tests are %v", ThumbsUp),
+		},
+		{
+			input:    ":thumbsup_: and :pizza: at 10:30:45 :not_exist_emoji:",
+			expected: fmt.Sprintf(":thumbsup_: and %v at 10:30:45 :not_exist_emoji:", Pizza),
+			err:      `unknown emoji alias ":thumbsup_:" at offset 0, did you mean ":thumbsup:"?; unknown emoji alias ":not_exist_emoji:" at offset 36`,
+		},
+	}
+
+	for i, tc := range tt {
+		t.Run(fmt.Sprintf("test #%d", i), func(t *testing.T) {
+			got, err := ReplaceStrict(tc.input)
+			if got != tc.expected {
+				t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got, tc.expected)
+			}
+			if (err == nil) != (tc.err == "") {
+				t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, err, tc.err)
+			}
+			if err != nil && err.Error() != tc.err {
+				t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, err, tc.err)
+			}
+		})
+	}
+}
+
+func TestReplaceStrictErrors(t *testing.T) {
+	_, err := ReplaceStrict(":foo: :pizza: :foo:")
+
+	errs, ok := err.(UnknownAliasesError)
+	if !ok || len(errs) != 2 {
+		t.Fatalf("test case fail: got: %#v, expected 2 unknown aliases", err)
+	}
+	if errs[1].Alias != ":foo:" || errs[1].Offset != 14 {
+		t.Fatalf("test case fail: got: %+v, expected: :foo: at 14", errs[1])
+	}
+}
+
+func TestUnknown(t *testing.T) {
+	tt := []struct {
+		input    string
+		expected []string
+	}{
+		{input: "no aliases", expected: []string{}},
+		{input: ":pizza: :sushi:", expected: []string{}},
+		{input: ":thumbsup_: :pizza: :foo: :thumbsup_: 10:30:45", expected: []string{":thumbsup_:", ":foo:"}},
+	}
+
+	for i, tc := range tt {
+		t.Run(fmt.Sprintf("test #%d", i), func(t *testing.T) {
+			if got := Unknown(tc.input); !reflect.DeepEqual(got, tc.expected) {
+				t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got, tc.expected)
+			}
+		})
+	}
+}
+
 func TestMap(t *testing.T) {
-	expected := len(emojiMap)
+	expected := emojiMap.len()
 	got := len(Map())
 
 	if got != expected {
@@ -219,6 +461,11 @@ func TestDeparse(t *testing.T) {
 			inputStr: "💏🏾 👩🏽‍❤️‍💋‍👨🏿",
 			want:     ":couplekiss: :kiss_woman_man:",
 		},
+		{
+			name:     "dashes and keycap code point names",
+			inputStr: "Room 20E3 - 1-2 #\u20e3",
+			want:     "Room 20E3 - 1-2 :keycap_hash:",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
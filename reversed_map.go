@@ -2,1880 +2,1602 @@ package emoji
 
 // Code generated by github.com/AkinAD/emoji/internal/generator DO NOT EDIT.
 
-// Source: https://raw.githubusercontent.com/github/gemoji/master/db/emoji.json
-// Create at: 2022-01-13T21:03:21-05:00
+// Source: https://raw.githubusercontent.com/github/gemoji/v4.1.0/db/emoji.json
+// Create at: 2026-10-19T06:09:04Z
 
-var reverseEmojiMap = map[string]string{
-	"\U0001f32d":                   ":hotdog:",
-	"\U0001f6a3\u200d\u2640\ufe0f": ":woman_rowing_boat:",
-	"\U0001f1eb\U0001f1ee":         ":finland:",
-	"\U0001f3c6":                   ":trophy:",
-	"\U0001f55b":                   ":clock12:",
-	"\U0001f5ff":                   ":moai:",
-	"\u262f\ufe0f":                 ":yin_yang:",
-	"\U0001f7ea":                   ":purple_square:",
-	"\U0001f457":                   ":dress:",
-	"\u2696\ufe0f":                 ":balance_scale:",
-	"\U0001f320":                   ":stars:",
-	"\U0001fa90":                   ":ringed_planet:",
-	"\U0001f3b5":                   ":musical_note:",
-	"\U0001f9d1\U0001f3fb\u200d\u2764\ufe0f\u200d\U0001f9d1\U0001f3fc": ":couple_with_heart_person_person:",
-	"\U0001f3a4":                   ":microphone:",
-	"\U0001f1f3\U0001f1ff":         ":new_zealand:",
-	"\U0001f318":                   ":waning_crescent_moon:",
-	"\u2197\ufe0f":                 ":arrow_upper_right:",
-	"\U0001f4f8":                   ":camera_flash:",
-	"\U0001f967":                   ":pie:",
-	"\U0001f9d8\u200d\u2640\ufe0f": ":lotus_position_woman:",
-	"\U0001f7e9":                   ":green_square:",
-	"\U0001f698":                   ":oncoming_automobile:",
-	"\U0001f9fe":                   ":receipt:",
-	"\U0001f505":                   ":dim_button:",
-	"\U0001f9cf\u200d\u2642\ufe0f": ":deaf_man:",
-	"\U0001f1f2\U0001f1ec":         ":madagascar:",
-	"\U0001faa6":                   ":headstone:",
-	"\U0001f7e3":                   ":purple_circle:",
-	"\U0001f4fa":                   ":tv:",
-	"\U0001f5bc\ufe0f":             ":framed_picture:",
-	"\U0001f563":                   ":eight_thirty:",
-	"\U0001f3be":                   ":tennis:",
-	"\U0001f3b9":                   ":musical_keyboard:",
-	"\U0001f34f":                   ":green_apple:",
-	"\U0001f9f7":                   ":safety_pin:",
-	"\U0001f6aa":                   ":door:",
-	"\u2757":                       ":exclamation:",
-	"\U0001f9f2":                   ":magnet:",
-	"\u303d\ufe0f":                 ":part_alternation_mark:",
-	"\U0001f55c":                   ":clock130:",
-	"\U0001f6a0":                   ":mountain_cableway:",
-	"\U0001f1f9\U0001f1f3":         ":flag_for_tunisia:",
-	"\U0001f9de\u200d\u2640\ufe0f": ":woman_genie:",
-	"\U0001f484":                   ":lipstick:",
-	"\U0001f1e8\U0001f1f2":         ":cameroon:",
-	"\U0001f1f2\U0001f1ff":         ":mozambique:",
-	"\U0001fad4":                   ":tamale:",
-	"\u2721\ufe0f":                 ":star_of_david:",
-	"\u23ec":                       ":arrow_double_down:",
-	"\U0001f412":                   ":monkey:",
-	"\U0001f558":                   ":clock9:",
-	"\U0001f46a":                   ":family:",
-	"\U0001f559":                   ":ten_o_clock:",
-	"\U0001f6de":                   ":wheel:",
-	"\U0001f98c":                   ":deer:",
-	"\U0001f441\ufe0f":             ":eye:",
-	"\U0001f4aa":                   ":flexed_biceps:",
-	"\U0001f385":                   ":santa_claus:",
-	"\U0001f993":                   ":zebra:",
-	"\U0001f34e":                   ":apple:",
-	"\U0001f532":                   ":black_square_button:",
-	"\U0001f3c8":                   ":football:",
-	"\U0001f4f5":                   ":no_mobile_phones:",
-	"\U0001f469\u200d\U0001f469\u200d\U0001f467\u200d\U0001f467": ":family_woman_woman_girl_girl:",
-	"\u25fb\ufe0f":         ":white_medium_square:",
-	"\u26c5":               ":partly_sunny:",
-	"\U0001f490":           ":bouquet:",
-	"\U0001f9e1":           ":orange_heart:",
-	"\U0001f9a0":           ":microbe:",
-	"\U0001f9ba":           ":safety_vest:",
-	"\U0001f41d":           ":bee:",
-	"\U0001f1f0\U0001f1fc": ":kuwait:",
-	"\U0001f4e4":           ":outbox_tray:",
-	"\U0001f4b3":           ":credit_card:",
-	"\u2653":               ":pisces:",
-	"\U0001f3d5\ufe0f":     ":camping:",
-	"\U0001f4ac":           ":speech_balloon:",
-	"\U0001fab2":           ":beetle:",
-	"\U0001f5c4\ufe0f":     ":file_cabinet:",
-	"\u24c2\ufe0f":         ":m:",
-	"\U0001f468\u200d\U0001f467\u200d\U0001f467": ":family_man_girl_girl:",
-	"\U0001f474":                 ":old_man:",
-	"\U0001f4ba":                 ":seat:",
-	"\U0001f9d1\u200d\U0001f393": ":student:",
-	"\u2601\ufe0f":               ":cloud:",
-	"\U0001f383":                 ":jack_o_lantern:",
-	"\U0001f366":                 ":icecream:",
-	"\U0001fae3":                 ":face_with_peeking_eye:",
-	"\U0001f1fa\U0001f1ec":       ":flag_for_uganda:",
-	"\U0001f523":                 ":input_symbols:",
-	"\U0001f1e7\U0001f1ec":       ":flag_for_bulgaria:",
-	"\U0001f3ca":                 ":swimmer:",
-	"\U0001f1fe\U0001f1ea":       ":yemen:",
-	"\U0001f57a":                 ":man_dancing:",
-	"\U0001f1e7\U0001f1f1":       ":flag_for_st_barthelemy:",
-	"\U0001f9a7":                 ":orangutan:",
-	"\U0001f1f8\U0001f1e8":       ":flag_for_seychelles:",
-	"\U0001f1f1\U0001f1fa":       ":luxembourg:",
-	"\U0001f52c":                 ":microscope:",
-	"\u270f\ufe0f":               ":pencil2:",
-	"\U0001f17f\ufe0f":           ":p_button:",
-	"\U0001f1e7\U0001f1f2":       ":bermuda:",
-	"\U0001f48f":                 ":couplekiss:",
-	"\U0001f7e5":                 ":red_square:",
-	"\U0001f951":                 ":avocado:",
-	"\U0001f9b5":                 ":leg:",
-	"\U0001fae1":                 ":saluting_face:",
-	"\u3030\ufe0f":               ":wavy_dash:",
-	"\U0001f93f":                 ":diving_mask:",
-	"\U0001f30f":                 ":globe_showing_asia_australia:",
-	"\U0001f530":                 ":japanese_symbol_for_beginner:",
-	"\U0001f1e7\U0001f1e6":       ":flag_for_bosnia_and_herzegovina:",
-	"\U0001f456":                 ":jeans:",
-	"\U0001f1f2\U0001f1ea":       ":flag_for_montenegro:",
-	"\U0001f577\ufe0f":           ":spider:",
-	"\U0001f3a2":                 ":roller_coaster:",
-	"\U0001f397\ufe0f":           ":reminder_ribbon:",
-	"\U0001f37e":                 ":champagne:",
-	"\U0001f329\ufe0f":           ":cloud_with_lightning:",
-	"\U0001f971":                 ":yawning_face:",
-	"\U0001f4dc":                 ":scroll:",
-	"\U0001f1ea\U0001f1f8":       ":flag_for_spain:",
-	"\U0001f62b":                 ":tired_face:",
-	"\U0001f1ee\U0001f1f4":       ":british_indian_ocean_territory:",
-	"\U0001f4e8":                 ":incoming_envelope:",
-	"\U0001f3fc":                 ":medium_light_skin_tone:",
-	"\U0001f3de\ufe0f":           ":national_park:",
-	"\U0001f55d":                 ":clock230:",
-	"\U0001f6ec":                 ":flight_arrival:",
-	"\u27b0":                     ":curly_loop:",
-	"\U0001f92e":                 ":face_vomiting:",
-	"\U0001f1f0\U0001f1ff":       ":kazakhstan:",
-	"\U0001f38b":                 ":tanabata_tree:",
-	"\U0001f514":                 ":bell:",
-	"\u263a\ufe0f":               ":smiling_face:",
-	"\U0001f3f4\U000e0067\U000e0062\U000e0077\U000e006c\U000e0073\U000e007f": ":wales:",
-	"\U0001f4db":                   ":name_badge:",
-	"\U0001f470":                   ":person_with_veil:",
-	"\U0001f45a":                   ":woman_s_clothes:",
-	"\U0001fa9b":                   ":screwdriver:",
-	"\U0001f30a":                   ":water_wave:",
-	"\U0001faab":                   ":low_battery:",
-	"\u2b1b":                       ":black_large_square:",
-	"\U0001f935\u200d\u2642\ufe0f": ":man_in_tuxedo:",
-	"\U0001f1ee\U0001f1f1":         ":israel:",
-	"\U0001f472":                   ":man_with_gua_pi_mao:",
-	"\u2708\ufe0f":                 ":airplane:",
-	"7":                            ":keycap_7:",
-	"\U0001f9aa":                   ":oyster:",
-	"\U0001f92b":                   ":shushing_face:",
-	"\U0001f468\u200d\U0001f9bc":   ":man_in_motorized_wheelchair:",
-	"\U0001f1ea\U0001f1f9":         ":ethiopia:",
-	"\U0001f97e":                   ":hiking_boot:",
-	"\U0001f4ae":                   ":white_flower:",
-	"\U0001f33b":                   ":sunflower:",
-	"\U0001f406":                   ":leopard:",
-	"\U0001f483":                   ":woman_dancing:",
-	"\U0001f9d1\u200d\U0001f9b3":   ":person_with_white_hair:",
-	"\U0001f5ef\ufe0f":             ":right_anger_bubble:",
-	"\U0001f380":                   ":ribbon:",
-	"\U0001f923":                   ":rolling_on_the_floor_laughing:",
-	"\U0001f511":                   ":key:",
-	"\U0001f468\u200d\U0001f52c":   ":man_scientist:",
-	"\u2754":                       ":grey_question:",
-	"\U0001f4ff":                   ":prayer_beads:",
-	"\U0001f938\u200d\u2640\ufe0f": ":woman_cartwheeling:",
-	"\U0001f1e6\U0001f1fd":         ":aland_islands:",
-	"\U0001f4dd":                   ":memo:",
-	"\U0001f61c":                   ":stuck_out_tongue_winking_eye:",
-	"\U0001f947":                   ":1st_place_medal:",
-	"\u23f2\ufe0f":                 ":timer_clock:",
-	"\U0001f36a":                   ":cookie:",
-	"\U0001f470\u200d\u2640\ufe0f": ":bride_with_veil:",
-	"\U0001f469\u200d\U0001f9b3":   ":white_haired_woman:",
-	"\U0001f6e5\ufe0f":             ":motor_boat:",
-	"\U0001f3c9":                   ":rugby_football:",
-	"\U0001f347":                   ":grapes:",
-	"\U0001f47e":                   ":alien_monster:",
-	"\U0001f1ed\U0001f1f3":         ":honduras:",
-	"\U0001f235":                   ":u6e80:",
-	"\U0001f58d\ufe0f":             ":crayon:",
-	"\U0001f925":                   ":lying_face:",
-	"\U0001f376":                   ":sake:",
-	"\U0001f991":                   ":squid:",
-	"\U0001f38f":                   ":carp_streamer:",
-	"\U0001f36c":                   ":candy:",
-	"\U0001f9c2":                   ":salt:",
-	"\U0001f93e\u200d\u2640\ufe0f": ":woman_playing_handball:",
-	"\U0001f4b2":                   ":heavy_dollar_sign:",
-	"*":                            ":asterisk:",
-	"\U0001f1ff\U0001f1fc":         ":flag_for_zimbabwe:",
-	"\U0001f1e8\U0001f1ff":         ":flag_for_czechia:",
-	"\U0001f1ea\U0001f1fa":         ":eu:",
-	"\U0001f617":                   ":kissing_face:",
-	"\U0001f9c5":                   ":onion:",
-	"\u2797":                       ":heavy_division_sign:",
-	"\U0001f375":                   ":tea:",
-	"\U0001f444":                   ":lips:",
-	"\U0001f9d5":                   ":woman_with_headscarf:",
-	"\u2198\ufe0f":                 ":down_right_arrow:",
-	"\U0001f48b":                   ":kiss:",
-	"\u23ef\ufe0f":                 ":play_or_pause_button:",
-	"\U0001f1ea\U0001f1e8":         ":flag_for_ecuador:",
-	"\U0001f644":                   ":face_with_rolling_eyes:",
-	"\U0001f561":                   ":clock630:",
-	"\U0001f430":                   ":rabbit_face:",
-	"\U0001f492":                   ":wedding:",
-	"\U0001f637":                   ":mask:",
-	"\U0001f39f\ufe0f":             ":tickets:",
-	"\U0001f50b":                   ":battery:",
-	"\U0001f1f3\U0001f1f5":         ":nepal:",
-	"\U0001f497":                   ":growing_heart:",
-	"\U0001f35d":                   ":spaghetti:",
-	"\U0001f4be":                   ":floppy_disk:",
-	"\U0001f4a6":                   ":sweat_droplets:",
-	"\U0001f3ee":                   ":lantern:",
-	"\U0001f6b4":                   ":bicyclist:",
-	"\U0001f428":                   ":koala:",
-	"\U0001f469\u200d\U0001f467\u200d\U0001f466": ":family_woman_girl_boy:",
-	"\u2668\ufe0f":                 ":hot_springs:",
-	"\U0001f331":                   ":seedling:",
-	"\U0001f926":                   ":person_facepalming:",
-	"\U0001f99a":                   ":peacock:",
-	"\U0001f1ec\U0001f1f5":         ":guadeloupe:",
-	"\U0001f1f0\U0001f1f7":         ":kr:",
-	"\U0001f9a6":                   ":otter:",
-	"\U0001f683":                   ":railway_car:",
-	"\U0001f9ec":                   ":dna:",
-	"\U0001f9e9":                   ":puzzle_piece:",
-	"\U0001f1f9\U0001f1fb":         ":tuvalu:",
-	"\U0001f957":                   ":green_salad:",
-	"\u23ed\ufe0f":                 ":next_track_button:",
-	"3\ufe0f\u20e3":                ":three:",
-	"\U0001f914":                   ":thinking:",
-	"\U0001f681":                   ":helicopter:",
-	"\U0001f912":                   ":face_with_thermometer:",
-	"\u2196\ufe0f":                 ":up_left_arrow:",
-	"\U0001f961":                   ":takeout_box:",
-	"\U0001f9bc":                   ":motorized_wheelchair:",
-	"\U0001f570\ufe0f":             ":mantelpiece_clock:",
-	"\U0001f6b5\u200d\u2640\ufe0f": ":mountain_biking_woman:",
-	"\U0001f481":                   ":tipping_hand_person:",
-	"\U0001f46e":                   ":cop:",
-	"\U0001f47d":                   ":alien:",
-	"\U0001f3a3":                   ":fishing_pole:",
-	"\U0001f691":                   ":ambulance:",
-	"\U0001f33e":                   ":ear_of_rice:",
-	"\U0001f95c":                   ":peanuts:",
-	"\U0001f418":                   ":elephant:",
-	"\U0001f1f1\U0001f1f0":         ":sri_lanka:",
-	"\U0001f42d":                   ":mouse:",
-	"\U0001f521":                   ":abcd:",
-	"\U0001f237\ufe0f":             ":japanese_monthly_amount_button:",
-	"6":                            ":keycap_6:",
-	"\U0001f4a0":                   ":diamond_with_a_dot:",
-	"\U0001f68e":                   ":trolleybus:",
-	"\U0001f573\ufe0f":             ":hole:",
-	"\U0001f527":                   ":wrench:",
-	"\U0001f486":                   ":massage:",
-	"\U0001fa81":                   ":kite:",
-	"\U0001f469\u200d\U0001f9b0":   ":woman_with_red_hair:",
-	"\U0001f468\u200d\u2764\ufe0f\u200d\U0001f468": ":couple_with_heart_man_man:",
-	"\U0001f9d1\u200d\U0001f4bb":                   ":technologist:",
-	"\U0001f461":                                   ":woman_s_sandal:",
-	"\U0001f9d6\u200d\u2642\ufe0f":                 ":sauna_man:",
-	"\U0001f9d1":                                   ":adult:",
-	"\u264a":                                       ":gemini:",
-	"\U0001f6ba":                                   ":women_s_room:",
-	"\U0001f4e5":                                   ":inbox_tray:",
-	"\U0001f3ce\ufe0f":                             ":racing_car:",
-	"\U0001f477":                                   ":construction_worker:",
-	"\U0001f469\u200d\U0001f680":                   ":woman_astronaut:",
-	"\U0001f486\u200d\u2640\ufe0f":                 ":massage_woman:",
-	"\U0001f986":                                   ":duck:",
-	"\U0001f9df\u200d\u2640\ufe0f":                 ":zombie_woman:",
-	"\u26d1\ufe0f":                                 ":rescue_worker_helmet:",
-	"\U0001f408\u200d\u2b1b":                       ":black_cat:",
-	"\U0001f46b":                                   ":woman_and_man_holding_hands:",
-	"\U0001f1e6\U0001f1fa":                         ":australia:",
-	"\U0001f4bc":                                   ":briefcase:",
-	"\U0001f1fc\U0001f1f8":                         ":flag_for_samoa:",
-	"\U0001f9e7":                                   ":red_envelope:",
-	"\U0001f9c0":                                   ":cheese_wedge:",
-	"\U0001f1f5\U0001f1f3":                         ":pitcairn_islands:",
-	"\U0001f964":                                   ":cup_with_straw:",
-	"\U0001f969":                                   ":cut_of_meat:",
-	"\U0001f32f":                                   ":burrito:",
-	"\U0001f469\u200d\u2764\ufe0f\u200d\U0001f469": ":couple_with_heart_woman_woman:",
-	"\U0001f3c3":                         ":runner:",
-	"\U0001f555":                         ":clock6:",
-	"\U0001f1ec\U0001f1f1":               ":greenland:",
-	"4\ufe0f\u20e3":                      ":keycap_4:",
-	"\U0001f1e8\U0001f1f7":               ":costa_rica:",
-	"0":                                  ":zero:",
-	"\U0001f917":                         ":smiling_face_with_open_hands:",
-	"\U0001f620":                         ":angry_face:",
-	"\U0001f357":                         ":poultry_leg:",
-	"\U0001f4f2":                         ":calling:",
-	"\U0001f469\u200d\U0001f33e":         ":woman_farmer:",
-	"\U0001fad5":                         ":fondue:",
-	"\U0001f9db\u200d\u2640\ufe0f":       ":woman_vampire:",
-	"\U0001f1f1\U0001f1f8":               ":lesotho:",
-	"\U0001f1eb\U0001f1ef":               ":fiji:",
-	"\U0001f575\ufe0f\u200d\u2642\ufe0f": ":male_detective:",
-	"\u2648":                             ":aries:",
-	"\U0001f9df":                         ":zombie:",
-	"\U0001f4a9":                         ":poop:",
-	"\U0001f423":                         ":hatching_chick:",
-	"\U0001f1ed\U0001f1f0":               ":flag_for_hong_kong_sar_china:",
-	"\U0001f600":                         ":grinning_face:",
-	"\U0001f1f5\U0001f1ed":               ":flag_for_philippines:",
-	"\U0001f645\u200d\u2642\ufe0f":       ":no_good_man:",
-	"\U0001f6a3":                         ":rowboat:",
-	"\U0001f3d0":                         ":volleyball:",
-	"\U0001f99f":                         ":mosquito:",
-	"\U0001f4a5":                         ":collision:",
-	"\U0001f46e\u200d\u2642\ufe0f":       ":man_police_officer:",
-	"\U0001f9cd":                         ":person_standing:",
-	"\U0001f960":                         ":fortune_cookie:",
-	"\U0001f36d":                         ":lollipop:",
-	"\U0001f9f3":                         ":luggage:",
-	"\U0001f49f":                         ":heart_decoration:",
-	"\U0001f468\u200d\U0001f468\u200d\U0001f466\u200d\U0001f466": ":family_man_man_boy_boy:",
-	"\U0001fab3":                   ":cockroach:",
-	"\U0001f1ee\U0001f1f6":         ":flag_for_iraq:",
-	"\U0001f1fd\U0001f1f0":         ":kosovo:",
-	"\U0001f54d":                   ":synagogue:",
-	"\U0001faa4":                   ":mouse_trap:",
-	"\U0001f619":                   ":kissing_smiling_eyes:",
-	"\U0001f390":                   ":wind_chime:",
-	"\U0001f9c1":                   ":cupcake:",
-	"\U0001f69b":                   ":articulated_lorry:",
-	"\U0001f499":                   ":blue_heart:",
-	"\U0001f6ad":                   ":no_smoking:",
-	"\U0001f639":                   ":cat_with_tears_of_joy:",
-	"\U0001f6df":                   ":ring_buoy:",
-	"\U0001f6e2\ufe0f":             ":oil_drum:",
-	"\U0001f6b8":                   ":children_crossing:",
-	"\U0001f51a":                   ":end:",
-	"\U0001f936":                   ":mrs_claus:",
-	"\U0001f68c":                   ":bus:",
-	"\U0001f6f4":                   ":kick_scooter:",
-	"\U0001f4a3":                   ":bomb:",
-	"\U0001f1e7\U0001f1ef":         ":benin:",
-	"\u26ea":                       ":church:",
-	"\U0001f374":                   ":fork_and_knife:",
-	"\U0001f7e1":                   ":yellow_circle:",
-	"\U0001f1e9\U0001f1f0":         ":denmark:",
-	"\U0001f410":                   ":goat:",
-	"\U0001f64c":                   ":raising_hands:",
-	"\U0001f17e\ufe0f":             ":o_button_blood_type:",
-	"\U0001f1e8\U0001f1ed":         ":switzerland:",
-	"\U0001f307":                   ":sunset:",
-	"\U0001f68b":                   ":train:",
-	"\U0001f445":                   ":tongue:",
-	"\U0001f1f0\U0001f1ea":         ":flag_for_kenya:",
-	"\U0001f489":                   ":syringe:",
-	"\U0001fac5":                   ":person_with_crown:",
-	"\U0001f37a":                   ":beer_mug:",
-	"\U0001f6b4\u200d\u2640\ufe0f": ":biking_woman:",
-	"\U0001f4d2":                   ":ledger:",
-	"\U0001f1f2\U0001f1f8":         ":flag_for_montserrat:",
-	"\U0001f933":                   ":selfie:",
-	"\U0001f1fb\U0001f1f3":         ":flag_for_vietnam:",
-	"\U0001f369":                   ":doughnut:",
-	"\U0001f607":                   ":innocent:",
-	"\U0001f44d":                   ":thumbsup:",
-	"\U0001f407":                   ":rabbit2:",
-	"\U0001fa72":                   ":swim_brief:",
-	"\U0001faaa":                   ":identification_card:",
-	"\U0001f93e":                   ":person_playing_handball:",
-	"\U0001f1e8\U0001f1fe":         ":flag_for_cyprus:",
-	"\u26f7\ufe0f":                 ":skier:",
-	"\U0001f4b5":                   ":dollar:",
-	"\U0001f537":                   ":large_blue_diamond:",
-	"\U0001f9dc\u200d\u2640\ufe0f": ":mermaid:",
-	"\U0001f4a7":                   ":droplet:",
-	"\U0001f517":                   ":link:",
-	"\U0001f1f0\U0001f1ed":         ":flag_for_cambodia:",
-	"\U0001f1e6\U0001f1f9":         ":flag_for_austria:",
-	"\U0001f477\u200d\u2642\ufe0f": ":construction_worker_man:",
-	"\U0001f1f9\U0001f1e8":         ":flag_for_turks_and_caicos_islands:",
-	"\U0001f4b8":                   ":money_with_wings:",
-	"\u23f9\ufe0f":                 ":stop_button:",
-	"\U0001f920":                   ":cowboy_hat_face:",
-	"\U0001f557":                   ":clock8:",
-	"\U0001f90c":                   ":pinched_fingers:",
-	"\U0001fa84":                   ":magic_wand:",
-	"\U0001f938":                   ":cartwheeling:",
-	"\U0001f528":                   ":hammer:",
-	"\U0001fab8":                   ":coral:",
-	"\U0001f682":                   ":locomotive:",
-	"\U0001f1e6\U0001f1ff":         ":flag_for_azerbaijan:",
-	"\U0001f9d1\u200d\U0001f3ed":   ":factory_worker:",
-	"\U0001f3db\ufe0f":             ":classical_building:",
-	"\U0001f622":                   ":crying_face:",
-	"\u264f":                       ":scorpius:",
-	"\U0001f1e7\U0001f1fc":         ":flag_for_botswana:",
-	"\U0001f3ea":                   ":convenience_store:",
-	"\U0001f469\u200d\U0001f469\u200d\U0001f466\u200d\U0001f466": ":family_woman_woman_boy_boy:",
-	"\U0001f1f8\U0001f1fe":         ":syria:",
-	"\U0001f9c8":                   ":butter:",
-	"\U0001f9d9":                   ":mage:",
-	"\U0001f479":                   ":japanese_ogre:",
-	"\U0001f44e":                   ":thumbsdown:",
-	"\U0001fad1":                   ":bell_pepper:",
-	"\U0001f333":                   ":deciduous_tree:",
-	"\U0001fa94":                   ":diya_lamp:",
-	"\U0001f9d1\u200d\U0001f9af":   ":person_with_probing_cane:",
-	"\U0001f1fa\U0001f1f3":         ":united_nations:",
-	"\u2712\ufe0f":                 ":black_nib:",
-	"\U0001f3b6":                   ":musical_notes:",
-	"\U0001f64b\u200d\u2640\ufe0f": ":raising_hand_woman:",
-	"\U0001f6d6":                   ":hut:",
-	"\U0001fa9d":                   ":hook:",
-	"\U0001f613":                   ":sweat:",
-	"\U0001f371":                   ":bento:",
-	"\U0001f9b0":                   ":red_hair:",
-	"\u26f9\ufe0f":                 ":person_bouncing_ball:",
-	"\U0001f30b":                   ":volcano:",
-	"\U0001f354":                   ":hamburger:",
-	"*\ufe0f\u20e3":                ":asterisk:",
-	"\U0001f316":                   ":waning_gibbous_moon:",
-	"\U0001f9b8\u200d\u2642\ufe0f": ":man_superhero:",
-	"\U0001f510":                   ":closed_lock_with_key:",
-	"\U0001f37d\ufe0f":             ":fork_and_knife_with_plate:",
-	"\U0001f381":                   ":gift:",
-	"\U0001f9bd":                   ":manual_wheelchair:",
-	"\U0001f4c8":                   ":chart_increasing:",
-	"\U0001f1f9\U0001f1f4":         ":tonga:",
-	"\U0001f387":                   ":sparkler:",
-	"\U0001f3ec":                   ":department_store:",
-	"\U0001f3fe":                   ":medium_dark_skin_tone:",
-	"\U0001f9d2":                   ":child:",
-	"\U0001f5e8\ufe0f":             ":left_speech_bubble:",
-	"\U0001f641":                   ":slightly_frowning_face:",
-	"\U0001fa7b":                   ":x_ray:",
-	"\u2702\ufe0f":                 ":scissors:",
-	"\U0001f4f9":                   ":video_camera:",
-	"\U0001fae5":                   ":dotted_line_face:",
-	"\U0001f4bb":                   ":laptop:",
-	"\U0001f5fc":                   ":tokyo_tower:",
-	"\U0001f694":                   ":oncoming_police_car:",
-	"\U0001f95b":                   ":milk_glass:",
-	"\U0001f94a":                   ":boxing_glove:",
-	"\U0001f6b3":                   ":no_bicycles:",
-	"\U0001f556":                   ":seven_o_clock:",
-	"\U0001f9e2":                   ":billed_cap:",
-	"\u26d4":                       ":no_entry:",
-	"\U0001f9d1\u200d\U0001f3eb":   ":teacher:",
-	"\U0001f9d1\u200d\U0001f52c":   ":scientist:",
-	"\U0001f96f":                   ":bagel:",
-	"\U0001f99e":                   ":lobster:",
-	"\U0001f468\u200d\U0001f692":   ":man_firefighter:",
-	"\u23ea":                       ":fast_reverse_button:",
-	"\U0001f645":                   ":no_good:",
-	"\U0001f5d1\ufe0f":             ":wastebasket:",
-	"\U0001f31b":                   ":first_quarter_moon_face:",
-	"\U0001f3f8":                   ":badminton:",
-	"\U0001f91a":                   ":raised_back_of_hand:",
-	"\U0001f9db\u200d\u2642\ufe0f": ":vampire_man:",
-	"\U0001f9e4":                   ":gloves:",
-	"\U0001f1ec\U0001f1fe":         ":flag_for_guyana:",
-	"\U0001f399\ufe0f":             ":studio_microphone:",
-	"\U0001f5d2\ufe0f":             ":spiral_notepad:",
-	"\U0001fa92":                   ":razor:",
-	"\U0001f1ec\U0001f1f8":         ":south_georgia_south_sandwich_islands:",
-	"\U0001f4de":                   ":telephone_receiver:",
-	"\U0001f538":                   ":small_orange_diamond:",
-	"\U0001f324\ufe0f":             ":sun_behind_small_cloud:",
-	"\U0001f473\u200d\u2642\ufe0f": ":man_with_turban:",
-	"\u265f\ufe0f":                 ":chess_pawn:",
-	"\U0001fa85":                   ":pinata:",
-	"\U0001f458":                   ":kimono:",
-	"\U0001f1f2\U0001f1fb":         ":flag_for_maldives:",
-	"\u2b1c":                       ":white_large_square:",
-	"\U0001fa71":                   ":one_piece_swimsuit:",
-	"\U0001f648":                   ":see_no_evil:",
-	"\U0001f9e8":                   ":firecracker:",
-	"\U0001f9d1\u200d\U0001f91d\u200d\U0001f9d1": ":people_holding_hands:",
-	"\U0001f431":                   ":cat:",
-	"\U0001f433":                   ":spouting_whale:",
-	"\u2199\ufe0f":                 ":down_left_arrow:",
-	"\U0001f98f":                   ":rhinoceros:",
-	"\u23f3":                       ":hourglass_not_done:",
-	"\U0001f6c0":                   ":bath:",
-	"\U0001f4c3":                   ":page_with_curl:",
-	"\U0001f415":                   ":dog2:",
-	"\U0001f55e":                   ":clock330:",
-	"\U0001f6f7":                   ":sled:",
-	"\U0001f95a":                   ":egg:",
-	"\U0001f3c4\u200d\u2642\ufe0f": ":surfing_man:",
-	"\U0001f1f8\U0001f1f9":         ":sao_tome_principe:",
-	"\U0001f373":                   ":fried_egg:",
-	"\U0001fa7a":                   ":stethoscope:",
-	"\U0001f9b9":                   ":supervillain:",
-	"\U0001f9bb":                   ":ear_with_hearing_aid:",
-	"\U0001f913":                   ":nerd_face:",
-	"\U0001f41b":                   ":bug:",
-	"\U0001f1f7\U0001f1fa":         ":ru:",
-	"\U0001f47b":                   ":ghost:",
-	"\U0001f552":                   ":three_o_clock:",
-	"\U0001f972":                   ":smiling_face_with_tear:",
-	"\U0001f1ec\U0001f1f3":         ":flag_for_guinea:",
-	"\U0001f1f2\U0001f1fc":         ":flag_for_malawi:",
-	"\U0001f602":                   ":joy:",
-	"\u2642\ufe0f":                 ":male_sign:",
-	"\U0001f40c":                   ":snail:",
-	"\U0001f3d1":                   ":field_hockey:",
-	"\U0001f6b6\u200d\u2642\ufe0f": ":walking_man:",
-	"\U0001f468\u200d\U0001f9af":   ":man_with_probing_cane:",
-	"\U0001f3af":                   ":dart:",
-	"\U0001f1f1\U0001f1e8":         ":st_lucia:",
-	"\U0001f1ff\U0001f1f2":         ":flag_for_zambia:",
-	"\U0001f9f6":                   ":yarn:",
-	"\U0001f503":                   ":clockwise_vertical_arrows:",
-	"\U0001f1e7\U0001f1eb":         ":burkina_faso:",
-	"\U0001f306":                   ":cityscape_at_dusk:",
-	"\U0001f36e":                   ":custard:",
-	"\U0001f1f9\U0001f1ff":         ":flag_for_tanzania:",
-	"\U0001f6d5":                   ":hindu_temple:",
-	"\U0001f30e":                   ":globe_showing_americas:",
-	"\U0001f19a":                   ":vs:",
-	"\U0001f32e":                   ":taco:",
-	"\U0001f51d":                   ":top_arrow:",
-	"\U0001f522":                   ":input_numbers:",
-	"\U0001f39e\ufe0f":             ":film_strip:",
-	"\U0001f32b\ufe0f":             ":fog:",
-	"\U0001f468\u200d\U0001f466\u200d\U0001f466": ":family_man_boy_boy:",
-	"\U0001f325\ufe0f":                           ":sun_behind_large_cloud:",
-	"\U0001f63a":                                 ":smiley_cat:",
-	"\U0001faa3":                                 ":bucket:",
-	"\U0001f6b4\u200d\u2642\ufe0f":               ":biking_man:",
-	"\U0001f9f5":                                 ":thread:",
-	"\U0001f696":                                 ":oncoming_taxi:",
-	"\U0001f51c":                                 ":soon:",
-	"\U0001f1ef\U0001f1f4":                       ":jordan:",
-	"\u26a0\ufe0f":                               ":warning:",
-	"\U0001f1fb\U0001f1ee":                       ":us_virgin_islands:",
-	"\U0001f553":                                 ":clock4:",
-	"\U0001f915":                                 ":face_with_head_bandage:",
-	"\U0001f1f8\U0001f1f0":                       ":flag_for_slovakia:",
-	"\U0001f93d":                                 ":person_playing_water_polo:",
-	"\U0001f932":                                 ":palms_up_together:",
-	"\U0001f3c3\u200d\u2640\ufe0f":               ":woman_running:",
-	"\U0001f4e9":                                 ":envelope_with_arrow:",
-	"\U0001f4d9":                                 ":orange_book:",
-	"\u26f8\ufe0f":                               ":ice_skate:",
-	"\u262e\ufe0f":                               ":peace_symbol:",
-	"\U0001f468\u200d\U0001f33e":                 ":man_farmer:",
-	"\U0001faba":                                 ":nest_with_eggs:",
-	"\U0001f4b4":                                 ":yen_banknote:",
-	"\U0001f935":                                 ":person_in_tuxedo:",
-	"\U0001f35c":                                 ":steaming_bowl:",
-	"\U0001f468\u200d\u2695\ufe0f":               ":man_health_worker:",
-	"\U0001f7e7":                                 ":orange_square:",
-	"\u2611\ufe0f":                               ":ballot_box_with_check:",
-	"\U0001f98a":                                 ":fox:",
-	"\U0001f1e6\U0001f1fc":                       ":aruba:",
-	"\U0001f3bd":                                 ":running_shirt_with_sash:",
-	"\U0001f4d3":                                 ":notebook:",
-	"\U0001f1f5\U0001f1f9":                       ":portugal:",
-	"\U0001f469\u200d\U0001f9b2":                 ":woman_bald:",
-	"\U0001f69d":                                 ":monorail:",
-	"\U0001f1fb\U0001f1fa":                       ":vanuatu:",
-	"\U0001f468\u200d\U0001f9b1":                 ":man_with_curly_hair:",
-	"\U0001f334":                                 ":palm_tree:",
-	"\U0001f468\u200d\U0001f680":                 ":man_astronaut:",
-	"\u2b07\ufe0f":                               ":down_arrow:",
-	"\U0001f48a":                                 ":pill:",
-	"\U0001f9a4":                                 ":dodo:",
-	"\U0001f439":                                 ":hamster:",
-	"\U0001f1e6\U0001f1eb":                       ":afghanistan:",
-	"\U0001f506":                                 ":high_brightness:",
-	"\U0001f338":                                 ":cherry_blossom:",
-	"\U0001f62e\u200d\U0001f4a8":                 ":face_exhaling:",
-	"\U0001f9d1\u200d\u2696\ufe0f":               ":judge:",
-	"\u260e\ufe0f":                               ":phone:",
-	"\U0001f319":                                 ":crescent_moon:",
-	"\U0001f575\ufe0f\u200d\u2640\ufe0f":         ":female_detective:",
-	"\U0001f1ee\U0001f1ea":                       ":ireland:",
-	"\U0001f911":                                 ":money_mouth_face:",
-	"\U0001f9d1\u200d\U0001f37c":                 ":person_feeding_baby:",
-	"\U0001f985":                                 ":eagle:",
-	"\U0001fa95":                                 ":banjo:",
-	"\U0001f1f1\U0001f1ee":                       ":flag_for_liechtenstein:",
-	"5":                                          ":five:",
-	"\U0001f462":                                 ":boot:",
-	"\U0001f62d":                                 ":sob:",
-	"\U0001f689":                                 ":station:",
-	"\U0001f43a":                                 ":wolf:",
-	"\U0001f5a8\ufe0f":                           ":printer:",
-	"\U0001f311":                                 ":new_moon:",
-	"\U0001f469":                                 ":woman:",
-	"\U0001f349":                                 ":watermelon:",
-	"\U0001f401":                                 ":mouse2:",
-	"\U0001f1f5\U0001f1eb":                       ":flag_for_french_polynesia:",
-	"\U0001f1eb\U0001f1f2":                       ":micronesia:",
-	"\U0001f1ec\U0001f1ea":                       ":georgia:",
-	"\u25fe":                                     ":black_medium_small_square:",
-	"\U0001f62a":                                 ":sleepy_face:",
-	"\U0001f404":                                 ":cow2:",
-	"\u271d\ufe0f":                               ":latin_cross:",
-	"\U0001f468\u200d\U0001f4bc":                 ":man_office_worker:",
-	"\U0001f7f0":                                 ":heavy_equals_sign:",
-	"\U0001f4d6":                                 ":book:",
-	"\U0001f49c":                                 ":purple_heart:",
-	"\U0001f937\u200d\u2640\ufe0f":               ":woman_shrugging:",
-	"\U0001f1eb\U0001f1f4":                       ":faroe_islands:",
-	"\U0001f1e7\U0001f1f9":                       ":bhutan:",
-	"\U0001f90d":                                 ":white_heart:",
-	"#":                                          ":keycap_hash:",
-	"\U0001f64d\u200d\u2642\ufe0f":               ":frowning_man:",
-	"\U0001f9cf\u200d\u2640\ufe0f":               ":deaf_woman:",
-	"\U0001f979":                                 ":face_holding_back_tears:",
-	"\U0001f4fb":                                 ":radio:",
-	"\U0001f356":                                 ":meat_on_bone:",
-	"\U0001f41e":                                 ":lady_beetle:",
-	"\U0001f5fb":                                 ":mount_fuji:",
-	"\U0001f1f9\U0001f1fc":                       ":flag_for_taiwan:",
-	"8":                                          ":keycap_8:",
-	"\U0001f452":                                 ":womans_hat:",
-	"\U0001f921":                                 ":clown_face:",
-	"\U0001f3a1":                                 ":ferris_wheel:",
-	"\U0001f197":                                 ":ok_button:",
-	"\U0001f1e8\U0001f1f1":                       ":chile:",
-	"\U0001f469\u200d\U0001f466":                 ":family_woman_boy:",
-	"\U0001f3a9":                                 ":top_hat:",
-	"\U0001f48e":                                 ":gem_stone:",
-	"\U0001f1ea\U0001f1ea":                       ":flag_for_estonia:",
-	"\U0001f40b":                                 ":whale2:",
-	"\U0001f6e4\ufe0f":                           ":railway_track:",
-	"\U0001f368":                                 ":ice_cream:",
-	"\U0001f9fb":                                 ":roll_of_paper:",
-	"\U0001f615":                                 ":confused:",
-	"\U0001f47c":                                 ":angel:",
-	"\U0001f4af":                                 ":100:",
-	"\U0001f45e":                                 ":mans_shoe:",
-	"5\ufe0f\u20e3":                              ":five:",
-	"\U0001f49a":                                 ":green_heart:",
-	"\U0001f3cb\ufe0f\u200d\u2642\ufe0f":         ":weight_lifting_man:",
-	"\U0001f344":                                 ":mushroom:",
-	"\U0001f980":                                 ":crab:",
-	"\u261d\ufe0f":                               ":point_up:",
-	"\u25aa\ufe0f":                               ":black_small_square:",
-	"\U0001f635\u200d\U0001f4ab":                 ":face_with_spiral_eyes:",
-	"\U0001f6b6":                                 ":walking:",
-	"\U0001f471\u200d\u2640\ufe0f":               ":blonde_woman:",
-	"\U0001f491":                                 ":couple_with_heart:",
-	"\U0001f9d1\u200d\U0001f3a8":                 ":artist:",
-	"\U0001f4cf":                                 ":straight_ruler:",
-	"\U0001f1f5\U0001f1f7":                       ":flag_for_puerto_rico:",
-	"\U0001f9d1\u200d\U0001f4bc":                 ":office_worker:",
-	"\U0001f931":                                 ":breast_feeding:",
-	"\U0001f9d4\u200d\u2642\ufe0f":               ":man_beard:",
-	"\U0001f6c2":                                 ":passport_control:",
-	"\U0001f9d8\u200d\u2642\ufe0f":               ":man_in_lotus_position:",
-	"\U0001f1f7\U0001f1f4":                       ":romania:",
-	"\U0001f379":                                 ":tropical_drink:",
-	"\U0001f998":                                 ":kangaroo:",
-	"\u203c\ufe0f":                               ":double_exclamation_mark:",
-	"\U0001f251":                                 ":japanese_acceptable_button:",
-	"\U0001f1ed\U0001f1f7":                       ":flag_for_croatia:",
-	"\U0001f699":                                 ":blue_car:",
-	"\U0001f302":                                 ":closed_umbrella:",
-	"\U0001f4a1":                                 ":bulb:",
-	"\U0001f1fb\U0001f1e8":                       ":flag_for_st_vincent_and_grenadines:",
-	"\u23cf\ufe0f":                               ":eject_button:",
-	"\U0001f948":                                 ":second_place_medal:",
-	"\U0001f1f3\U0001f1f7":                       ":flag_for_nauru:",
-	"\U0001f3c1":                                 ":chequered_flag:",
-	"\U0001f4b0":                                 ":money_bag:",
-	"\U0001f519":                                 ":back:",
-	"\u26aa":                                     ":white_circle:",
-	"\u26f9\ufe0f\u200d\u2642\ufe0f":             ":man_bouncing_ball:",
-	"\U0001f6f3\ufe0f":                           ":passenger_ship:",
-	"\U0001f9d1\u200d\U0001f9b1":                 ":person_curly_hair:",
-	"\U0001f6a3\u200d\u2642\ufe0f":               ":rowing_man:",
-	"\U0001fa93":                                 ":axe:",
-	"\U0001f4d0":                                 ":triangular_ruler:",
-	"\U0001f232":                                 ":u7981:",
-	"\U0001f41f":                                 ":fish:",
-	"\U0001f448":                                 ":backhand_index_pointing_left:",
-	"\U0001f4c9":                                 ":chart_with_downwards_trend:",
-	"\U0001fa83":                                 ":boomerang:",
-	"\U0001f1f5\U0001f1f1":                       ":flag_for_poland:",
-	"\U0001f9dd\u200d\u2640\ufe0f":               ":elf_woman:",
-	"\U0001f3ed":                                 ":factory:",
-	"\U0001f314":                                 ":moon:",
-	"\U0001f1f5\U0001f1ec":                       ":flag_for_papua_new_guinea:",
-	"\U0001f9d9\u200d\u2640\ufe0f":               ":mage_woman:",
-	"\U0001f4b6":                                 ":euro:",
-	"\U0001f437":                                 ":pig:",
-	"\U0001f35e":                                 ":bread:",
-	"\u26f0\ufe0f":                               ":mountain:",
-	"\U0001f443":                                 ":nose:",
-	"\U0001f310":                                 ":globe_with_meridians:",
-	"\U0001f468\u200d\U0001f9b0":                 ":red_haired_man:",
-	"\U0001f4d4":                                 ":notebook_with_decorative_cover:",
-	"\U0001f1fa\U0001f1fe":                       ":uruguay:",
-	"\U0001f501":                                 ":repeat:",
-	"\U0001f1f8\U0001f1ff":                       ":swaziland:",
-	"\U0001f3bb":                                 ":violin:",
-	"\U0001f3f4\U000e0067\U000e0062\U000e0065\U000e006e\U000e0067\U000e007f": ":england:",
-	"\U0001f336\ufe0f":             ":hot_pepper:",
-	"\U0001f3f5\ufe0f":             ":rosette:",
-	"\U0001f9d1\u200d\U0001f384":   ":mx_claus:",
-	"\U0001f1e7\U0001f1f4":         ":flag_for_bolivia:",
-	"\U0001f64d":                   ":person_frowning:",
-	"\U0001f910":                   ":zipper_mouth_face:",
-	"\U0001f686":                   ":train2:",
-	"\U0001f6a9":                   ":triangular_flag:",
-	"\U0001f539":                   ":small_blue_diamond:",
-	"\U0001f3dc\ufe0f":             ":desert:",
-	"\U0001f171\ufe0f":             ":b_button_blood_type:",
-	"\U0001f5fd":                   ":statue_of_liberty:",
-	"\U0001f53c":                   ":upwards_button:",
-	"\U0001faa5":                   ":toothbrush:",
-	"\U0001f238":                   ":u7533:",
-	"\U0001f43b":                   ":bear:",
-	"\U0001f1f9\U0001f1eb":         ":french_southern_territories:",
-	"\U0001f6eb":                   ":airplane_departure:",
-	"\U0001f7e4":                   ":brown_circle:",
-	"\U0001f58c\ufe0f":             ":paintbrush:",
-	"\U0001f469\u200d\U0001f373":   ":woman_cook:",
-	"\U0001f3f4\u200d\u2620\ufe0f": ":pirate_flag:",
-	"\U0001f916":                   ":robot_face:",
-	"\U0001f6f8":                   ":flying_saucer:",
-	"\U0001f388":                   ":balloon:",
-	"\U0001f605":                   ":sweat_smile:",
-	"\U0001f353":                   ":strawberry:",
-	"\u267b\ufe0f":                 ":recycle:",
-	"\U0001f61d":                   ":squinting_face_with_tongue:",
-	"\U0001f628":                   ":fearful_face:",
-	"\U0001f465":                   ":busts_in_silhouette:",
-	"\U0001f1e8\U0001f1fb":         ":cape_verde:",
-	"\u2638\ufe0f":                 ":wheel_of_dharma:",
-	"\U0001f1e8\U0001f1f0":         ":cook_islands:",
-	"\U0001f963":                   ":bowl_with_spoon:",
-	"\U0001f469\u200d\U0001f467\u200d\U0001f467": ":family_woman_girl_girl:",
-	"\U0001f1ec\U0001f1ed":                       ":flag_for_ghana:",
-	"\U0001f3c3\u200d\u2642\ufe0f":               ":running_man:",
-	"\U0001f488":                                 ":barber_pole:",
-	"\U0001f1f6\U0001f1e6":                       ":flag_for_qatar:",
-	"\U0001f37f":                                 ":popcorn:",
-	"\U0001f4d1":                                 ":bookmark_tabs:",
-	"\U0001f3bf":                                 ":skis:",
-	"\U0001f684":                                 ":bullettrain_side:",
-	"\U0001f1fa\U0001f1f2":                       ":us_outlying_islands:",
-	"\U0001f321\ufe0f":                           ":thermometer:",
-	"\U0001f9b9\u200d\u2640\ufe0f":               ":supervillain_woman:",
-	"\U0001f1ee\U0001f1e9":                       ":indonesia:",
-	"\U0001f469\u200d\U0001f3a4":                 ":woman_singer:",
-	"\U0001f973":                                 ":partying_face:",
-	"\U0001f560":                                 ":five_thirty:",
-	"\U0001f004":                                 ":mahjong:",
-	"\U0001f5e1\ufe0f":                           ":dagger:",
-	"\U0001f4bf":                                 ":cd:",
-	"\U0001f9cf":                                 ":deaf_person:",
-	"\U0001f939\u200d\u2640\ufe0f":               ":woman_juggling:",
-	"\U0001f60c":                                 ":relieved:",
-	"\U0001faa1":                                 ":sewing_needle:",
-	"\U0001f417":                                 ":boar:",
-	"\U0001f3e2":                                 ":office_building:",
-	"\u2796":                                     ":minus:",
-	"\U0001f64e\u200d\u2640\ufe0f":               ":pouting_woman:",
-	"\U0001f42f":                                 ":tiger_face:",
-	"\U0001f3d9\ufe0f":                           ":cityscape:",
-	"\U0001f308":                                 ":rainbow:",
-	"\U0001f524":                                 ":input_latin_letters:",
-	"\U0001f968":                                 ":pretzel:",
-	"\U0001f4e1":                                 ":satellite:",
-	"\U0001f35b":                                 ":curry:",
-	"\U0001f549\ufe0f":                           ":om:",
-	"\U0001f44c":                                 ":ok_hand:",
-	"\U0001f3c4\u200d\u2640\ufe0f":               ":surfing_woman:",
-	"\U0001f64f":                                 ":folded_hands:",
-	"\U0001f649":                                 ":hear_no_evil_monkey:",
-	"\U0001f6b1":                                 ":non_potable_water:",
-	"\U0001f1e6\U0001f1ec":                       ":flag_for_antigua_and_barbuda:",
-	"\u26c4":                                     ":snowman_without_snow:",
-	"\U0001f9dc\u200d\u2642\ufe0f":               ":merman:",
-	"\U0001f38a":                                 ":confetti_ball:",
-	"\U0001f43b\u200d\u2744\ufe0f":               ":polar_bear:",
-	"\U0001f1e9\U0001f1f4":                       ":dominican_republic:",
-	"\U0001f3ff":                                 ":dark_skin_tone:",
-	"\u2693":                                     ":anchor:",
-	"\U0001f534":                                 ":red_circle:",
-	"\U0001f364":                                 ":fried_shrimp:",
-	"\U0001f97d":                                 ":goggles:",
-	"\U0001fae2":                                 ":face_with_open_eyes_and_hand_over_mouth:",
-	"\U0001f595":                                 ":fu:",
-	"\U0001f1f0\U0001f1ee":                       ":kiribati:",
-	"\U0001f468\u200d\U0001f469\u200d\U0001f467\u200d\U0001f467": ":family_man_woman_girl_girl:",
-	"\U0001faa9":           ":mirror_ball:",
-	"\U0001f198":           ":sos_button:",
-	"\U0001f1ff\U0001f1e6": ":south_africa:",
-	"\U0001f4ed":           ":mailbox_with_no_mail:",
-	"\U0001f1f5\U0001f1fc": ":flag_for_palau:",
-	"\U0001f469\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468": ":kiss_woman_man:",
-	"\U0001f42c":                   ":dolphin:",
-	"\U0001f93d\u200d\u2640\ufe0f": ":woman_playing_water_polo:",
-	"\U0001f41c":                   ":ant:",
-	"\U0001f30c":                   ":milky_way:",
-	"\U0001f468\u200d\U0001f467\u200d\U0001f466": ":family_man_girl_boy:",
-	"\U0001f5e3\ufe0f":                           ":speaking_head:",
-	"\U0001f339":                                 ":rose:",
-	"\u25b6\ufe0f":                               ":arrow_forward:",
-	"\U0001f3e6":                                 ":bank:",
-	"\U0001f1e7\U0001f1ed":                       ":flag_for_bahrain:",
-	"\U0001f43f\ufe0f":                           ":chipmunk:",
-	"\U0001f35f":                                 ":french_fries:",
-	"\U0001f4fd\ufe0f":                           ":film_projector:",
-	"\U0001f596":                                 ":vulcan_salute:",
-	"\U0001f996":                                 ":t-rex:",
-	"\U0001f1ea\U0001f1e6":                       ":ceuta_melilla:",
-	"\u2651":                                     ":capricorn:",
-	"\U0001f1e7\U0001f1ea":                       ":belgium:",
-	"\U0001f4e6":                                 ":package:",
-	"\u2139\ufe0f":                               ":information:",
-	"\U0001f46f":                                 ":dancers:",
-	"\u2763\ufe0f":                               ":heart_exclamation:",
-	"\U0001f578\ufe0f":                           ":spider_web:",
-	"\U0001f1ec\U0001f1e6":                       ":gabon:",
-	"\U0001f9e5":                                 ":coat:",
-	"\U0001f6c5":                                 ":left_luggage:",
-	"\U0001f535":                                 ":blue_circle:",
-	"\U0001f4e3":                                 ":megaphone:",
-	"\U0001f360":                                 ":roasted_sweet_potato:",
-	"\U0001fae4":                                 ":face_with_diagonal_mouth:",
-	"\U0001f4eb":                                 ":mailbox:",
-	"\U0001f9a1":                                 ":badger:",
-	"\U0001f1ec\U0001f1e9":                       ":grenada:",
-	"\U0001f46e\u200d\u2640\ufe0f":               ":policewoman:",
-	"\U0001f9a9":                                 ":flamingo:",
-	"\U0001f3c7":                                 ":horse_racing:",
-	"\U0001f1f2\U0001f1f2":                       ":flag_for_myanmar_burma:",
-	"\U0001faa2":                                 ":knot:",
-	"\U0001fa96":                                 ":military_helmet:",
-	"\U0001f468\u200d\U0001f373":                 ":man_cook:",
-	"\u274e":                                     ":negative_squared_cross_mark:",
-	"\u2649":                                     ":taurus:",
-	"9":                                          ":keycap_9:",
-	"\U0001f9b3":                                 ":white_hair:",
-	"4":                                          ":keycap_4:",
-	"\U0001f9a3":                                 ":mammoth:",
-	"\U0001f451":                                 ":crown:",
-	"\U0001f1ec\U0001f1f6":                       ":flag_for_equatorial_guinea:",
-	"\u23ee\ufe0f":                               ":last_track_button:",
-	"\U0001f1f5\U0001f1ea":                       ":peru:",
-	"\U0001f1f8\U0001f1f1":                       ":flag_for_sierra_leone:",
-	"\U0001f3ca\u200d\u2642\ufe0f":               ":swimming_man:",
-	"\U0001f999":                                 ":llama:",
-	"\U0001f1f0\U0001f1ec":                       ":flag_for_kyrgyzstan:",
-	"\U0001f234":                                 ":japanese_passing_grade_button:",
-	"\U0001f482\u200d\u2640\ufe0f":               ":woman_guard:",
-	"\U0001f3a0":                                 ":carousel_horse:",
-	"\U0001f550":                                 ":clock1:",
-	"\U0001f47a":                                 ":goblin:",
-	"\U0001f440":                                 ":eyes:",
-	"\U0001f5de\ufe0f":                           ":newspaper_roll:",
-	"\U0001f425":                                 ":hatched_chick:",
-	"\U0001f6e1\ufe0f":                           ":shield:",
-	"\u231b":                                     ":hourglass:",
-	"\U0001f6ae":                                 ":litter_in_bin_sign:",
-	"\U0001f697":                                 ":red_car:",
-	"\U0001f9d1\u200d\U0001f9b0":                 ":person_red_hair:",
-	"\U0001f1f2\U0001f1fd":                       ":flag_for_mexico:",
-	"\u26f1\ufe0f":                               ":umbrella_on_ground:",
-	"\U0001f40f":                                 ":ram:",
-	"\U0001fad7":                                 ":pouring_liquid:",
-	"\U0001f1e9\U0001f1ef":                       ":djibouti:",
-	"\U0001f9fa":                                 ":basket:",
-	"\U0001f332":                                 ":evergreen_tree:",
-	"\u2328\ufe0f":                               ":keyboard:",
-	"\U0001f463":                                 ":footprints:",
-	"\U0001f1f2\U0001f1ed":                       ":marshall_islands:",
-	"\U0001f4ec":                                 ":mailbox_with_mail:",
-	"\U0001f636\u200d\U0001f32b\ufe0f":           ":face_in_clouds:",
-	"\U0001f3aa":                                 ":circus_tent:",
-	"\U0001f956":                                 ":baguette_bread:",
-	"\U0001f1f0\U0001f1f5":                       ":flag_for_north_korea:",
-	"\U0001f1ec\U0001f1ee":                       ":gibraltar:",
-	"\U0001f5c3\ufe0f":                           ":card_file_box:",
-	"\U0001f1e6\U0001f1ee":                       ":anguilla:",
-	"\U0001f4e7":                                 ":e_mail:",
-	"\U0001f93e\u200d\u2642\ufe0f":               ":man_playing_handball:",
-	"\U0001f4f7":                                 ":camera:",
-	"\U0001f564":                                 ":clock930:",
-	"2":                                          ":keycap_2:",
-	"\U0001f60b":                                 ":face_savoring_food:",
-	"\U0001f6dd":                                 ":playground_slide:",
-	"\U0001f58a\ufe0f":                           ":pen:",
-	"\U0001f1ef\U0001f1f2":                       ":flag_for_jamaica:",
-	"\U0001f976":                                 ":cold_face:",
-	"\u270d\ufe0f":                               ":writing_hand:",
-	"\U0001fad6":                                 ":teapot:",
-	"\U0001f3e3":                                 ":post_office:",
-	"\U0001f53b":                                 ":small_red_triangle_down:",
-	"\U0001f647\u200d\u2642\ufe0f":               ":man_bowing:",
-	"\U0001fa79":                                 ":adhesive_bandage:",
-	"\U0001f988":                                 ":shark:",
-	"\U0001f4b1":                                 ":currency_exchange:",
-	"\U0001f44a":                                 ":fist_oncoming:",
-	"\U0001f468\u200d\U0001f9b3":                 ":man_with_white_hair:",
-	"\u2935\ufe0f":                               ":right_arrow_curving_down:",
-	"\U0001f9dd\u200d\u2642\ufe0f":               ":elf_man:",
-	"\U0001f7e6":                                 ":blue_square:",
-	"\U0001f3c0":                                 ":basketball:",
-	"\U0001f954":                                 ":potato:",
-	"\U0001f22f":                                 ":u6307:",
-	"\U0001f337":                                 ":tulip:",
-	"\U0001f18e":                                 ":ab:",
-	"\U0001f1f9\U0001f1ef":                       ":tajikistan:",
-	"\U0001f47f":                                 ":angry_face_with_horns:",
-	"\U0001f1f4\U0001f1f2":                       ":oman:",
-	"\U0001f970":                                 ":smiling_face_with_three_hearts:",
-	"\U0001f468":                                 ":man:",
-	"\U0001f5d3\ufe0f":                           ":spiral_calendar:",
-	"\U0001f3e9":                                 ":love_hotel:",
-	"\U0001f9fc":                                 ":soap:",
-	"\U0001f21a":                                 ":u7121:",
-	"\U0001f1ea\U0001f1f7":                       ":flag_for_eritrea:",
-	"\U0001f50a":                                 ":speaker_high_volume:",
-	"\U0001fa7c":                                 ":crutch:",
-	"\U0001f642":                                 ":slightly_smiling_face:",
-	"\U0001f382":                                 ":birthday:",
-	"\U0001f46d":                                 ":women_holding_hands:",
-	"\u270a":                                     ":fist:",
-	"\U0001f195":                                 ":new_button:",
-	"\u26a1":                                     ":high_voltage:",
-	"\U0001f1ec\U0001f1eb":                       ":french_guiana:",
-	"\U0001f632":                                 ":astonished:",
-	"\U0001f468\u200d\U0001f9bd":                 ":man_in_manual_wheelchair:",
-	"\U0001f68a":                                 ":tram:",
-	"\u3297\ufe0f":                               ":japanese_congratulations_button:",
-	"\U0001f53a":                                 ":small_red_triangle:",
-	"\U0001f92a":                                 ":zany_face:",
-	"\U0001f50e":                                 ":mag_right:",
-	"\U0001f31f":                                 ":glowing_star:",
-	"\U0001f478":                                 ":princess:",
-	"\U0001f512":                                 ":lock:",
-	"\U0001f449":                                 ":point_right:",
-	"\U0001f6cc":                                 ":person_in_bed:",
-	"\U0001f1f1\U0001f1e7":                       ":flag_for_lebanon:",
-	"\U0001f516":                                 ":bookmark:",
-	"\U0001f46f\u200d\u2642\ufe0f":               ":dancing_men:",
-	"\U0001f1ea\U0001f1ed":                       ":flag_for_western_sahara:",
-	"\U0001f31d":                                 ":full_moon_with_face:",
-	"\U0001f312":                                 ":waxing_crescent_moon:",
-	"\U0001fa73":                                 ":shorts:",
-	"\U0001f1f5\U0001f1e6":                       ":flag_for_panama:",
-	"\U0001f9e6":                                 ":socks:",
-	"\u2795":                                     ":heavy_plus_sign:",
-	"\U0001f469\u200d\U0001f9af":                 ":woman_with_white_cane:",
-	"\U0001f69a":                                 ":delivery_truck:",
-	"\U0001f482":                                 ":guard:",
-	"\U0001f1f5\U0001f1f0":                       ":flag_for_pakistan:",
-	"\U0001f4e0":                                 ":fax:",
-	"\U0001f239":                                 ":u5272:",
-	"\U0001f9cc":                                 ":troll:",
-	"\U0001f1f8\U0001f1f2":                       ":san_marino:",
-	"\U0001f351":                                 ":peach:",
-	"\U0001f997":                                 ":cricket:",
-	"\U0001f3f9":                                 ":bow_and_arrow:",
-	"0\ufe0f\u20e3":                              ":zero:",
-	"\U0001fa9c":                                 ":ladder:",
-	"9\ufe0f\u20e3":                              ":keycap_9:",
-	"\U0001f40d":                                 ":snake:",
-	"\U0001f7e2":                                 ":green_circle:",
-	"\U0001f940":                                 ":wilted_flower:",
-	"\U0001f442":                                 ":ear:",
-	"\U0001f939":                                 ":person_juggling:",
-	"\U0001f6fc":                                 ":roller_skate:",
-	"\U0001f493":                                 ":heartbeat:",
-	"\u2614":                                     ":umbrella:",
-	"\U0001f309":                                 ":bridge_at_night:",
-	"\U0001f5dc\ufe0f":                           ":clamp:",
-	"\U0001f515":                                 ":no_bell:",
-	"\U0001f3d3":                                 ":ping_pong:",
-	"\U0001f929":                                 ":star_struck:",
-	"\U0001f4ad":                                 ":thought_balloon:",
-	"\U0001f919":                                 ":call_me_hand:",
-	"\U0001f350":                                 ":pear:",
-	"\u2622\ufe0f":                               ":radioactive:",
-	"\U0001f942":                                 ":clinking_glasses:",
-	"\U0001f633":                                 ":flushed_face:",
-	"\U0001f3f3\ufe0f":                           ":white_flag:",
-	"\U0001f450":                                 ":open_hands:",
-	"\U0001f64d\u200d\u2640\ufe0f":               ":woman_frowning:",
-	"\U0001f987":                                 ":bat:",
-	"\U0001faa7":                                 ":placard:",
-	"\U0001f40a":                                 ":crocodile:",
-	"\U0001f202\ufe0f":                           ":japanese_service_charge_button:",
-	"\U0001f365":                                 ":fish_cake_with_swirl:",
-	"\U0001f5f3\ufe0f":                           ":ballot_box_with_ballot:",
-	"\U0001f937":                                 ":person_shrugging:",
-	"\U0001f1ed\U0001f1fa":                       ":flag_for_hungary:",
-	"\U0001f39b\ufe0f":                           ":control_knobs:",
-	"\U0001f6b6\u200d\u2640\ufe0f":               ":walking_woman:",
-	"\U0001f482\u200d\u2642\ufe0f":               ":man_guard:",
-	"\U0001f6b9":                                 ":mens:",
-	"\U0001f518":                                 ":radio_button:",
-	"\U0001faf6":                                 ":heart_hands:",
-	"\U0001f9d1\u200d\U0001f527":                 ":mechanic:",
-	"\U0001faac":                                 ":hamsa:",
-	"\U0001f487":                                 ":haircut:",
-	"\U0001f236":                                 ":u6709:",
-	"\U0001f50d":                                 ":magnifying_glass_tilted_left:",
-	"\U0001f30d":                                 ":earth_africa:",
-	"\U0001f372":                                 ":pot_of_food:",
-	"\U0001f468\u200d\U0001f469\u200d\U0001f467\u200d\U0001f466": ":family_man_woman_girl_boy:",
-	"\U0001f91d":                 ":handshake:",
-	"\U0001f536":                 ":large_orange_diamond:",
-	"\U0001f9d1\u200d\U0001f9bd": ":person_in_manual_wheelchair:",
-	"\u231a":                     ":watch:",
-	"\U0001f4f0":                 ":newspaper:",
-	"\u26ce":                     ":ophiuchus:",
-	"\U0001f396\ufe0f":           ":military_medal:",
-	"\U0001f6d2":                 ":shopping_cart:",
-	"\U0001f3e0":                 ":house:",
-	"\U0001f1e9\U0001f1ff":       ":flag_for_algeria:",
-	"\U0001f1e8\U0001f1fd":       ":christmas_island:",
-	"\U0001f3e5":                 ":hospital:",
-	"\U0001f4ef":                 ":postal_horn:",
-	"\U0001f1f7\U0001f1fc":       ":rwanda:",
-	"\U0001f469\u200d\u2764\ufe0f\u200d\U0001f468": ":couple_with_heart_woman_man:",
-	"\U0001f9ad":                         ":seal:",
-	"\U0001f464":                         ":bust_in_silhouette:",
-	"\U0001f9d4\u200d\u2640\ufe0f":       ":woman_with_beard:",
-	"\U0001f9d1\u200d\U0001f3a4":         ":singer:",
-	"\U0001f1e7\U0001f1fe":               ":flag_for_belarus:",
-	"\U0001f61a":                         ":kissing_face_with_closed_eyes:",
-	"\U0001f330":                         ":chestnut:",
-	"\U0001f199":                         ":up:",
-	"\U0001f99c":                         ":parrot:",
-	"\U0001f378":                         ":cocktail_glass:",
-	"\U0001f926\u200d\u2642\ufe0f":       ":man_facepalming:",
-	"\U0001f9f9":                         ":broom:",
-	"\u26a7\ufe0f":                       ":transgender_symbol:",
-	"\U0001f1f3\U0001f1eb":               ":flag_for_norfolk_island:",
-	"\U0001f469\u200d\u2696\ufe0f":       ":woman_judge:",
-	"\U0001f1f0\U0001f1fe":               ":cayman_islands:",
-	"\U0001f1f5\U0001f1f2":               ":flag_for_st_pierre_and_miquelon:",
-	"\U0001f6f6":                         ":canoe:",
-	"\U0001f1eb\U0001f1f0":               ":flag_for_falkland_islands:",
-	"\U0001f9f4":                         ":lotion_bottle:",
-	"\U0001f685":                         ":bullet_train:",
-	"\U0001f54c":                         ":mosque:",
-	"\U0001f3ba":                         ":trumpet:",
-	"\U0001f9d9\u200d\u2642\ufe0f":       ":mage_man:",
-	"\U0001f587\ufe0f":                   ":linked_paperclips:",
-	"\U0001f520":                         ":capital_abcd:",
-	"\U0001f606":                         ":laughing:",
-	"\U0001f974":                         ":woozy_face:",
-	"\U0001f9d1\u200d\u2695\ufe0f":       ":health_worker:",
-	"\U0001f4df":                         ":pager:",
-	"\U0001f603":                         ":smiley:",
-	"\U0001f61e":                         ":disappointed:",
-	"\U0001f3cc\ufe0f\u200d\u2642\ufe0f": ":man_golfing:",
-	"\U0001f3ca\u200d\u2640\ufe0f":       ":woman_swimming:",
-	"\U0001f6be":                         ":water_closet:",
-	"\U0001f1f7\U0001f1f8":               ":flag_for_serbia:",
-	"\U0001f9ff":                         ":nazar_amulet:",
-	"\U0001f3a7":                         ":headphone:",
-	"\u2b05\ufe0f":                       ":arrow_left:",
-	"\U0001f4c2":                         ":open_file_folder:",
-	"\u2639\ufe0f":                       ":frowning_face:",
-	"\U0001f370":                         ":shortcake:",
-	"\u270c\ufe0f":                       ":victory_hand:",
-	"\U0001f45b":                         ":purse:",
-	"\U0001f938\u200d\u2642\ufe0f":       ":man_cartwheeling:",
-	"\U0001f3f0":                         ":castle:",
-	"\u2744\ufe0f":                       ":snowflake:",
-	"\U0001f992":                         ":giraffe:",
-	"\U0001f1e6\U0001f1f7":               ":argentina:",
-	"\U0001f533":                         ":white_square_button:",
-	"\U0001f9bf":                         ":mechanical_leg:",
-	"\U0001f529":                         ":nut_and_bolt:",
-	"\U0001f61f":                         ":worried:",
-	"\U0001f45f":                         ":running_shoe:",
-	"\U0001f4fc":                         ":videocassette:",
-	"\U0001f1f2\U0001f1f6":               ":flag_for_martinique:",
-	"\U0001f4c4":                         ":page_facing_up:",
-	"\U0001f3f3\ufe0f\u200d\u26a7\ufe0f": ":transgender_flag:",
-	"\U0001f69e":                         ":mountain_railway:",
-	"\U0001f95d":                         ":kiwi_fruit:",
-	"\U0001f6ce\ufe0f":                   ":bellhop_bell:",
-	"\U0001f485":                         ":nail_care:",
-	"\U0001f1e6\U0001f1f6":               ":antarctica:",
-	"\U0001f408":                         ":cat2:",
-	"\U0001f1f9\U0001f1ed":               ":flag_for_thailand:",
-	"\U0001f90f":                         ":pinching_hand:",
-	"\U0001f9db":                         ":vampire:",
-	"\U0001f355":                         ":pizza:",
-	"\U0001f3b2":                         ":game_die:",
-	"\U0001fab1":                         ":worm:",
-	"\u2733\ufe0f":                       ":eight_spoked_asterisk:",
-	"\U0001f471\u200d\u2642\ufe0f":       ":man_with_blond_hair:",
-	"\U0001f303":                         ":night_with_stars:",
-	"\U0001f959":                         ":stuffed_flatbread:",
-	"\U0001f1f3\U0001f1e6":               ":namibia:",
-	"\U0001f646":                         ":person_gesturing_ok:",
-	"\U0001f9d6\u200d\u2640\ufe0f":       ":sauna_woman:",
-	"\U0001f690":                         ":minibus:",
-	"\U0001f98d":                         ":gorilla:",
-	"\U0001f4f1":                         ":iphone:",
-	"\U0001f6e0\ufe0f":                   ":hammer_and_wrench:",
-	"\U0001f3ef":                         ":japanese_castle:",
-	"\U0001f477\u200d\u2640\ufe0f":       ":construction_worker_woman:",
-	"\U0001fae0":                         ":melting_face:",
-	"\U0001f301":                         ":foggy:",
-	"\U0001f621":                         ":rage:",
-	"\U0001f496":                         ":sparkling_heart:",
-	"\u2705":                             ":check_mark_button:",
-	"\U0001f612":                         ":unamused_face:",
-	"\u270b":                             ":raised_hand:",
-	"\U0001f977":                         ":ninja:",
-	"\u2618\ufe0f":                       ":shamrock:",
-	"\U0001f93d\u200d\u2642\ufe0f":       ":man_playing_water_polo:",
-	"\U0001f3ac":                         ":clapper:",
-	"\U0001f1f8\U0001f1ef":               ":flag_for_svalbard_and_jan_mayen:",
-	"\U0001f233":                         ":japanese_vacancy_button:",
-	"\U0001f415\u200d\U0001f9ba":         ":service_dog:",
-	"\U0001f468\u200d\u2696\ufe0f":       ":man_judge:",
-	"\U0001f69c":                         ":tractor:",
-	"\U0001f68d":                         ":oncoming_bus:",
-	"\U0001f1f5\U0001f1fe":               ":paraguay:",
-	"\U0001f384":                         ":christmas_tree:",
-	"\U0001f9c6":                         ":falafel:",
-	"\U0001f1ec\U0001f1f9":               ":guatemala:",
-	"\U0001f6d0":                         ":place_of_worship:",
-	"\U0001f1f0\U0001f1f3":               ":st_kitts_nevis:",
-	"\U0001f939\u200d\u2642\ufe0f":       ":man_juggling:",
-	"\U0001f1f2\U0001f1e9":               ":flag_for_moldova:",
-	"\U0001f61b":                         ":face_with_tongue:",
-	"\U0001f1e6\U0001f1f8":               ":flag_for_american_samoa:",
-	"\U0001f9a2":                         ":swan:",
-	"\U0001f565":                         ":clock1030:",
-	"\u2764\ufe0f":                       ":red_heart:",
-	"\U0001f626":                         ":frowning:",
-	"\U0001f469\u200d\U0001f9bd":         ":woman_in_manual_wheelchair:",
-	"\U0001fac2":                         ":people_hugging:",
-	"\u2b55":                             ":o:",
-	"\U0001f1e6\U0001f1e8":               ":ascension_island:",
-	"\u269b\ufe0f":                       ":atom_symbol:",
-	"\U0001f4cb":                         ":clipboard:",
-	"\U0001f1ec\U0001f1e7":               ":gb:",
-	"\U0001f4ab":                         ":dizzy:",
-	"\u2652":                             ":aquarius:",
-	"\u2934\ufe0f":                       ":arrow_heading_up:",
-	"\U0001f64e":                         ":pouting_face:",
-	"\U0001f1ef\U0001f1f5":               ":flag_for_japan:",
-	"\U0001f647\u200d\u2640\ufe0f":       ":woman_bowing:",
-	"\U0001f1f2\U0001f1e8":               ":flag_for_monaco:",
-	"\U0001f98b":                         ":butterfly:",
-	"\U0001fae7":                         ":bubbles:",
-	"\U0001faf4":                         ":palm_up_hand:",
-	"\U0001f476":                         ":baby:",
-	"\U0001f623":                         ":persevere:",
-	"\U0001f590\ufe0f":                   ":raised_hand_with_fingers_splayed:",
-	"\U0001f90e":                         ":brown_heart:",
-	"\U0001f630":                         ":cold_sweat:",
-	"\U0001f6a7":                         ":construction:",
-	"\U0001f37b":                         ":beers:",
-	"\U0001f509":                         ":sound:",
-	"\U0001f38c":                         ":crossed_flags:",
-	"\u2694\ufe0f":                       ":crossed_swords:",
-	"\U0001f1f3\U0001f1ee":               ":flag_for_nicaragua:",
-	"\U0001f49d":                         ":gift_heart:",
-	"\U0001f400":                         ":rat:",
-	"\U0001f551":                         ":clock2:",
-	"\U0001f3b0":                         ":slot_machine:",
-	"\U0001f469\u200d\U0001f37c":         ":woman_feeding_baby:",
-	"\U0001f4a2":                         ":anger:",
-	"\U0001f1f2\U0001f1f3":               ":flag_for_mongolia:",
-	"\U0001f52f":                         ":six_pointed_star:",
-	"\U0001f62e":                         ":face_with_open_mouth:",
-	"\u27bf":                             ":double_curly_loop:",
-	"\u25ab\ufe0f":                       ":white_small_square:",
-	"\U0001f304":                         ":sunrise_over_mountains:",
-	"\U0001f42e":                         ":cow:",
-	"\U0001f9b7":                         ":tooth:",
-	"\U0001f1e6\U0001f1f4":               ":flag_for_angola:",
-	"\U0001f9d6":                         ":sauna_person:",
-	"\U0001f1ee\U0001f1f8":               ":iceland:",
-	"\U0001f62f":                         ":hushed_face:",
-	"\U0001f1f2\U0001f1fe":               ":malaysia:",
-	"\U0001f1f8\U0001f1fd":               ":sint_maarten:",
-	"\U0001f3c2":                         ":snowboarder:",
-	"\U0001f5a5\ufe0f":                   ":desktop_computer:",
-	"\u26ab":                             ":black_circle:",
-	"\U0001f6c4":                         ":baggage_claim:",
-	"\U0001fa82":                         ":parachute:",
-	"\U0001f9c7":                         ":waffle:",
-	"\U0001f486\u200d\u2642\ufe0f":       ":man_getting_massage:",
-	"\U0001f93c\u200d\u2640\ufe0f":       ":women_wrestling:",
-	"\U0001f469\u200d\U0001f3eb":         ":woman_teacher:",
-	"\U0001f958":                         ":shallow_pan_of_food:",
-	"\U0001f1eb\U0001f1f7":               ":fr:",
-	"\U0001f9d8":                         ":lotus_position:",
-	"\U0001f983":                         ":turkey:",
-	"\U0001f608":                         ":smiling_face_with_horns:",
-	"\U0001f432":                         ":dragon_face:",
-	"\U0001f978":                         ":disguised_face:",
-	"\U0001f1e6\U0001f1f1":               ":albania:",
-	"\U0001f965":                         ":coconut:",
-	"\U0001f455":                         ":shirt:",
-	"\U0001f468\u200d\U0001f466":         ":family_man_boy:",
-	"\U0001f9ab":                         ":beaver:",
-	"\U0001f31e":                         ":sun_with_face:",
-	"\U0001f3cf":                         ":cricket_game:",
-	"\U0001f9f1":                         ":brick:",
-	"\U0001f3d2":                         ":ice_hockey:",
-	"\U0001f1fa\U0001f1ff":               ":flag_for_uzbekistan:",
-	"\U0001f170\ufe0f":                   ":a:",
-	"\U0001f9ce\u200d\u2642\ufe0f":       ":kneeling_man:",
-	"\U0001f468\u200d\U0001f468\u200d\U0001f466": ":family_man_man_boy:",
-	"\U0001f927":                   ":sneezing_face:",
-	"\U0001f341":                   ":maple_leaf:",
-	"\U0001f62c":                   ":grimacing_face:",
-	"\U0001f60a":                   ":smiling_face_with_smiling_eyes:",
-	"\U0001f692":                   ":fire_engine:",
-	"\U0001f348":                   ":melon:",
-	"\U0001f6d7":                   ":elevator:",
-	"\U0001f6a4":                   ":speedboat:",
-	"\U0001f250":                   ":japanese_bargain_button:",
-	"\U0001f1f9\U0001f1f0":         ":tokelau:",
-	"\U0001f9ef":                   ":fire_extinguisher:",
-	"\u21aa\ufe0f":                 ":left_arrow_curving_right:",
-	"\U0001f438":                   ":frog:",
-	"\U0001f6b2":                   ":bicycle:",
-	"\U0001f9d3":                   ":older_adult:",
-	"\U0001f3a6":                   ":cinema:",
-	"\U0001f481\u200d\u2640\ufe0f": ":woman_tipping_hand:",
-	"\U0001f469\u200d\U0001f469\u200d\U0001f466": ":family_woman_woman_boy:",
-	"\U0001f3cb\ufe0f\u200d\u2640\ufe0f":         ":weight_lifting_woman:",
-	"\u2604\ufe0f":                               ":comet:",
-	"\U0001f9b4":                                 ":bone:",
-	"\U0001f96e":                                 ":moon_cake:",
-	"\U0001f1e6\U0001f1ea":                       ":flag_for_united_arab_emirates:",
-	"\U0001f635":                                 ":dizzy_face:",
-	"\U0001fa99":                                 ":coin:",
-	"\U0001f467":                                 ":girl:",
-	"\U0001f459":                                 ":bikini:",
-	"\U0001f9ae":                                 ":guide_dog:",
-	"\U0001f955":                                 ":carrot:",
-	"\u264e":                                     ":libra:",
-	"\U0001fab6":                                 ":feather:",
-	"\U0001f358":                                 ":rice_cracker:",
-	"\U0001f414":                                 ":chicken:",
-	"\U0001f9d4":                                 ":person_with_beard:",
-	"\U0001f48d":                                 ":ring:",
-	"\U0001f3c4":                                 ":surfer:",
-	"\U0001f413":                                 ":rooster:",
-	"\U0001f5b2\ufe0f":                           ":trackball:",
-	"\u2753":                                     ":question:",
-	"\U0001f3d7\ufe0f":                           ":building_construction:",
-	"\U0001f990":                                 ":shrimp:",
-	"\U0001f201":                                 ":koko:",
-	"\U0001f3b7":                                 ":saxophone:",
-	"\U0001faf3":                                 ":palm_down_hand:",
-	"\U0001f1e9\U0001f1f2":                       ":dominica:",
-	"\U0001f1ea\U0001f1ec":                       ":egypt:",
-	"\U0001f1f9\U0001f1f9":                       ":trinidad_tobago:",
-	"\U0001f419":                                 ":octopus:",
-	"\U0001f31a":                                 ":new_moon_face:",
-	"\U0001f36f":                                 ":honey_pot:",
-	"\U0001f69f":                                 ":suspension_railway:",
-	"\U0001fad9":                                 ":jar:",
-	"\U0001f6d1":                                 ":stop_sign:",
-	"\U0001f3e8":                                 ":hotel:",
-	"\U0001fa70":                                 ":ballet_shoes:",
-	"\u269c\ufe0f":                               ":fleur_de_lis:",
-	"\U0001f4bd":                                 ":computer_disk:",
-	"\U0001f1ee\U0001f1f9":                       ":flag_for_italy:",
-	"\U0001f40e":                                 ":racehorse:",
-	"\U0001f502":                                 ":repeat_one:",
-	"6\ufe0f\u20e3":                              ":keycap_6:",
-	"\u26fd":                                     ":fuelpump:",
-	"\u26fa":                                     ":tent:",
-	"\U0001fac1":                                 ":lungs:",
-	"\U0001f9f0":                                 ":toolbox:",
-	"\U0001f52d":                                 ":telescope:",
-	"\U0001f52a":                                 ":kitchen_knife:",
-	"\U0001f638":                                 ":grinning_cat_with_smiling_eyes:",
-	"\u2640\ufe0f":                               ":female_sign:",
-	"\U0001f3cc\ufe0f\u200d\u2640\ufe0f":         ":woman_golfing:",
-	"\u2699\ufe0f":                               ":gear:",
-	"\U0001f429":                                 ":poodle:",
-	"7\ufe0f\u20e3":                              ":keycap_7:",
-	"\U0001f9df\u200d\u2642\ufe0f":               ":zombie_man:",
-	"\U0001f94d":                                 ":lacrosse:",
-	"\U0001f5c2\ufe0f":                           ":card_index_dividers:",
-	"\U0001f9cd\u200d\u2642\ufe0f":               ":man_standing:",
-	"\U0001f1f9\U0001f1e9":                       ":chad:",
-	"\u27a1\ufe0f":                               ":right_arrow:",
-	"\U0001f7e0":                                 ":orange_circle:",
-	"\u26b0\ufe0f":                               ":coffin:",
-	"\U0001f41a":                                 ":spiral_shell:",
-	"\U0001f1e7\U0001f1f7":                       ":brazil:",
-	"\U0001f52b":                                 ":water_pistol:",
-	"\U0001f627":                                 ":anguished_face:",
-	"\U0001f49e":                                 ":revolving_hearts:",
-	"\U0001f468\u200d\U0001f467":                 ":family_man_girl:",
-	"\U0001f9da\u200d\u2640\ufe0f":               ":woman_fairy:",
-	"\U0001f6a8":                                 ":rotating_light:",
-	"\U0001f454":                                 ":necktie:",
-	"\U0001f1fb\U0001f1ea":                       ":flag_for_venezuela:",
-	"\U0001f4d5":                                 ":closed_book:",
-	"\U0001f469\u200d\U0001f469\u200d\U0001f467": ":family_woman_woman_girl:",
-	"\U0001f55a":                             ":eleven_o_clock:",
-	"\U0001f3f3\ufe0f\u200d\U0001f308":       ":rainbow_flag:",
-	"\U0001f945":                             ":goal_net:",
-	"\U0001f495":                             ":two_hearts:",
-	"\U0001f7eb":                             ":brown_square:",
-	"\u262a\ufe0f":                           ":star_and_crescent:",
-	"\U0001f1e8\U0001f1f3":                   ":flag_for_china:",
-	"\U0001f44b":                             ":wave:",
-	"\u23eb":                                 ":fast_up_button:",
-	"\U0001f695":                             ":taxi:",
-	"\U0001fa86":                             ":nesting_dolls:",
-	"\U0001f693":                             ":police_car:",
-	"\U0001f91e":                             ":crossed_fingers:",
-	"\U0001fab9":                             ":empty_nest:",
-	"\U0001f1f2\U0001f1e6":                   ":flag_for_morocco:",
-	"\U0001f636":                             ":face_without_mouth:",
-	"\U0001f4ce":                             ":paperclip:",
-	"\U0001f3d8\ufe0f":                       ":houses:",
-	"\U0001f554":                             ":clock5:",
-	"\u2709\ufe0f":                           ":envelope:",
-	"\U0001f6b7":                             ":no_pedestrians:",
-	"\U0001f4cd":                             ":round_pushpin:",
-	"\U0001f1ef\U0001f1ea":                   ":flag_for_jersey:",
-	"\U0001f1e8\U0001f1e6":                   ":flag_for_canada:",
-	"\U0001f562":                             ":clock730:",
-	"\U0001f93a":                             ":person_fencing:",
-	"\U0001f352":                             ":cherries:",
-	"\u2665\ufe0f":                           ":heart_suit:",
-	"\U0001f1f1\U0001f1e6":                   ":laos:",
-	"\U0001f468\u200d\U0001f393":             ":man_student:",
-	"\U0001f37c":                             ":baby_bottle:",
-	"\U0001f300":                             ":cyclone:",
-	"\U0001f1f8\U0001f1ec":                   ":singapore:",
-	"\u274c":                                 ":cross_mark:",
-	"\U0001f1e7\U0001f1fb":                   ":flag_for_bouvet_island:",
-	"\u26f4\ufe0f":                           ":ferry:",
-	"\U0001f54a\ufe0f":                       ":dove:",
-	"\U0001f1f9\U0001f1ec":                   ":togo:",
-	"\U0001f3b4":                             ":flower_playing_cards:",
-	"\U0001f99b":                             ":hippopotamus:",
-	"\U0001f363":                             ":sushi:",
-	"\U0001f4c1":                             ":file_folder:",
-	"\U0001f1e6\U0001f1f2":                   ":flag_for_armenia:",
-	"\U0001f469\u200d\U0001f3ed":             ":woman_factory_worker:",
-	"\U0001f1f9\U0001f1f7":                   ":tr:",
-	"\U0001f52e":                             ":crystal_ball:",
-	"\U0001f91b":                             ":fist_left:",
-	"\U0001f33c":                             ":blossom:",
-	"\U0001f92c":                             ":face_with_symbols_on_mouth:",
-	"\U0001f435":                             ":monkey_face:",
-	"\U0001f95e":                             ":pancakes:",
-	"\U0001f3df\ufe0f":                       ":stadium:",
-	"\U0001f1f1\U0001f1fb":                   ":latvia:",
-	"\u2195\ufe0f":                           ":arrow_up_down:",
-	"\U0001f7e8":                             ":yellow_square:",
-	"\U0001f9c4":                             ":garlic:",
-	"\U0001f575\ufe0f":                       ":detective:",
-	"\U0001f1e7\U0001f1e9":                   ":bangladesh:",
-	"\U0001f420":                             ":tropical_fish:",
-	"\U0001f469\u200d\U0001f3a8":             ":woman_artist:",
-	"\U0001f97b":                             ":sari:",
-	"\U0001f1e7\U0001f1f6":                   ":caribbean_netherlands:",
-	"\U0001f9ca":                             ":ice_cube:",
-	"\U0001f3cb\ufe0f":                       ":person_lifting_weights:",
-	"\U0001f1f1\U0001f1f9":                   ":lithuania:",
-	"\U0001f469\u200d\u2695\ufe0f":           ":woman_health_worker:",
-	"\u3299\ufe0f":                           ":japanese_secret_button:",
-	"\U0001f63e":                             ":pouting_cat:",
-	"\U0001f5b1\ufe0f":                       ":computer_mouse:",
-	"\U0001f9a5":                             ":sloth:",
-	"\U0001f98e":                             ":lizard:",
-	"\U0001f3fb":                             ":light_skin_tone:",
-	"\U0001f409":                             ":dragon:",
-	"\U0001f9e0":                             ":brain:",
-	"\U0001f1e8\U0001f1f5":                   ":flag_for_clipperton_island:",
-	"\u2755":                                 ":white_exclamation_mark:",
-	"\U0001f4b7":                             ":pound_banknote:",
-	"\U0001f9d1\u200d\U0001f9b2":             ":person_bald:",
-	"\U0001fac4":                             ":pregnant_person:",
-	"\u26f5":                                 ":sailboat:",
-	"\U0001fa98":                             ":long_drum:",
-	"\U0001f3ad":                             ":performing_arts:",
-	"\U0001f46f\u200d\u2640\ufe0f":           ":women_with_bunny_ears:",
-	"\U0001f1f2\U0001f1f1":                   ":mali:",
-	"\U0001f6e9\ufe0f":                       ":small_airplane:",
-	"\U0001f3bc":                             ":musical_score:",
-	"\u2603\ufe0f":                           ":snowman_with_snow:",
-	"\U0001f1ed\U0001f1f9":                   ":flag_for_haiti:",
-	"\U0001f94c":                             ":curling_stone:",
-	"\U0001f566":                             ":clock1130:",
-	"\U0001f1f8\U0001f1fb":                   ":flag_for_el_salvador:",
-	"\U0001f393":                             ":graduation_cap:",
-	"\U0001fa91":                             ":chair:",
-	"\U0001f975":                             ":hot_face:",
-	"\U0001f525":                             ":fire:",
-	"\U0001f3d6\ufe0f":                       ":beach_with_umbrella:",
-	"\U0001f468\u200d\U0001f527":             ":man_mechanic:",
-	"\U0001f97c":                             ":lab_coat:",
-	"\U0001f1e8\U0001f1eb":                   ":central_african_republic:",
-	"\U0001f500":                             ":twisted_rightwards_arrows:",
-	"\U0001f64a":                             ":speak_no_evil:",
-	"\U0001f1ec\U0001f1fc":                   ":flag_for_guinea_bissau:",
-	"\U0001f48c":                             ":love_letter:",
-	"\U0001f9d1\u200d\U0001f9bc":             ":person_in_motorized_wheelchair:",
-	"\U0001f498":                             ":heart_with_arrow:",
-	"\U0001f58b\ufe0f":                       ":fountain_pen:",
-	"\U0001f4c5":                             ":date:",
-	"\U0001f989":                             ":owl:",
-	"\U0001f441\ufe0f\u200d\U0001f5e8\ufe0f": ":eye_speech_bubble:",
-	"\U0001f422":                             ":turtle:",
-	"\U0001fa74":                             ":thong_sandal:",
-	"\U0001f34c":                             ":banana:",
-	"\U0001fa9f":                             ":window:",
-	"\U0001f196":                             ":ng:",
-	"\U0001f6e3\ufe0f":                       ":motorway:",
-	"\U0001f9d7\u200d\u2642\ufe0f":           ":climbing_man:",
-	"\U0001f4c7":                             ":card_index:",
-	"\U0001f9de\u200d\u2642\ufe0f":           ":man_genie:",
-	"1":                                      ":one:",
-	"\U0001f5a4":                             ":black_heart:",
-	"\U0001f6f0\ufe0f":                       ":artificial_satellite:",
-	"\U0001f3e7":                             ":atm_sign:",
-	"\u2600\ufe0f":                           ":sun:",
-	"\U0001f63b":                             ":heart_eyes_cat:",
-	"\U0001f952":                             ":cucumber:",
-	"\U0001f359":                             ":rice_ball:",
-	"\U0001f3a8":                             ":artist_palette:",
-	"\U0001f469\u200d\U0001f466\u200d\U0001f466": ":family_woman_boy_boy:",
-	"\U0001f4ea":                   ":mailbox_closed:",
-	"\U0001f460":                   ":high_heeled_shoe:",
-	"\U0001f468\u200d\U0001f4bb":   ":man_technologist:",
-	"\U0001f468\u200d\U0001f3eb":   ":man_teacher:",
-	"\u25fd":                       ":white_medium_small_square:",
-	"\U0001f937\u200d\u2642\ufe0f": ":man_shrugging:",
-	"\U0001f687":                   ":metro:",
-	"\U0001f33a":                   ":hibiscus:",
-	"\U0001f618":                   ":face_blowing_a_kiss:",
-	"\U0001f4a8":                   ":dash:",
-	"\u2716\ufe0f":                 ":multiply:",
-	"#\ufe0f\u20e3":                ":keycap_hash:",
-	"\U0001f9d1\u200d\U0001f680":   ":astronaut:",
-	"\u2122\ufe0f":                 ":tm:",
-	"\U0001f468\u200d\U0001f3ed":   ":man_factory_worker:",
-	"\U0001f6c1":                   ":bathtub:",
-	"\U0001f1f8\U0001f1ea":         ":sweden:",
-	"\U0001f1e7\U0001f1f3":         ":flag_for_brunei:",
-	"\U0001f1ec\U0001f1ec":         ":flag_for_guernsey:",
-	"\u23f1\ufe0f":                 ":stopwatch:",
-	"\U0001f5fe":                   ":japan:",
-	"\U0001f426":                   ":bird:",
-	"\U0001f97a":                   ":pleading_face:",
-	"\U0001f3cc\ufe0f":             ":person_golfing:",
-	"\U0001f3f7\ufe0f":             ":label:",
-	"\U0001f1e7\U0001f1ff":         ":belize:",
-	"\U0001f34a":                   ":mandarin:",
-	"\U0001f194":                   ":id:",
-	"\U0001f9a8":                   ":skunk:",
-	"\U0001f346":                   ":eggplant:",
-	"\U0001f1fe\U0001f1f9":         ":flag_for_mayotte:",
-	"\U0001f468\u200d\U0001f3a8":   ":man_artist:",
-	"\U0001f3e4":                   ":european_post_office:",
-	"\U0001f1f5\U0001f1f8":         ":palestinian_territories:",
-	"\U0001f1f1\U0001f1f7":         ":liberia:",
-	"\U0001f416":                   ":pig2:",
-	"\U0001fad3":                   ":flatbread:",
-	"\U0001f966":                   ":broccoli:",
-	"\U0001f601":                   ":beaming_face_with_smiling_eyes:",
-	"\U0001f1f2\U0001f1f7":         ":flag_for_mauritania:",
-	"\U0001f926\u200d\u2640\ufe0f": ":woman_facepalming:",
-	"\U0001f38d":                   ":bamboo:",
-	"\U0001f4f3":                   ":vibration_mode:",
-	"\U0001f981":                   ":lion:",
-	"\U0001f343":                   ":leaf_fluttering_in_wind:",
-	"\U0001f1f3\U0001f1e8":         ":new_caledonia:",
-	"\U0001f391":                   ":moon_viewing_ceremony:",
-	"\U0001f468\u200d\U0001f468\u200d\U0001f467\u200d\U0001f467": ":family_man_man_girl_girl:",
-	"\U0001f468\u200d\U0001f469\u200d\U0001f466\u200d\U0001f466": ":family_man_woman_boy_boy:",
-	"\U0001f611":                   ":expressionless_face:",
-	"\U0001faf1":                   ":rightwards_hand:",
-	"\U0001f469\u200d\U0001f4bb":   ":woman_technologist:",
-	"\U0001f579\ufe0f":             ":joystick:",
-	"\U0001f1f8\U0001f1f3":         ":flag_for_senegal:",
-	"\U0001f944":                   ":spoon:",
-	"\U0001f574\ufe0f":             ":business_suit_levitating:",
-	"\u00a9\ufe0f":                 ":copyright:",
-	"\U0001f6af":                   ":no_littering:",
-	"\U0001f469\u200d\U0001f393":   ":woman_student:",
-	"\U0001f928":                   ":raised_eyebrow:",
-	"\U0001f50c":                   ":electric_plug:",
-	"\U0001f3e1":                   ":house_with_garden:",
-	"\u2747\ufe0f":                 ":sparkle:",
-	"\U0001f64e\u200d\u2642\ufe0f": ":man_pouting:",
-	"\U0001f646\u200d\u2642\ufe0f": ":man_gesturing_ok:",
-	"\U0001fa80":                   ":yo_yo:",
-	"\u26d3\ufe0f":                 ":chains:",
-	"\U0001f1f2\U0001f1f0":         ":macedonia:",
-	"\U0001f60e":                   ":sunglasses:",
-	"\u2697\ufe0f":                 ":alembic:",
-	"\u2602\ufe0f":                 ":open_umbrella:",
-	"\U0001f64b\u200d\u2642\ufe0f": ":man_raising_hand:",
-	"\u2b06\ufe0f":                 ":arrow_up:",
-	"\U0001fab7":                   ":lotus:",
-	"\U0001f9f8":                   ":teddy_bear:",
-	"\U0001f4c0":                   ":dvd:",
-	"\U0001f3da\ufe0f":             ":derelict_house:",
-	"\U0001faa0":                   ":plunger:",
-	"\U0001f34d":                   ":pineapple:",
-	"\U0001f1f3\U0001f1fa":         ":flag_for_niue:",
-	"\U0001f6ab":                   ":prohibited:",
-	"\U0001f1ee\U0001f1f7":         ":flag_for_iran:",
-	"\U0001f1f3\U0001f1f4":         ":flag_for_norway:",
-	"\U0001f43d":                   ":pig_nose:",
-	"\U0001f386":                   ":fireworks:",
-	"\U0001f6f5":                   ":motor_scooter:",
-	"\U0001f367":                   ":shaved_ice:",
-	"\U0001f984":                   ":unicorn:",
-	"\u2b50":                       ":star:",
-	"\U0001f9da\u200d\u2642\ufe0f": ":fairy_man:",
-	"\U0001f1f2\U0001f1fa":         ":mauritius:",
-	"\U0001f1f8\U0001f1ed":         ":st_helena:",
-	"\U0001f63c":                   ":smirk_cat:",
-	"\u2663\ufe0f":                 ":clubs:",
-	"\U0001f9ce\u200d\u2640\ufe0f": ":kneeling_woman:",
-	"\U0001f1ec\U0001f1f2":         ":gambia:",
-	"\U0001f924":                   ":drooling_face:",
-	"\U0001f50f":                   ":locked_with_pen:",
-	"\u26cf\ufe0f":                 ":pick:",
-	"\U0001f1e8\U0001f1fa":         ":cuba:",
-	"\U0001f63f":                   ":crying_cat_face:",
-	"\U0001f6c3":                   ":customs:",
-	"\U0001f43e":                   ":paw_prints:",
-	"\U0001f4d8":                   ":blue_book:",
-	"\u2728":                       ":sparkles:",
-	"\U0001f9ce":                   ":person_kneeling:",
-	"\U0001f1e6\U0001f1e9":         ":andorra:",
-	"\U0001f643":                   ":upside_down_face:",
-	"\U0001f487\u200d\u2642\ufe0f": ":haircut_man:",
-	"\U0001fae6":                   ":biting_lip:",
-	"\U0001f4b9":                   ":chart_increasing_with_yen:",
-	"\U0001f9b8":                   ":superhero:",
-	"\U0001f688":                   ":light_rail:",
-	"\U0001f6cf\ufe0f":             ":bed:",
-	"\U0001f335":                   ":cactus:",
-	"\U0001f3ab":                   ":ticket:",
-	"\U0001f1e7\U0001f1f8":         ":flag_for_bahamas:",
-	"\U0001f3b8":                   ":guitar:",
-	"\u2623\ufe0f":                 ":biohazard:",
-	"\U0001f6b0":                   ":potable_water:",
-	"\U0001f953":                   ":bacon:",
-	"\U0001f53d":                   ":downwards_button:",
-	"\U0001faf5":                   ":index_pointing_at_the_viewer:",
-	"\U0001f1e7\U0001f1e7":         ":barbados:",
-	"\U0001f51e":                   ":no_one_under_eighteen:",
-	"\U0001f9d7":                   ":person_climbing:",
-	"\U0001f9d1\u200d\U0001f692":   ":firefighter:",
-	"\U0001f68f":                   ":busstop:",
-	"\u26b1\ufe0f":                 ":funeral_urn:",
-	"\U0001f38e":                   ":japanese_dolls:",
-	"\u2049\ufe0f":                 ":interrobang:",
-	"\u26c8\ufe0f":                 ":cloud_with_lightning_and_rain:",
-	"\U0001f46c":                   ":two_men_holding_hands:",
-	"\U0001f646\u200d\u2640\ufe0f": ":woman_gesturing_ok:",
-	"\U0001fac3":                   ":pregnant_man:",
-	"\U0001f45d":                   ":pouch:",
-	"\U0001f60d":                   ":heart_eyes:",
-	"\u23f8\ufe0f":                 ":pause_button:",
-	"\U0001fab4":                   ":potted_plant:",
-	"\U0001f9b8\u200d\u2640\ufe0f": ":superhero_woman:",
-	"\U0001f634":                   ":sleeping_face:",
-	"\U0001f922":                   ":nauseated_face:",
-	"\U0001f434":                   ":horse_face:",
-	"\U0001f9c3":                   ":beverage_box:",
-	"\U0001f326\ufe0f":             ":sun_behind_rain_cloud:",
-	"\U0001f481\u200d\u2642\ufe0f": ":sassy_man:",
-	"\U0001f934":                   ":prince:",
-	"\U0001f468\u200d\U0001f3a4":   ":man_singer:",
-	"\U0001f0cf":                   ":black_joker:",
-	"\U0001f94b":                   ":martial_arts_uniform:",
-	"\U0001f982":                   ":scorpion:",
-	"\u26f2":                       ":fountain:",
-	"\U0001f6bd":                   ":toilet:",
-	"\U0001f36b":                   ":chocolate_bar:",
-	"\U0001f9d1\u200d\U0001f33e":   ":farmer:",
-	"\U0001faf2":                   ":leftwards_hand:",
-	"\U0001f1f7\U0001f1ea":         ":flag_for_reunion:",
-	"\U0001f9d1\u200d\u2708\ufe0f": ":pilot:",
-	"\U0001f610":                   ":neutral_face:",
-	"\U0001f1f2\U0001f1eb":         ":st_martin:",
-	"\U0001f567":                   ":twelve_thirty:",
-	"\U0001f362":                   ":oden:",
-	"\U0001fad0":                   ":blueberries:",
-	"\U0001f468\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468": ":kiss_man_man:",
-	"\U0001f9fd":                   ":sponge:",
-	"\U0001f97f":                   ":flat_shoe:",
-	"\U0001f91f":                   ":love_you_gesture:",
-	"\U0001f4ca":                   ":bar_chart:",
-	"\U0001f1e8\U0001f1e9":         ":flag_for_congo_kinshasa:",
-	"\U0001f23a":                   ":japanese_open_for_business_button:",
-	"\U0001f33f":                   ":herb:",
-	"\U0001f446":                   ":backhand_index_pointing_up:",
-	"\U0001f3d4\ufe0f":             ":snow_capped_mountain:",
-	"\U0001f9da":                   ":fairy:",
-	"\U0001f1ed\U0001f1f2":         ":heard_mcdonald_islands:",
-	"\U0001f5fa\ufe0f":             ":world_map:",
-	"\U0001f640":                   ":scream_cat:",
-	"\U0001f94e":                   ":softball:",
-	"\U0001f468\u200d\U0001f37c":   ":man_feeding_baby:",
-	"\U0001f526":                   ":flashlight:",
-	"\U0001f1fa\U0001f1e6":         ":ukraine:",
-	"\u23fa\ufe0f":                 ":record_button:",
-	"\U0001f9cd\u200d\u2640\ufe0f": ":woman_standing:",
-	"\u2734\ufe0f":                 ":eight_pointed_black_star:",
-	"\U0001f3fd":                   ":medium_skin_tone:",
-	"\U0001f3b1":                   ":8ball:",
-	"\u26bd":                       ":soccer_ball:",
-	"\U0001f6bb":                   ":restroom:",
-	"\U0001f9eb":                   ":petri_dish:",
-	"\U0001f469\u200d\U0001f4bc":   ":woman_office_worker:",
-	"\U0001f1e9\U0001f1ec":         ":diego_garcia:",
-	"\U0001f3a5":                   ":movie_camera:",
-	"\U0001f44f":                   ":clapping_hands:",
-	"\U0001f340":                   ":four_leaf_clover:",
-	"\U0001f9dd":                   ":elf:",
-	"\U0001f411":                   ":ewe:",
-	"\U0001f436":                   ":dog:",
-	"\U0001f342":                   ":fallen_leaf:",
-	"\U0001f1e8\U0001f1fc":         ":curacao:",
-	"\U0001f32c\ufe0f":             ":wind_face:",
-	"\U0001f1fa\U0001f1f8":         ":flag_for_united_states:",
-	"\U0001f34b":                   ":lemon:",
-	"\u26be":                       ":baseball:",
-	"\U0001f45c":                   ":handbag:",
-	"\U0001f3eb":                   ":school:",
-	"\U0001f95f":                   ":dumpling:",
-	"\U0001f49b":                   ":yellow_heart:",
-	"\U0001f392":                   ":school_satchel:",
-	"\U0001f1f8\U0001f1ee":         ":slovenia:",
-	"\U0001f63d":                   ":kissing_cat:",
-	"\U0001f1f8\U0001f1e9":         ":flag_for_sudan:",
-	"\U0001f1f8\U0001f1e7":         ":flag_for_solomon_islands:",
-	"\U0001f42b":                   ":two_hump_camel:",
-	"\U0001f1ec\U0001f1fa":         ":guam:",
-	"\U0001f469\u200d\U0001f467":   ":family_woman_girl:",
-	"\U0001f54b":                   ":kaaba:",
-	"\U0001f39a\ufe0f":             ":level_slider:",
-	"\U0001f42a":                   ":dromedary_camel:",
-	"\U0001f60f":                   ":smirk:",
-	"\U0001f9be":                   ":mechanical_arm:",
-	"\U0001f470\u200d\u2642\ufe0f": ":man_with_veil:",
-	"\u26e9\ufe0f":                 ":shinto_shrine:",
-	"\U0001f3b3":                   ":bowling:",
-	"\U0001f1e8\U0001f1f4":         ":flag_for_colombia:",
-	"\U0001f361":                   ":dango:",
-	"\u26f3":                       ":flag_in_hole:",
-	"\U0001f480":                   ":skull:",
-	"\U0001f1f1\U0001f1fe":         ":flag_for_libya:",
-	"\U0001f469\u200d\U0001f9bc":   ":woman_in_motorized_wheelchair:",
-	"\U0001f9d7\u200d\u2640\ufe0f": ":woman_climbing:",
-	"\u25fc\ufe0f":                 ":black_medium_square:",
-	"\U0001f629":                   ":weary_face:",
-	"\u264c":                       ":leo:",
-	"\U0001f4a4":                   ":zzz:",
-	"\U0001f508":                   ":speaker:",
-	"\U0001f1f8\U0001f1e6":         ":flag_for_saudi_arabia:",
-	"\u2764\ufe0f\u200d\U0001f525": ":heart_on_fire:",
-	"\U0001f473":                   ":person_with_turban:",
-	"\U0001f531":                   ":trident:",
-	"\U0001f96a":                   ":sandwich:",
-	"\U0001f469\u200d\U0001f527":   ":woman_mechanic:",
-	"\U0001f1f2\U0001f1f9":         ":malta:",
-	"\U0001f604":                   ":grinning_face_with_smiling_eyes:",
-	"\U0001f1fb\U0001f1ec":         ":flag_for_british_virgin_islands:",
-	"\u2650":                       ":sagittarius:",
-	"\U0001f1f3\U0001f1ec":         ":flag_for_nigeria:",
-	"\U0001fa9a":                   ":carpentry_saw:",
-	"\U0001f3ae":                   ":video_game:",
-	"\U0001f193":                   ":free:",
-	"\U0001f51f":                   ":keycap_ten:",
-	"\U0001f473\u200d\u2640\ufe0f": ":woman_wearing_turban:",
-	"\U0001f1ee\U0001f1f2":         ":flag_for_isle_of_man:",
-	"\U0001f3fa":                   ":amphora:",
-	"\U0001f4e2":                   ":loudspeaker:",
-	"\u267e\ufe0f":                 ":infinity:",
-	"\U0001f647":                   ":person_bowing:",
-	"\U0001f389":                   ":tada:",
-	"\U0001f1ee\U0001f1f3":         ":india:",
-	"\U0001f9de":                   ":genie:",
-	"\U0001f1e7\U0001f1ee":         ":burundi:",
-	"\U0001f487\u200d\u2640\ufe0f": ":haircut_woman:",
-	"\U0001f616":                   ":confounded_face:",
-	"\U0001f614":                   ":pensive_face:",
-	"\U0001f468\u200d\U0001f469\u200d\U0001f466": ":family_man_woman_boy:",
-	"\U0001f377":                     ":wine_glass:",
-	"\U0001f9b1":                     ":curly_hair:",
-	"\U0001f6a6":                     ":vertical_traffic_light:",
-	"\U0001fad8":                     ":beans:",
-	"\U0001f1ec\U0001f1f7":           ":flag_for_greece:",
-	"1\ufe0f\u20e3":                  ":one:",
-	"\U0001f494":                     ":broken_heart:",
-	"\U0001f402":                     ":ox:",
-	"\U0001f468\u200d\U0001f9b2":     ":bald_man:",
-	"\U0001f469\u200d\U0001f692":     ":woman_firefighter:",
-	"\U0001f191":                     ":cl_button:",
-	"\U0001f469\u200d\u2708\ufe0f":   ":woman_pilot:",
-	"\U0001faa8":                     ":rock:",
-	"\U0001f9e3":                     ":scarf:",
-	"\U0001f1f2\U0001f1f5":           ":northern_mariana_islands:",
-	"\U0001f6fb":                     ":pickup_truck:",
-	"\u2692\ufe0f":                   ":hammer_and_pick:",
-	"\u26f9\ufe0f\u200d\u2640\ufe0f": ":bouncing_ball_woman:",
-	"\U0001f625":                     ":disappointed_relieved:",
-	"\U0001f96d":                     ":mango:",
-	"\U0001f950":                     ":croissant:",
-	"\U0001f31c":                     ":last_quarter_moon_with_face:",
-	"\U0001f55f":                     ":four_thirty:",
-	"\u21a9\ufe0f":                   ":right_arrow_curving_left:",
-	"\U0001f1f0\U0001f1f2":           ":flag_for_comoros:",
-	"\U0001f513":                     ":unlocked:",
-	"\U0001f1f9\U0001f1f1":           ":timor_leste:",
-	"\U0001f1e8\U0001f1e8":           ":cocos_islands:",
-	"\U0001f6b5\u200d\u2642\ufe0f":   ":mountain_biking_man:",
-	"\U0001f327\ufe0f":               ":cloud_with_rain:",
-	"\U0001f6f9":                     ":skateboard:",
-	"\U0001f469\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f469": ":couplekiss_woman_woman:",
-	"\U0001f421":                   ":blowfish:",
-	"\U0001f4f4":                   ":mobile_phone_off:",
-	"\U0001f6cb\ufe0f":             ":couch_and_lamp:",
-	"\u00ae\ufe0f":                 ":registered:",
-	"\u2660\ufe0f":                 ":spade_suit:",
-	"\u2764\ufe0f\u200d\U0001fa79": ":mending_heart:",
-	"\U0001f576\ufe0f":             ":dark_sunglasses:",
-	"\U0001f504":                   ":counterclockwise_arrows_button:",
-	"\U0001f918":                   ":sign_of_the_horns:",
-	"\U0001f9ed":                   ":compass:",
-	"\U0001f6a5":                   ":traffic_light:",
-	"\U0001f54e":                   ":menorah:",
-	"\U0001f92f":                   ":exploding_head:",
-	"\U0001f447":                   ":backhand_index_pointing_down:",
-	"\U0001fad2":                   ":olive:",
-	"\U0001f6a2":                   ":ship:",
-	"\U0001f1e9\U0001f1ea":         ":de:",
-	"\u2626\ufe0f":                 ":orthodox_cross:",
-	"\U0001f424":                   ":baby_chick:",
-	"\U0001f507":                   ":muted_speaker:",
-	"\U0001fab0":                   ":fly:",
-	"\U0001f56f\ufe0f":             ":candle:",
-	"\U0001f645\u200d\u2640\ufe0f": ":ng_woman:",
-	"\U0001f313":                   ":first_quarter_moon:",
-	"\U0001f315":                   ":full_moon:",
-	"\U0001f466":                   ":boy:",
-	"\U0001f3f4":                   ":black_flag:",
-	"\U0001f468\u200d\U0001f468\u200d\U0001f467\u200d\U0001f466": ":family_man_man_girl_boy:",
-	"\U0001f1fc\U0001f1eb": ":wallis_futuna:",
-	"\U0001fab5":           ":wood:",
-	"\U0001f3f4\U000e0067\U000e0062\U000e0073\U000e0063\U000e0074\U000e007f": ":scotland:",
-	"\U0001f9af":           ":white_cane:",
-	"\U0001f9ea":           ":test_tube:",
-	"\u23f0":               ":alarm_clock:",
-	"\U0001f9ee":           ":abacus:",
-	"\U0001f328\ufe0f":     ":cloud_with_snow:",
-	"\U0001f3c5":           ":sports_medal:",
-	"\U0001f96c":           ":leafy_green:",
-	"\U0001f1ee\U0001f1e8": ":canary_islands:",
-	"\u23e9":               ":fast_forward:",
-	"\U0001f91c":           ":fist_right:",
-	"\U0001f5dd\ufe0f":     ":old_key:",
-	"\U0001f4f6":           ":signal_strength:",
-	"\U0001f64b":           ":person_raising_hand:",
-	"\U0001f994":           ":hedgehog:",
-	"\u25c0\ufe0f":         ":arrow_backward:",
-	"\U0001f469\u200d\U0001f469\u200d\U0001f467\u200d\U0001f466":                       ":family_woman_woman_girl_boy:",
-	"\U0001f468\u200d\u2708\ufe0f":                                                     ":man_pilot:",
-	"\U0001f469\u200d\U0001f9b1":                                                       ":woman_with_curly_hair:",
-	"\U0001f9d1\U0001f3fb\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f9d1\U0001f3fc": ":kiss_person_person:",
-	"\U0001f9d0":                   ":face_with_monocle:",
-	"\U0001f1f3\U0001f1ea":         ":niger:",
-	"\U0001f4cc":                   ":pushpin:",
-	"\U0001fa78":                   ":drop_of_blood:",
-	"\U0001f453":                   ":glasses:",
-	"\U0001f475":                   ":old_woman:",
-	"\U0001f1fb\U0001f1e6":         ":vatican_city:",
-	"\U0001f93c\u200d\u2642\ufe0f": ":men_wrestling:",
-	"\U0001f6fa":                   ":auto_rickshaw:",
-	"\U0001fa97":                   ":accordion:",
-	"2\ufe0f\u20e3":                ":keycap_2:",
-	"\U0001f33d":                   ":corn:",
-	"\U0001f9ac":                   ":bison:",
-	"\U0001f94f":                   ":flying_disc:",
-	"\U0001f995":                   ":sauropod:",
-	"\U0001f43c":                   ":panda_face:",
-	"\U0001f9b2":                   ":bald:",
-	"\U0001fa9e":                   ":mirror:",
-	"\U0001f9dc":                   ":merperson:",
-	"\U0001f471":                   ":person_with_blond_hair:",
-	"\U0001f1f8\U0001f1f4":         ":somalia:",
-	"\U0001f6bf":                   ":shower:",
-	"\U0001f468\u200d\U0001f468\u200d\U0001f467": ":family_man_man_girl:",
-	"\U0001f6cd\ufe0f":                           ":shopping_bags:",
-	"\U0001f941":                                 ":drum:",
-	"\u2714\ufe0f":                               ":heavy_check_mark:",
-	"\U0001f51b":                                 ":on_arrow:",
-	"\U0001f305":                                 ":sunrise:",
-	"\U0001faf0":                                 ":hand_with_index_finger_and_thumb_crossed:",
-	"\U0001f1e8\U0001f1ee":                       ":flag_for_cote_d_ivoire:",
-	"\U0001f93c":                                 ":people_wrestling:",
-	"\U0001f92d":                                 ":face_with_hand_over_mouth:",
-	"\U0001f4c6":                                 ":calendar:",
-	"\u267f":                                     ":wheelchair:",
-	"\u2615":                                     ":hot_beverage:",
-	"\U0001f1f8\U0001f1f7":                       ":flag_for_suriname:",
-	"\U0001f609":                                 ":winking_face:",
-	"\U0001f1f2\U0001f1f4":                       ":flag_for_macao_sar_china:",
-	"\U0001f9b6":                                 ":foot:",
-	"\U0001f4d7":                                 ":green_book:",
-	"\u2666\ufe0f":                               ":diamonds:",
-	"\U0001f99d":                                 ":raccoon:",
-	"\U0001f96b":                                 ":canned_food:",
-	"\u264d":                                     ":virgo:",
-	"\U0001f403":                                 ":water_buffalo:",
-	"\U0001f4ee":                                 ":postbox:",
-	"\U0001f631":                                 ":face_screaming_in_fear:",
-	"\U0001f35a":                                 ":rice:",
-	"\U0001f930":                                 ":pregnant_woman:",
-	"\U0001f680":                                 ":rocket:",
-	"\U0001f32a\ufe0f":                           ":tornado:",
-	"\U0001f6ac":                                 ":cigarette:",
-	"\U0001f469\u200d\U0001f52c":                 ":woman_scientist:",
-	"\U0001f6a1":                                 ":aerial_tramway:",
-	"\U0001f3cd\ufe0f":                           ":motorcycle:",
-	"\U0001f962":                                 ":chopsticks:",
-	"\U0001f3dd\ufe0f":                           ":desert_island:",
-	"\U0001f405":                                 ":tiger2:",
-	"\U0001fac0":                                 ":anatomical_heart:",
-	"\U0001f1f8\U0001f1f8":                       ":south_sudan:",
-	"\U0001f935\u200d\u2640\ufe0f":               ":woman_in_tuxedo:",
-	"\U0001f9b9\u200d\u2642\ufe0f":               ":supervillain_man:",
-	"\U0001f1f9\U0001f1e6":                       ":flag_for_tristan_da_cunha:",
-	"\U0001f6b5":                                 ":person_mountain_biking:",
-	"\u2194\ufe0f":                               ":left_right_arrow:",
-	"\U0001f4da":                                 ":books:",
-	"\U0001f9c9":                                 ":mate:",
-	"\U0001f1f3\U0001f1f1":                       ":flag_for_netherlands:",
-	"\U0001f468\u200d\U0001f469\u200d\U0001f467": ":family_man_woman_girl:",
-	"8\ufe0f\u20e3":              ":keycap_8:",
-	"\U0001f427":                 ":penguin:",
-	"\U0001f9cb":                 ":bubble_tea:",
-	"\u2620\ufe0f":               ":skull_and_crossbones:",
-	"\U0001f949":                 ":third_place_medal:",
-	"\U0001f9d1\u200d\U0001f373": ":cook:",
-	"\U0001f1f9\U0001f1f2":       ":turkmenistan:",
-	"\u2695\ufe0f":               ":medical_symbol:",
-	"\U0001f317":                 ":last_quarter_moon:",
-	"\U0001f6bc":                 ":baby_symbol:",
-	"\U0001f345":                 ":tomato:",
-	"\U0001f943":                 ":tumbler_glass:",
-	"\U0001f624":                 ":triumph:",
-	"\U0001f192":                 ":cool_button:",
-	"\u264b":                     ":cancer:",
-	"\U0001f1e8\U0001f1ec":       ":congo_brazzaville:",
-	"3":                          ":three:",
-}
+// reverseEmojiMap is the aliases of the emojis by their codes.
+var reverseEmojiMap = &table{parts: []string{reverseData, reverseFlagData}}
+
+// reverseData is the aliases of the emojis other than flags, which are in reverseFlagData.
+const reverseData = "" +
+	"#\ufe0f\u20e3\x00:keycap_hash:\x00" +
+	"*\ufe0f\u20e3\x00:asterisk:\x00" +
+	"0\ufe0f\u20e3\x00:zero:\x00" +
+	"1\ufe0f\u20e3\x00:one:\x00" +
+	"2\ufe0f\u20e3\x00:keycap_2:\x00" +
+	"3\ufe0f\u20e3\x00:three:\x00" +
+	"4\ufe0f\u20e3\x00:keycap_4:\x00" +
+	"5\ufe0f\u20e3\x00:five:\x00" +
+	"6\ufe0f\u20e3\x00:keycap_6:\x00" +
+	"7\ufe0f\u20e3\x00:keycap_7:\x00" +
+	"8\ufe0f\u20e3\x00:keycap_8:\x00" +
+	"9\ufe0f\u20e3\x00:keycap_9:\x00" +
+	"\u00a9\ufe0f\x00:copyright:\x00" +
+	"\u00ae\ufe0f\x00:registered:\x00" +
+	"\u203c\ufe0f\x00:double_exclamation_mark:\x00" +
+	"\u2049\ufe0f\x00:interrobang:\x00" +
+	"\u2122\ufe0f\x00:tm:\x00" +
+	"\u2139\ufe0f\x00:information:\x00" +
+	"\u2194\ufe0f\x00:left_right_arrow:\x00" +
+	"\u2195\ufe0f\x00:arrow_up_down:\x00" +
+	"\u2196\ufe0f\x00:up_left_arrow:\x00" +
+	"\u2197\ufe0f\x00:arrow_upper_right:\x00" +
+	"\u2198\ufe0f\x00:down_right_arrow:\x00" +
+	"\u2199\ufe0f\x00:down_left_arrow:\x00" +
+	"\u21a9\ufe0f\x00:right_arrow_curving_left:\x00" +
+	"\u21aa\ufe0f\x00:left_arrow_curving_right:\x00" +
+	"\u231a\x00:watch:\x00" +
+	"\u231b\x00:hourglass:\x00" +
+	"\u2328\ufe0f\x00:keyboard:\x00" +
+	"\u23cf\ufe0f\x00:eject_button:\x00" +
+	"\u23e9\x00:fast_forward:\x00" +
+	"\u23ea\x00:fast_reverse_button:\x00" +
+	"\u23eb\x00:fast_up_button:\x00" +
+	"\u23ec\x00:arrow_double_down:\x00" +
+	"\u23ed\ufe0f\x00:next_track_button:\x00" +
+	"\u23ee\ufe0f\x00:last_track_button:\x00" +
+	"\u23ef\ufe0f\x00:play_or_pause_button:\x00" +
+	"\u23f0\x00:alarm_clock:\x00" +
+	"\u23f1\ufe0f\x00:stopwatch:\x00" +
+	"\u23f2\ufe0f\x00:timer_clock:\x00" +
+	"\u23f3\x00:hourglass_not_done:\x00" +
+	"\u23f8\ufe0f\x00:pause_button:\x00" +
+	"\u23f9\ufe0f\x00:stop_button:\x00" +
+	"\u23fa\ufe0f\x00:record_button:\x00" +
+	"\u24c2\ufe0f\x00:m:\x00" +
+	"\u25aa\ufe0f\x00:black_small_square:\x00" +
+	"\u25ab\ufe0f\x00:white_small_square:\x00" +
+	"\u25b6\ufe0f\x00:arrow_forward:\x00" +
+	"\u25c0\ufe0f\x00:arrow_backward:\x00" +
+	"\u25fb\ufe0f\x00:white_medium_square:\x00" +
+	"\u25fc\ufe0f\x00:black_medium_square:\x00" +
+	"\u25fd\x00:white_medium_small_square:\x00" +
+	"\u25fe\x00:black_medium_small_square:\x00" +
+	"\u2600\ufe0f\x00:sun:\x00" +
+	"\u2601\ufe0f\x00:cloud:\x00" +
+	"\u2602\ufe0f\x00:open_umbrella:\x00" +
+	"\u2603\ufe0f\x00:snowman_with_snow:\x00" +
+	"\u2604\ufe0f\x00:comet:\x00" +
+	"\u260e\ufe0f\x00:phone:\x00" +
+	"\u2611\ufe0f\x00:ballot_box_with_check:\x00" +
+	"\u2614\x00:umbrella:\x00" +
+	"\u2615\x00:hot_beverage:\x00" +
+	"\u2618\ufe0f\x00:shamrock:\x00" +
+	"\u261d\ufe0f\x00:point_up:\x00" +
+	"\u2620\ufe0f\x00:skull_and_crossbones:\x00" +
+	"\u2622\ufe0f\x00:radioactive:\x00" +
+	"\u2623\ufe0f\x00:biohazard:\x00" +
+	"\u2626\ufe0f\x00:orthodox_cross:\x00" +
+	"\u262a\ufe0f\x00:star_and_crescent:\x00" +
+	"\u262e\ufe0f\x00:peace_symbol:\x00" +
+	"\u262f\ufe0f\x00:yin_yang:\x00" +
+	"\u2638\ufe0f\x00:wheel_of_dharma:\x00" +
+	"\u2639\ufe0f\x00:frowning_face:\x00" +
+	"\u263a\ufe0f\x00:smiling_face:\x00" +
+	"\u2640\ufe0f\x00:female_sign:\x00" +
+	"\u2642\ufe0f\x00:male_sign:\x00" +
+	"\u2648\x00:aries:\x00" +
+	"\u2649\x00:taurus:\x00" +
+	"\u264a\x00:gemini:\x00" +
+	"\u264b\x00:cancer:\x00" +
+	"\u264c\x00:leo:\x00" +
+	"\u264d\x00:virgo:\x00" +
+	"\u264e\x00:libra:\x00" +
+	"\u264f\x00:scorpius:\x00" +
+	"\u2650\x00:sagittarius:\x00" +
+	"\u2651\x00:capricorn:\x00" +
+	"\u2652\x00:aquarius:\x00" +
+	"\u2653\x00:pisces:\x00" +
+	"\u265f\ufe0f\x00:chess_pawn:\x00" +
+	"\u2660\ufe0f\x00:spade_suit:\x00" +
+	"\u2663\ufe0f\x00:clubs:\x00" +
+	"\u2665\ufe0f\x00:heart_suit:\x00" +
+	"\u2666\ufe0f\x00:diamonds:\x00" +
+	"\u2668\ufe0f\x00:hot_springs:\x00" +
+	"\u267b\ufe0f\x00:recycle:\x00" +
+	"\u267e\ufe0f\x00:infinity:\x00" +
+	"\u267f\x00:wheelchair:\x00" +
+	"\u2692\ufe0f\x00:hammer_and_pick:\x00" +
+	"\u2693\x00:anchor:\x00" +
+	"\u2694\ufe0f\x00:crossed_swords:\x00" +
+	"\u2695\ufe0f\x00:medical_symbol:\x00" +
+	"\u2696\ufe0f\x00:balance_scale:\x00" +
+	"\u2697\ufe0f\x00:alembic:\x00" +
+	"\u2699\ufe0f\x00:gear:\x00" +
+	"\u269b\ufe0f\x00:atom_symbol:\x00" +
+	"\u269c\ufe0f\x00:fleur_de_lis:\x00" +
+	"\u26a0\ufe0f\x00:warning:\x00" +
+	"\u26a1\x00:high_voltage:\x00" +
+	"\u26a7\ufe0f\x00:transgender_symbol:\x00" +
+	"\u26aa\x00:white_circle:\x00" +
+	"\u26ab\x00:black_circle:\x00" +
+	"\u26b0\ufe0f\x00:coffin:\x00" +
+	"\u26b1\ufe0f\x00:funeral_urn:\x00" +
+	"\u26bd\x00:soccer_ball:\x00" +
+	"\u26be\x00:baseball:\x00" +
+	"\u26c4\x00:snowman_without_snow:\x00" +
+	"\u26c5\x00:partly_sunny:\x00" +
+	"\u26c8\ufe0f\x00:cloud_with_lightning_and_rain:\x00" +
+	"\u26ce\x00:ophiuchus:\x00" +
+	"\u26cf\ufe0f\x00:pick:\x00" +
+	"\u26d1\ufe0f\x00:rescue_worker_helmet:\x00" +
+	"\u26d3\ufe0f\x00:chains:\x00" +
+	"\u26d4\x00:no_entry:\x00" +
+	"\u26e9\ufe0f\x00:shinto_shrine:\x00" +
+	"\u26ea\x00:church:\x00" +
+	"\u26f0\ufe0f\x00:mountain:\x00" +
+	"\u26f1\ufe0f\x00:umbrella_on_ground:\x00" +
+	"\u26f2\x00:fountain:\x00" +
+	"\u26f3\x00:flag_in_hole:\x00" +
+	"\u26f4\ufe0f\x00:ferry:\x00" +
+	"\u26f5\x00:sailboat:\x00" +
+	"\u26f7\ufe0f\x00:skier:\x00" +
+	"\u26f8\ufe0f\x00:ice_skate:\x00" +
+	"\u26f9\ufe0f\x00:person_bouncing_ball:\x00" +
+	"\u26f9\ufe0f\u200d\u2640\ufe0f\x00:bouncing_ball_woman:\x00" +
+	"\u26f9\ufe0f\u200d\u2642\ufe0f\x00:man_bouncing_ball:\x00" +
+	"\u26fa\x00:tent:\x00" +
+	"\u26fd\x00:fuelpump:\x00" +
+	"\u2702\ufe0f\x00:scissors:\x00" +
+	"\u2705\x00:check_mark_button:\x00" +
+	"\u2708\ufe0f\x00:airplane:\x00" +
+	"\u2709\ufe0f\x00:envelope:\x00" +
+	"\u270a\x00:fist:\x00" +
+	"\u270b\x00:raised_hand:\x00" +
+	"\u270c\ufe0f\x00:victory_hand:\x00" +
+	"\u270d\ufe0f\x00:writing_hand:\x00" +
+	"\u270f\ufe0f\x00:pencil2:\x00" +
+	"\u2712\ufe0f\x00:black_nib:\x00" +
+	"\u2714\ufe0f\x00:heavy_check_mark:\x00" +
+	"\u2716\ufe0f\x00:multiply:\x00" +
+	"\u271d\ufe0f\x00:latin_cross:\x00" +
+	"\u2721\ufe0f\x00:star_of_david:\x00" +
+	"\u2728\x00:sparkles:\x00" +
+	"\u2733\ufe0f\x00:eight_spoked_asterisk:\x00" +
+	"\u2734\ufe0f\x00:eight_pointed_black_star:\x00" +
+	"\u2744\ufe0f\x00:snowflake:\x00" +
+	"\u2747\ufe0f\x00:sparkle:\x00" +
+	"\u274c\x00:cross_mark:\x00" +
+	"\u274e\x00:negative_squared_cross_mark:\x00" +
+	"\u2753\x00:question:\x00" +
+	"\u2754\x00:grey_question:\x00" +
+	"\u2755\x00:white_exclamation_mark:\x00" +
+	"\u2757\x00:exclamation:\x00" +
+	"\u2763\ufe0f\x00:heart_exclamation:\x00" +
+	"\u2764\ufe0f\x00:red_heart:\x00" +
+	"\u2764\ufe0f\u200d\U0001f525\x00:heart_on_fire:\x00" +
+	"\u2764\ufe0f\u200d\U0001fa79\x00:mending_heart:\x00" +
+	"\u2795\x00:heavy_plus_sign:\x00" +
+	"\u2796\x00:minus:\x00" +
+	"\u2797\x00:heavy_division_sign:\x00" +
+	"\u27a1\ufe0f\x00:right_arrow:\x00" +
+	"\u27b0\x00:curly_loop:\x00" +
+	"\u27bf\x00:double_curly_loop:\x00" +
+	"\u2934\ufe0f\x00:arrow_heading_up:\x00" +
+	"\u2935\ufe0f\x00:right_arrow_curving_down:\x00" +
+	"\u2b05\ufe0f\x00:arrow_left:\x00" +
+	"\u2b06\ufe0f\x00:arrow_up:\x00" +
+	"\u2b07\ufe0f\x00:down_arrow:\x00" +
+	"\u2b1b\x00:black_large_square:\x00" +
+	"\u2b1c\x00:white_large_square:\x00" +
+	"\u2b50\x00:star:\x00" +
+	"\u2b55\x00:o:\x00" +
+	"\u3030\ufe0f\x00:wavy_dash:\x00" +
+	"\u303d\ufe0f\x00:part_alternation_mark:\x00" +
+	"\u3297\ufe0f\x00:japanese_congratulations_button:\x00" +
+	"\u3299\ufe0f\x00:japanese_secret_button:\x00" +
+	"\U0001f004\x00:mahjong:\x00" +
+	"\U0001f0cf\x00:black_joker:\x00" +
+	"\U0001f170\ufe0f\x00:a:\x00" +
+	"\U0001f171\ufe0f\x00:b_button_blood_type:\x00" +
+	"\U0001f17e\ufe0f\x00:o_button_blood_type:\x00" +
+	"\U0001f17f\ufe0f\x00:p_button:\x00" +
+	"\U0001f18e\x00:ab:\x00" +
+	"\U0001f191\x00:cl_button:\x00" +
+	"\U0001f192\x00:cool_button:\x00" +
+	"\U0001f193\x00:free:\x00" +
+	"\U0001f194\x00:id:\x00" +
+	"\U0001f195\x00:new_button:\x00" +
+	"\U0001f196\x00:ng:\x00" +
+	"\U0001f197\x00:ok_button:\x00" +
+	"\U0001f198\x00:sos_button:\x00" +
+	"\U0001f199\x00:up:\x00" +
+	"\U0001f19a\x00:vs:\x00" +
+	"\U0001f201\x00:koko:\x00" +
+	"\U0001f202\ufe0f\x00:japanese_service_charge_button:\x00" +
+	"\U0001f21a\x00:u7121:\x00" +
+	"\U0001f22f\x00:u6307:\x00" +
+	"\U0001f232\x00:u7981:\x00" +
+	"\U0001f233\x00:japanese_vacancy_button:\x00" +
+	"\U0001f234\x00:japanese_passing_grade_button:\x00" +
+	"\U0001f235\x00:u6e80:\x00" +
+	"\U0001f236\x00:u6709:\x00" +
+	"\U0001f237\ufe0f\x00:japanese_monthly_amount_button:\x00" +
+	"\U0001f238\x00:u7533:\x00" +
+	"\U0001f239\x00:u5272:\x00" +
+	"\U0001f23a\x00:japanese_open_for_business_button:\x00" +
+	"\U0001f250\x00:japanese_bargain_button:\x00" +
+	"\U0001f251\x00:japanese_acceptable_button:\x00" +
+	"\U0001f300\x00:cyclone:\x00" +
+	"\U0001f301\x00:foggy:\x00" +
+	"\U0001f302\x00:closed_umbrella:\x00" +
+	"\U0001f303\x00:night_with_stars:\x00" +
+	"\U0001f304\x00:sunrise_over_mountains:\x00" +
+	"\U0001f305\x00:sunrise:\x00" +
+	"\U0001f306\x00:cityscape_at_dusk:\x00" +
+	"\U0001f307\x00:sunset:\x00" +
+	"\U0001f308\x00:rainbow:\x00" +
+	"\U0001f309\x00:bridge_at_night:\x00" +
+	"\U0001f30a\x00:water_wave:\x00" +
+	"\U0001f30b\x00:volcano:\x00" +
+	"\U0001f30c\x00:milky_way:\x00" +
+	"\U0001f30d\x00:earth_africa:\x00" +
+	"\U0001f30e\x00:globe_showing_americas:\x00" +
+	"\U0001f30f\x00:globe_showing_asia_australia:\x00" +
+	"\U0001f310\x00:globe_with_meridians:\x00" +
+	"\U0001f311\x00:new_moon:\x00" +
+	"\U0001f312\x00:waxing_crescent_moon:\x00" +
+	"\U0001f313\x00:first_quarter_moon:\x00" +
+	"\U0001f314\x00:moon:\x00" +
+	"\U0001f315\x00:full_moon:\x00" +
+	"\U0001f316\x00:waning_gibbous_moon:\x00" +
+	"\U0001f317\x00:last_quarter_moon:\x00" +
+	"\U0001f318\x00:waning_crescent_moon:\x00" +
+	"\U0001f319\x00:crescent_moon:\x00" +
+	"\U0001f31a\x00:new_moon_face:\x00" +
+	"\U0001f31b\x00:first_quarter_moon_face:\x00" +
+	"\U0001f31c\x00:last_quarter_moon_with_face:\x00" +
+	"\U0001f31d\x00:full_moon_with_face:\x00" +
+	"\U0001f31e\x00:sun_with_face:\x00" +
+	"\U0001f31f\x00:glowing_star:\x00" +
+	"\U0001f320\x00:stars:\x00" +
+	"\U0001f321\ufe0f\x00:thermometer:\x00" +
+	"\U0001f324\ufe0f\x00:sun_behind_small_cloud:\x00" +
+	"\U0001f325\ufe0f\x00:sun_behind_large_cloud:\x00" +
+	"\U0001f326\ufe0f\x00:sun_behind_rain_cloud:\x00" +
+	"\U0001f327\ufe0f\x00:cloud_with_rain:\x00" +
+	"\U0001f328\ufe0f\x00:cloud_with_snow:\x00" +
+	"\U0001f329\ufe0f\x00:cloud_with_lightning:\x00" +
+	"\U0001f32a\ufe0f\x00:tornado:\x00" +
+	"\U0001f32b\ufe0f\x00:fog:\x00" +
+	"\U0001f32c\ufe0f\x00:wind_face:\x00" +
+	"\U0001f32d\x00:hotdog:\x00" +
+	"\U0001f32e\x00:taco:\x00" +
+	"\U0001f32f\x00:burrito:\x00" +
+	"\U0001f330\x00:chestnut:\x00" +
+	"\U0001f331\x00:seedling:\x00" +
+	"\U0001f332\x00:evergreen_tree:\x00" +
+	"\U0001f333\x00:deciduous_tree:\x00" +
+	"\U0001f334\x00:palm_tree:\x00" +
+	"\U0001f335\x00:cactus:\x00" +
+	"\U0001f336\ufe0f\x00:hot_pepper:\x00" +
+	"\U0001f337\x00:tulip:\x00" +
+	"\U0001f338\x00:cherry_blossom:\x00" +
+	"\U0001f339\x00:rose:\x00" +
+	"\U0001f33a\x00:hibiscus:\x00" +
+	"\U0001f33b\x00:sunflower:\x00" +
+	"\U0001f33c\x00:blossom:\x00" +
+	"\U0001f33d\x00:corn:\x00" +
+	"\U0001f33e\x00:ear_of_rice:\x00" +
+	"\U0001f33f\x00:herb:\x00" +
+	"\U0001f340\x00:four_leaf_clover:\x00" +
+	"\U0001f341\x00:maple_leaf:\x00" +
+	"\U0001f342\x00:fallen_leaf:\x00" +
+	"\U0001f343\x00:leaf_fluttering_in_wind:\x00" +
+	"\U0001f344\x00:mushroom:\x00" +
+	"\U0001f345\x00:tomato:\x00" +
+	"\U0001f346\x00:eggplant:\x00" +
+	"\U0001f347\x00:grapes:\x00" +
+	"\U0001f348\x00:melon:\x00" +
+	"\U0001f349\x00:watermelon:\x00" +
+	"\U0001f34a\x00:mandarin:\x00" +
+	"\U0001f34b\x00:lemon:\x00" +
+	"\U0001f34c\x00:banana:\x00" +
+	"\U0001f34d\x00:pineapple:\x00" +
+	"\U0001f34e\x00:apple:\x00" +
+	"\U0001f34f\x00:green_apple:\x00" +
+	"\U0001f350\x00:pear:\x00" +
+	"\U0001f351\x00:peach:\x00" +
+	"\U0001f352\x00:cherries:\x00" +
+	"\U0001f353\x00:strawberry:\x00" +
+	"\U0001f354\x00:hamburger:\x00" +
+	"\U0001f355\x00:pizza:\x00" +
+	"\U0001f356\x00:meat_on_bone:\x00" +
+	"\U0001f357\x00:poultry_leg:\x00" +
+	"\U0001f358\x00:rice_cracker:\x00" +
+	"\U0001f359\x00:rice_ball:\x00" +
+	"\U0001f35a\x00:rice:\x00" +
+	"\U0001f35b\x00:curry:\x00" +
+	"\U0001f35c\x00:steaming_bowl:\x00" +
+	"\U0001f35d\x00:spaghetti:\x00" +
+	"\U0001f35e\x00:bread:\x00" +
+	"\U0001f35f\x00:french_fries:\x00" +
+	"\U0001f360\x00:roasted_sweet_potato:\x00" +
+	"\U0001f361\x00:dango:\x00" +
+	"\U0001f362\x00:oden:\x00" +
+	"\U0001f363\x00:sushi:\x00" +
+	"\U0001f364\x00:fried_shrimp:\x00" +
+	"\U0001f365\x00:fish_cake_with_swirl:\x00" +
+	"\U0001f366\x00:icecream:\x00" +
+	"\U0001f367\x00:shaved_ice:\x00" +
+	"\U0001f368\x00:ice_cream:\x00" +
+	"\U0001f369\x00:doughnut:\x00" +
+	"\U0001f36a\x00:cookie:\x00" +
+	"\U0001f36b\x00:chocolate_bar:\x00" +
+	"\U0001f36c\x00:candy:\x00" +
+	"\U0001f36d\x00:lollipop:\x00" +
+	"\U0001f36e\x00:custard:\x00" +
+	"\U0001f36f\x00:honey_pot:\x00" +
+	"\U0001f370\x00:shortcake:\x00" +
+	"\U0001f371\x00:bento:\x00" +
+	"\U0001f372\x00:pot_of_food:\x00" +
+	"\U0001f373\x00:fried_egg:\x00" +
+	"\U0001f374\x00:fork_and_knife:\x00" +
+	"\U0001f375\x00:tea:\x00" +
+	"\U0001f376\x00:sake:\x00" +
+	"\U0001f377\x00:wine_glass:\x00" +
+	"\U0001f378\x00:cocktail_glass:\x00" +
+	"\U0001f379\x00:tropical_drink:\x00" +
+	"\U0001f37a\x00:beer_mug:\x00" +
+	"\U0001f37b\x00:beers:\x00" +
+	"\U0001f37c\x00:baby_bottle:\x00" +
+	"\U0001f37d\ufe0f\x00:fork_and_knife_with_plate:\x00" +
+	"\U0001f37e\x00:champagne:\x00" +
+	"\U0001f37f\x00:popcorn:\x00" +
+	"\U0001f380\x00:ribbon:\x00" +
+	"\U0001f381\x00:gift:\x00" +
+	"\U0001f382\x00:birthday:\x00" +
+	"\U0001f383\x00:jack_o_lantern:\x00" +
+	"\U0001f384\x00:christmas_tree:\x00" +
+	"\U0001f385\x00:santa_claus:\x00" +
+	"\U0001f386\x00:fireworks:\x00" +
+	"\U0001f387\x00:sparkler:\x00" +
+	"\U0001f388\x00:balloon:\x00" +
+	"\U0001f389\x00:tada:\x00" +
+	"\U0001f38a\x00:confetti_ball:\x00" +
+	"\U0001f38b\x00:tanabata_tree:\x00" +
+	"\U0001f38d\x00:bamboo:\x00" +
+	"\U0001f38e\x00:japanese_dolls:\x00" +
+	"\U0001f38f\x00:carp_streamer:\x00" +
+	"\U0001f390\x00:wind_chime:\x00" +
+	"\U0001f391\x00:moon_viewing_ceremony:\x00" +
+	"\U0001f392\x00:school_satchel:\x00" +
+	"\U0001f393\x00:graduation_cap:\x00" +
+	"\U0001f396\ufe0f\x00:military_medal:\x00" +
+	"\U0001f397\ufe0f\x00:reminder_ribbon:\x00" +
+	"\U0001f399\ufe0f\x00:studio_microphone:\x00" +
+	"\U0001f39a\ufe0f\x00:level_slider:\x00" +
+	"\U0001f39b\ufe0f\x00:control_knobs:\x00" +
+	"\U0001f39e\ufe0f\x00:film_strip:\x00" +
+	"\U0001f39f\ufe0f\x00:tickets:\x00" +
+	"\U0001f3a0\x00:carousel_horse:\x00" +
+	"\U0001f3a1\x00:ferris_wheel:\x00" +
+	"\U0001f3a2\x00:roller_coaster:\x00" +
+	"\U0001f3a3\x00:fishing_pole:\x00" +
+	"\U0001f3a4\x00:microphone:\x00" +
+	"\U0001f3a5\x00:movie_camera:\x00" +
+	"\U0001f3a6\x00:cinema:\x00" +
+	"\U0001f3a7\x00:headphone:\x00" +
+	"\U0001f3a8\x00:artist_palette:\x00" +
+	"\U0001f3a9\x00:top_hat:\x00" +
+	"\U0001f3aa\x00:circus_tent:\x00" +
+	"\U0001f3ab\x00:ticket:\x00" +
+	"\U0001f3ac\x00:clapper:\x00" +
+	"\U0001f3ad\x00:performing_arts:\x00" +
+	"\U0001f3ae\x00:video_game:\x00" +
+	"\U0001f3af\x00:dart:\x00" +
+	"\U0001f3b0\x00:slot_machine:\x00" +
+	"\U0001f3b1\x00:8ball:\x00" +
+	"\U0001f3b2\x00:game_die:\x00" +
+	"\U0001f3b3\x00:bowling:\x00" +
+	"\U0001f3b4\x00:flower_playing_cards:\x00" +
+	"\U0001f3b5\x00:musical_note:\x00" +
+	"\U0001f3b6\x00:musical_notes:\x00" +
+	"\U0001f3b7\x00:saxophone:\x00" +
+	"\U0001f3b8\x00:guitar:\x00" +
+	"\U0001f3b9\x00:musical_keyboard:\x00" +
+	"\U0001f3ba\x00:trumpet:\x00" +
+	"\U0001f3bb\x00:violin:\x00" +
+	"\U0001f3bc\x00:musical_score:\x00" +
+	"\U0001f3bd\x00:running_shirt_with_sash:\x00" +
+	"\U0001f3be\x00:tennis:\x00" +
+	"\U0001f3bf\x00:skis:\x00" +
+	"\U0001f3c0\x00:basketball:\x00" +
+	"\U0001f3c2\x00:snowboarder:\x00" +
+	"\U0001f3c3\x00:runner:\x00" +
+	"\U0001f3c3\u200d\u2640\ufe0f\x00:woman_running:\x00" +
+	"\U0001f3c3\u200d\u2642\ufe0f\x00:running_man:\x00" +
+	"\U0001f3c4\x00:surfer:\x00" +
+	"\U0001f3c4\u200d\u2640\ufe0f\x00:surfing_woman:\x00" +
+	"\U0001f3c4\u200d\u2642\ufe0f\x00:surfing_man:\x00" +
+	"\U0001f3c5\x00:sports_medal:\x00" +
+	"\U0001f3c6\x00:trophy:\x00" +
+	"\U0001f3c7\x00:horse_racing:\x00" +
+	"\U0001f3c8\x00:football:\x00" +
+	"\U0001f3c9\x00:rugby_football:\x00" +
+	"\U0001f3ca\x00:swimmer:\x00" +
+	"\U0001f3ca\u200d\u2640\ufe0f\x00:woman_swimming:\x00" +
+	"\U0001f3ca\u200d\u2642\ufe0f\x00:swimming_man:\x00" +
+	"\U0001f3cb\ufe0f\x00:person_lifting_weights:\x00" +
+	"\U0001f3cb\ufe0f\u200d\u2640\ufe0f\x00:weight_lifting_woman:\x00" +
+	"\U0001f3cb\ufe0f\u200d\u2642\ufe0f\x00:weight_lifting_man:\x00" +
+	"\U0001f3cc\ufe0f\x00:person_golfing:\x00" +
+	"\U0001f3cc\ufe0f\u200d\u2640\ufe0f\x00:woman_golfing:\x00" +
+	"\U0001f3cc\ufe0f\u200d\u2642\ufe0f\x00:man_golfing:\x00" +
+	"\U0001f3cd\ufe0f\x00:motorcycle:\x00" +
+	"\U0001f3ce\ufe0f\x00:racing_car:\x00" +
+	"\U0001f3cf\x00:cricket_game:\x00" +
+	"\U0001f3d0\x00:volleyball:\x00" +
+	"\U0001f3d1\x00:field_hockey:\x00" +
+	"\U0001f3d2\x00:ice_hockey:\x00" +
+	"\U0001f3d3\x00:ping_pong:\x00" +
+	"\U0001f3d4\ufe0f\x00:snow_capped_mountain:\x00" +
+	"\U0001f3d5\ufe0f\x00:camping:\x00" +
+	"\U0001f3d6\ufe0f\x00:beach_with_umbrella:\x00" +
+	"\U0001f3d7\ufe0f\x00:building_construction:\x00" +
+	"\U0001f3d8\ufe0f\x00:houses:\x00" +
+	"\U0001f3d9\ufe0f\x00:cityscape:\x00" +
+	"\U0001f3da\ufe0f\x00:derelict_house:\x00" +
+	"\U0001f3db\ufe0f\x00:classical_building:\x00" +
+	"\U0001f3dc\ufe0f\x00:desert:\x00" +
+	"\U0001f3dd\ufe0f\x00:desert_island:\x00" +
+	"\U0001f3de\ufe0f\x00:national_park:\x00" +
+	"\U0001f3df\ufe0f\x00:stadium:\x00" +
+	"\U0001f3e0\x00:house:\x00" +
+	"\U0001f3e1\x00:house_with_garden:\x00" +
+	"\U0001f3e2\x00:office_building:\x00" +
+	"\U0001f3e3\x00:post_office:\x00" +
+	"\U0001f3e4\x00:european_post_office:\x00" +
+	"\U0001f3e5\x00:hospital:\x00" +
+	"\U0001f3e6\x00:bank:\x00" +
+	"\U0001f3e7\x00:atm_sign:\x00" +
+	"\U0001f3e8\x00:hotel:\x00" +
+	"\U0001f3e9\x00:love_hotel:\x00" +
+	"\U0001f3ea\x00:convenience_store:\x00" +
+	"\U0001f3eb\x00:school:\x00" +
+	"\U0001f3ec\x00:department_store:\x00" +
+	"\U0001f3ed\x00:factory:\x00" +
+	"\U0001f3ee\x00:lantern:\x00" +
+	"\U0001f3ef\x00:japanese_castle:\x00" +
+	"\U0001f3f0\x00:castle:\x00" +
+	"\U0001f3f5\ufe0f\x00:rosette:\x00" +
+	"\U0001f3f7\ufe0f\x00:label:\x00" +
+	"\U0001f3f8\x00:badminton:\x00" +
+	"\U0001f3f9\x00:bow_and_arrow:\x00" +
+	"\U0001f3fa\x00:amphora:\x00" +
+	"\U0001f3fb\x00:light_skin_tone:\x00" +
+	"\U0001f3fc\x00:medium_light_skin_tone:\x00" +
+	"\U0001f3fd\x00:medium_skin_tone:\x00" +
+	"\U0001f3fe\x00:medium_dark_skin_tone:\x00" +
+	"\U0001f3ff\x00:dark_skin_tone:\x00" +
+	"\U0001f400\x00:rat:\x00" +
+	"\U0001f401\x00:mouse2:\x00" +
+	"\U0001f402\x00:ox:\x00" +
+	"\U0001f403\x00:water_buffalo:\x00" +
+	"\U0001f404\x00:cow2:\x00" +
+	"\U0001f405\x00:tiger2:\x00" +
+	"\U0001f406\x00:leopard:\x00" +
+	"\U0001f407\x00:rabbit2:\x00" +
+	"\U0001f408\x00:cat2:\x00" +
+	"\U0001f408\u200d\u2b1b\x00:black_cat:\x00" +
+	"\U0001f409\x00:dragon:\x00" +
+	"\U0001f40a\x00:crocodile:\x00" +
+	"\U0001f40b\x00:whale2:\x00" +
+	"\U0001f40c\x00:snail:\x00" +
+	"\U0001f40d\x00:snake:\x00" +
+	"\U0001f40e\x00:racehorse:\x00" +
+	"\U0001f40f\x00:ram:\x00" +
+	"\U0001f410\x00:goat:\x00" +
+	"\U0001f411\x00:ewe:\x00" +
+	"\U0001f412\x00:monkey:\x00" +
+	"\U0001f413\x00:rooster:\x00" +
+	"\U0001f414\x00:chicken:\x00" +
+	"\U0001f415\x00:dog2:\x00" +
+	"\U0001f415\u200d\U0001f9ba\x00:service_dog:\x00" +
+	"\U0001f416\x00:pig2:\x00" +
+	"\U0001f417\x00:boar:\x00" +
+	"\U0001f418\x00:elephant:\x00" +
+	"\U0001f419\x00:octopus:\x00" +
+	"\U0001f41a\x00:spiral_shell:\x00" +
+	"\U0001f41b\x00:bug:\x00" +
+	"\U0001f41c\x00:ant:\x00" +
+	"\U0001f41d\x00:bee:\x00" +
+	"\U0001f41e\x00:lady_beetle:\x00" +
+	"\U0001f41f\x00:fish:\x00" +
+	"\U0001f420\x00:tropical_fish:\x00" +
+	"\U0001f421\x00:blowfish:\x00" +
+	"\U0001f422\x00:turtle:\x00" +
+	"\U0001f423\x00:hatching_chick:\x00" +
+	"\U0001f424\x00:baby_chick:\x00" +
+	"\U0001f425\x00:hatched_chick:\x00" +
+	"\U0001f426\x00:bird:\x00" +
+	"\U0001f427\x00:penguin:\x00" +
+	"\U0001f428\x00:koala:\x00" +
+	"\U0001f429\x00:poodle:\x00" +
+	"\U0001f42a\x00:dromedary_camel:\x00" +
+	"\U0001f42b\x00:two_hump_camel:\x00" +
+	"\U0001f42c\x00:dolphin:\x00" +
+	"\U0001f42d\x00:mouse:\x00" +
+	"\U0001f42e\x00:cow:\x00" +
+	"\U0001f42f\x00:tiger_face:\x00" +
+	"\U0001f430\x00:rabbit_face:\x00" +
+	"\U0001f431\x00:cat:\x00" +
+	"\U0001f432\x00:dragon_face:\x00" +
+	"\U0001f433\x00:spouting_whale:\x00" +
+	"\U0001f434\x00:horse_face:\x00" +
+	"\U0001f435\x00:monkey_face:\x00" +
+	"\U0001f436\x00:dog:\x00" +
+	"\U0001f437\x00:pig:\x00" +
+	"\U0001f438\x00:frog:\x00" +
+	"\U0001f439\x00:hamster:\x00" +
+	"\U0001f43a\x00:wolf:\x00" +
+	"\U0001f43b\x00:bear:\x00" +
+	"\U0001f43b\u200d\u2744\ufe0f\x00:polar_bear:\x00" +
+	"\U0001f43c\x00:panda_face:\x00" +
+	"\U0001f43d\x00:pig_nose:\x00" +
+	"\U0001f43e\x00:paw_prints:\x00" +
+	"\U0001f43f\ufe0f\x00:chipmunk:\x00" +
+	"\U0001f440\x00:eyes:\x00" +
+	"\U0001f441\ufe0f\x00:eye:\x00" +
+	"\U0001f441\ufe0f\u200d\U0001f5e8\ufe0f\x00:eye_speech_bubble:\x00" +
+	"\U0001f442\x00:ear:\x00" +
+	"\U0001f443\x00:nose:\x00" +
+	"\U0001f444\x00:lips:\x00" +
+	"\U0001f445\x00:tongue:\x00" +
+	"\U0001f446\x00:backhand_index_pointing_up:\x00" +
+	"\U0001f447\x00:backhand_index_pointing_down:\x00" +
+	"\U0001f448\x00:backhand_index_pointing_left:\x00" +
+	"\U0001f449\x00:point_right:\x00" +
+	"\U0001f44a\x00:fist_oncoming:\x00" +
+	"\U0001f44b\x00:wave:\x00" +
+	"\U0001f44c\x00:ok_hand:\x00" +
+	"\U0001f44d\x00:thumbsup:\x00" +
+	"\U0001f44e\x00:thumbsdown:\x00" +
+	"\U0001f44f\x00:clapping_hands:\x00" +
+	"\U0001f450\x00:open_hands:\x00" +
+	"\U0001f451\x00:crown:\x00" +
+	"\U0001f452\x00:womans_hat:\x00" +
+	"\U0001f453\x00:glasses:\x00" +
+	"\U0001f454\x00:necktie:\x00" +
+	"\U0001f455\x00:shirt:\x00" +
+	"\U0001f456\x00:jeans:\x00" +
+	"\U0001f457\x00:dress:\x00" +
+	"\U0001f458\x00:kimono:\x00" +
+	"\U0001f459\x00:bikini:\x00" +
+	"\U0001f45a\x00:woman_s_clothes:\x00" +
+	"\U0001f45b\x00:purse:\x00" +
+	"\U0001f45c\x00:handbag:\x00" +
+	"\U0001f45d\x00:pouch:\x00" +
+	"\U0001f45e\x00:mans_shoe:\x00" +
+	"\U0001f45f\x00:running_shoe:\x00" +
+	"\U0001f460\x00:high_heeled_shoe:\x00" +
+	"\U0001f461\x00:woman_s_sandal:\x00" +
+	"\U0001f462\x00:boot:\x00" +
+	"\U0001f463\x00:footprints:\x00" +
+	"\U0001f464\x00:bust_in_silhouette:\x00" +
+	"\U0001f465\x00:busts_in_silhouette:\x00" +
+	"\U0001f466\x00:boy:\x00" +
+	"\U0001f467\x00:girl:\x00" +
+	"\U0001f468\x00:man:\x00" +
+	"\U0001f468\u200d\u2695\ufe0f\x00:man_health_worker:\x00" +
+	"\U0001f468\u200d\u2696\ufe0f\x00:man_judge:\x00" +
+	"\U0001f468\u200d\u2708\ufe0f\x00:man_pilot:\x00" +
+	"\U0001f468\u200d\u2764\ufe0f\u200d\U0001f468\x00:couple_with_heart_man_man:\x00" +
+	"\U0001f468\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468\x00:kiss_man_man:\x00" +
+	"\U0001f468\u200d\U0001f33e\x00:man_farmer:\x00" +
+	"\U0001f468\u200d\U0001f373\x00:man_cook:\x00" +
+	"\U0001f468\u200d\U0001f37c\x00:man_feeding_baby:\x00" +
+	"\U0001f468\u200d\U0001f393\x00:man_student:\x00" +
+	"\U0001f468\u200d\U0001f3a4\x00:man_singer:\x00" +
+	"\U0001f468\u200d\U0001f3a8\x00:man_artist:\x00" +
+	"\U0001f468\u200d\U0001f3eb\x00:man_teacher:\x00" +
+	"\U0001f468\u200d\U0001f3ed\x00:man_factory_worker:\x00" +
+	"\U0001f468\u200d\U0001f466\x00:family_man_boy:\x00" +
+	"\U0001f468\u200d\U0001f466\u200d\U0001f466\x00:family_man_boy_boy:\x00" +
+	"\U0001f468\u200d\U0001f467\x00:family_man_girl:\x00" +
+	"\U0001f468\u200d\U0001f467\u200d\U0001f466\x00:family_man_girl_boy:\x00" +
+	"\U0001f468\u200d\U0001f467\u200d\U0001f467\x00:family_man_girl_girl:\x00" +
+	"\U0001f468\u200d\U0001f468\u200d\U0001f466\x00:family_man_man_boy:\x00" +
+	"\U0001f468\u200d\U0001f468\u200d\U0001f466\u200d\U0001f466\x00:family_man_man_boy_boy:\x00" +
+	"\U0001f468\u200d\U0001f468\u200d\U0001f467\x00:family_man_man_girl:\x00" +
+	"\U0001f468\u200d\U0001f468\u200d\U0001f467\u200d\U0001f466\x00:family_man_man_girl_boy:\x00" +
+	"\U0001f468\u200d\U0001f468\u200d\U0001f467\u200d\U0001f467\x00:family_man_man_girl_girl:\x00" +
+	"\U0001f468\u200d\U0001f469\u200d\U0001f466\x00:family_man_woman_boy:\x00" +
+	"\U0001f468\u200d\U0001f469\u200d\U0001f466\u200d\U0001f466\x00:family_man_woman_boy_boy:\x00" +
+	"\U0001f468\u200d\U0001f469\u200d\U0001f467\x00:family_man_woman_girl:\x00" +
+	"\U0001f468\u200d\U0001f469\u200d\U0001f467\u200d\U0001f466\x00:family_man_woman_girl_boy:\x00" +
+	"\U0001f468\u200d\U0001f469\u200d\U0001f467\u200d\U0001f467\x00:family_man_woman_girl_girl:\x00" +
+	"\U0001f468\u200d\U0001f4bb\x00:man_technologist:\x00" +
+	"\U0001f468\u200d\U0001f4bc\x00:man_office_worker:\x00" +
+	"\U0001f468\u200d\U0001f527\x00:man_mechanic:\x00" +
+	"\U0001f468\u200d\U0001f52c\x00:man_scientist:\x00" +
+	"\U0001f468\u200d\U0001f680\x00:man_astronaut:\x00" +
+	"\U0001f468\u200d\U0001f692\x00:man_firefighter:\x00" +
+	"\U0001f468\u200d\U0001f9af\x00:man_with_probing_cane:\x00" +
+	"\U0001f468\u200d\U0001f9b0\x00:red_haired_man:\x00" +
+	"\U0001f468\u200d\U0001f9b1\x00:man_with_curly_hair:\x00" +
+	"\U0001f468\u200d\U0001f9b2\x00:bald_man:\x00" +
+	"\U0001f468\u200d\U0001f9b3\x00:man_with_white_hair:\x00" +
+	"\U0001f468\u200d\U0001f9bc\x00:man_in_motorized_wheelchair:\x00" +
+	"\U0001f468\u200d\U0001f9bd\x00:man_in_manual_wheelchair:\x00" +
+	"\U0001f469\x00:woman:\x00" +
+	"\U0001f469\u200d\u2695\ufe0f\x00:woman_health_worker:\x00" +
+	"\U0001f469\u200d\u2696\ufe0f\x00:woman_judge:\x00" +
+	"\U0001f469\u200d\u2708\ufe0f\x00:woman_pilot:\x00" +
+	"\U0001f469\u200d\u2764\ufe0f\u200d\U0001f468\x00:couple_with_heart_woman_man:\x00" +
+	"\U0001f469\u200d\u2764\ufe0f\u200d\U0001f469\x00:couple_with_heart_woman_woman:\x00" +
+	"\U0001f469\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468\x00:kiss_woman_man:\x00" +
+	"\U0001f469\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f469\x00:couplekiss_woman_woman:\x00" +
+	"\U0001f469\u200d\U0001f33e\x00:woman_farmer:\x00" +
+	"\U0001f469\u200d\U0001f373\x00:woman_cook:\x00" +
+	"\U0001f469\u200d\U0001f37c\x00:woman_feeding_baby:\x00" +
+	"\U0001f469\u200d\U0001f393\x00:woman_student:\x00" +
+	"\U0001f469\u200d\U0001f3a4\x00:woman_singer:\x00" +
+	"\U0001f469\u200d\U0001f3a8\x00:woman_artist:\x00" +
+	"\U0001f469\u200d\U0001f3eb\x00:woman_teacher:\x00" +
+	"\U0001f469\u200d\U0001f3ed\x00:woman_factory_worker:\x00" +
+	"\U0001f469\u200d\U0001f466\x00:family_woman_boy:\x00" +
+	"\U0001f469\u200d\U0001f466\u200d\U0001f466\x00:family_woman_boy_boy:\x00" +
+	"\U0001f469\u200d\U0001f467\x00:family_woman_girl:\x00" +
+	"\U0001f469\u200d\U0001f467\u200d\U0001f466\x00:family_woman_girl_boy:\x00" +
+	"\U0001f469\u200d\U0001f467\u200d\U0001f467\x00:family_woman_girl_girl:\x00" +
+	"\U0001f469\u200d\U0001f469\u200d\U0001f466\x00:family_woman_woman_boy:\x00" +
+	"\U0001f469\u200d\U0001f469\u200d\U0001f466\u200d\U0001f466\x00:family_woman_woman_boy_boy:\x00" +
+	"\U0001f469\u200d\U0001f469\u200d\U0001f467\x00:family_woman_woman_girl:\x00" +
+	"\U0001f469\u200d\U0001f469\u200d\U0001f467\u200d\U0001f466\x00:family_woman_woman_girl_boy:\x00" +
+	"\U0001f469\u200d\U0001f469\u200d\U0001f467\u200d\U0001f467\x00:family_woman_woman_girl_girl:\x00" +
+	"\U0001f469\u200d\U0001f4bb\x00:woman_technologist:\x00" +
+	"\U0001f469\u200d\U0001f4bc\x00:woman_office_worker:\x00" +
+	"\U0001f469\u200d\U0001f527\x00:woman_mechanic:\x00" +
+	"\U0001f469\u200d\U0001f52c\x00:woman_scientist:\x00" +
+	"\U0001f469\u200d\U0001f680\x00:woman_astronaut:\x00" +
+	"\U0001f469\u200d\U0001f692\x00:woman_firefighter:\x00" +
+	"\U0001f469\u200d\U0001f9af\x00:woman_with_white_cane:\x00" +
+	"\U0001f469\u200d\U0001f9b0\x00:woman_with_red_hair:\x00" +
+	"\U0001f469\u200d\U0001f9b1\x00:woman_with_curly_hair:\x00" +
+	"\U0001f469\u200d\U0001f9b2\x00:woman_bald:\x00" +
+	"\U0001f469\u200d\U0001f9b3\x00:white_haired_woman:\x00" +
+	"\U0001f469\u200d\U0001f9bc\x00:woman_in_motorized_wheelchair:\x00" +
+	"\U0001f469\u200d\U0001f9bd\x00:woman_in_manual_wheelchair:\x00" +
+	"\U0001f46a\x00:family:\x00" +
+	"\U0001f46b\x00:woman_and_man_holding_hands:\x00" +
+	"\U0001f46c\x00:two_men_holding_hands:\x00" +
+	"\U0001f46d\x00:women_holding_hands:\x00" +
+	"\U0001f46e\x00:cop:\x00" +
+	"\U0001f46e\u200d\u2640\ufe0f\x00:policewoman:\x00" +
+	"\U0001f46e\u200d\u2642\ufe0f\x00:man_police_officer:\x00" +
+	"\U0001f46f\x00:dancers:\x00" +
+	"\U0001f46f\u200d\u2640\ufe0f\x00:women_with_bunny_ears:\x00" +
+	"\U0001f46f\u200d\u2642\ufe0f\x00:dancing_men:\x00" +
+	"\U0001f470\x00:person_with_veil:\x00" +
+	"\U0001f470\u200d\u2640\ufe0f\x00:bride_with_veil:\x00" +
+	"\U0001f470\u200d\u2642\ufe0f\x00:man_with_veil:\x00" +
+	"\U0001f471\x00:person_with_blond_hair:\x00" +
+	"\U0001f471\u200d\u2640\ufe0f\x00:blonde_woman:\x00" +
+	"\U0001f471\u200d\u2642\ufe0f\x00:man_with_blond_hair:\x00" +
+	"\U0001f472\x00:man_with_gua_pi_mao:\x00" +
+	"\U0001f473\x00:person_with_turban:\x00" +
+	"\U0001f473\u200d\u2640\ufe0f\x00:woman_wearing_turban:\x00" +
+	"\U0001f473\u200d\u2642\ufe0f\x00:man_with_turban:\x00" +
+	"\U0001f474\x00:old_man:\x00" +
+	"\U0001f475\x00:old_woman:\x00" +
+	"\U0001f476\x00:baby:\x00" +
+	"\U0001f477\x00:construction_worker:\x00" +
+	"\U0001f477\u200d\u2640\ufe0f\x00:construction_worker_woman:\x00" +
+	"\U0001f477\u200d\u2642\ufe0f\x00:construction_worker_man:\x00" +
+	"\U0001f478\x00:princess:\x00" +
+	"\U0001f479\x00:japanese_ogre:\x00" +
+	"\U0001f47a\x00:goblin:\x00" +
+	"\U0001f47b\x00:ghost:\x00" +
+	"\U0001f47c\x00:angel:\x00" +
+	"\U0001f47d\x00:alien:\x00" +
+	"\U0001f47e\x00:alien_monster:\x00" +
+	"\U0001f47f\x00:angry_face_with_horns:\x00" +
+	"\U0001f480\x00:skull:\x00" +
+	"\U0001f481\x00:tipping_hand_person:\x00" +
+	"\U0001f481\u200d\u2640\ufe0f\x00:woman_tipping_hand:\x00" +
+	"\U0001f481\u200d\u2642\ufe0f\x00:sassy_man:\x00" +
+	"\U0001f482\x00:guard:\x00" +
+	"\U0001f482\u200d\u2640\ufe0f\x00:woman_guard:\x00" +
+	"\U0001f482\u200d\u2642\ufe0f\x00:man_guard:\x00" +
+	"\U0001f483\x00:woman_dancing:\x00" +
+	"\U0001f484\x00:lipstick:\x00" +
+	"\U0001f485\x00:nail_care:\x00" +
+	"\U0001f486\x00:massage:\x00" +
+	"\U0001f486\u200d\u2640\ufe0f\x00:massage_woman:\x00" +
+	"\U0001f486\u200d\u2642\ufe0f\x00:man_getting_massage:\x00" +
+	"\U0001f487\x00:haircut:\x00" +
+	"\U0001f487\u200d\u2640\ufe0f\x00:haircut_woman:\x00" +
+	"\U0001f487\u200d\u2642\ufe0f\x00:haircut_man:\x00" +
+	"\U0001f488\x00:barber_pole:\x00" +
+	"\U0001f489\x00:syringe:\x00" +
+	"\U0001f48a\x00:pill:\x00" +
+	"\U0001f48b\x00:kiss:\x00" +
+	"\U0001f48c\x00:love_letter:\x00" +
+	"\U0001f48d\x00:ring:\x00" +
+	"\U0001f48e\x00:gem_stone:\x00" +
+	"\U0001f48f\x00:couplekiss:\x00" +
+	"\U0001f490\x00:bouquet:\x00" +
+	"\U0001f491\x00:couple_with_heart:\x00" +
+	"\U0001f492\x00:wedding:\x00" +
+	"\U0001f493\x00:heartbeat:\x00" +
+	"\U0001f494\x00:broken_heart:\x00" +
+	"\U0001f495\x00:two_hearts:\x00" +
+	"\U0001f496\x00:sparkling_heart:\x00" +
+	"\U0001f497\x00:growing_heart:\x00" +
+	"\U0001f498\x00:heart_with_arrow:\x00" +
+	"\U0001f499\x00:blue_heart:\x00" +
+	"\U0001f49a\x00:green_heart:\x00" +
+	"\U0001f49b\x00:yellow_heart:\x00" +
+	"\U0001f49c\x00:purple_heart:\x00" +
+	"\U0001f49d\x00:gift_heart:\x00" +
+	"\U0001f49e\x00:revolving_hearts:\x00" +
+	"\U0001f49f\x00:heart_decoration:\x00" +
+	"\U0001f4a0\x00:diamond_with_a_dot:\x00" +
+	"\U0001f4a1\x00:bulb:\x00" +
+	"\U0001f4a2\x00:anger:\x00" +
+	"\U0001f4a3\x00:bomb:\x00" +
+	"\U0001f4a4\x00:zzz:\x00" +
+	"\U0001f4a5\x00:collision:\x00" +
+	"\U0001f4a6\x00:sweat_droplets:\x00" +
+	"\U0001f4a7\x00:droplet:\x00" +
+	"\U0001f4a8\x00:dash:\x00" +
+	"\U0001f4a9\x00:poop:\x00" +
+	"\U0001f4aa\x00:flexed_biceps:\x00" +
+	"\U0001f4ab\x00:dizzy:\x00" +
+	"\U0001f4ac\x00:speech_balloon:\x00" +
+	"\U0001f4ad\x00:thought_balloon:\x00" +
+	"\U0001f4ae\x00:white_flower:\x00" +
+	"\U0001f4af\x00:100:\x00" +
+	"\U0001f4b0\x00:money_bag:\x00" +
+	"\U0001f4b1\x00:currency_exchange:\x00" +
+	"\U0001f4b2\x00:heavy_dollar_sign:\x00" +
+	"\U0001f4b3\x00:credit_card:\x00" +
+	"\U0001f4b4\x00:yen_banknote:\x00" +
+	"\U0001f4b5\x00:dollar:\x00" +
+	"\U0001f4b6\x00:euro:\x00" +
+	"\U0001f4b7\x00:pound_banknote:\x00" +
+	"\U0001f4b8\x00:money_with_wings:\x00" +
+	"\U0001f4b9\x00:chart_increasing_with_yen:\x00" +
+	"\U0001f4ba\x00:seat:\x00" +
+	"\U0001f4bb\x00:laptop:\x00" +
+	"\U0001f4bc\x00:briefcase:\x00" +
+	"\U0001f4bd\x00:computer_disk:\x00" +
+	"\U0001f4be\x00:floppy_disk:\x00" +
+	"\U0001f4bf\x00:cd:\x00" +
+	"\U0001f4c0\x00:dvd:\x00" +
+	"\U0001f4c1\x00:file_folder:\x00" +
+	"\U0001f4c2\x00:open_file_folder:\x00" +
+	"\U0001f4c3\x00:page_with_curl:\x00" +
+	"\U0001f4c4\x00:page_facing_up:\x00" +
+	"\U0001f4c5\x00:date:\x00" +
+	"\U0001f4c6\x00:calendar:\x00" +
+	"\U0001f4c7\x00:card_index:\x00" +
+	"\U0001f4c8\x00:chart_increasing:\x00" +
+	"\U0001f4c9\x00:chart_with_downwards_trend:\x00" +
+	"\U0001f4ca\x00:bar_chart:\x00" +
+	"\U0001f4cb\x00:clipboard:\x00" +
+	"\U0001f4cc\x00:pushpin:\x00" +
+	"\U0001f4cd\x00:round_pushpin:\x00" +
+	"\U0001f4ce\x00:paperclip:\x00" +
+	"\U0001f4cf\x00:straight_ruler:\x00" +
+	"\U0001f4d0\x00:triangular_ruler:\x00" +
+	"\U0001f4d1\x00:bookmark_tabs:\x00" +
+	"\U0001f4d2\x00:ledger:\x00" +
+	"\U0001f4d3\x00:notebook:\x00" +
+	"\U0001f4d4\x00:notebook_with_decorative_cover:\x00" +
+	"\U0001f4d5\x00:closed_book:\x00" +
+	"\U0001f4d6\x00:book:\x00" +
+	"\U0001f4d7\x00:green_book:\x00" +
+	"\U0001f4d8\x00:blue_book:\x00" +
+	"\U0001f4d9\x00:orange_book:\x00" +
+	"\U0001f4da\x00:books:\x00" +
+	"\U0001f4db\x00:name_badge:\x00" +
+	"\U0001f4dc\x00:scroll:\x00" +
+	"\U0001f4dd\x00:memo:\x00" +
+	"\U0001f4de\x00:telephone_receiver:\x00" +
+	"\U0001f4df\x00:pager:\x00" +
+	"\U0001f4e0\x00:fax:\x00" +
+	"\U0001f4e1\x00:satellite:\x00" +
+	"\U0001f4e2\x00:loudspeaker:\x00" +
+	"\U0001f4e3\x00:megaphone:\x00" +
+	"\U0001f4e4\x00:outbox_tray:\x00" +
+	"\U0001f4e5\x00:inbox_tray:\x00" +
+	"\U0001f4e6\x00:package:\x00" +
+	"\U0001f4e7\x00:e_mail:\x00" +
+	"\U0001f4e8\x00:incoming_envelope:\x00" +
+	"\U0001f4e9\x00:envelope_with_arrow:\x00" +
+	"\U0001f4ea\x00:mailbox_closed:\x00" +
+	"\U0001f4eb\x00:mailbox:\x00" +
+	"\U0001f4ec\x00:mailbox_with_mail:\x00" +
+	"\U0001f4ed\x00:mailbox_with_no_mail:\x00" +
+	"\U0001f4ee\x00:postbox:\x00" +
+	"\U0001f4ef\x00:postal_horn:\x00" +
+	"\U0001f4f0\x00:newspaper:\x00" +
+	"\U0001f4f1\x00:iphone:\x00" +
+	"\U0001f4f2\x00:calling:\x00" +
+	"\U0001f4f3\x00:vibration_mode:\x00" +
+	"\U0001f4f4\x00:mobile_phone_off:\x00" +
+	"\U0001f4f5\x00:no_mobile_phones:\x00" +
+	"\U0001f4f6\x00:signal_strength:\x00" +
+	"\U0001f4f7\x00:camera:\x00" +
+	"\U0001f4f8\x00:camera_flash:\x00" +
+	"\U0001f4f9\x00:video_camera:\x00" +
+	"\U0001f4fa\x00:tv:\x00" +
+	"\U0001f4fb\x00:radio:\x00" +
+	"\U0001f4fc\x00:videocassette:\x00" +
+	"\U0001f4fd\ufe0f\x00:film_projector:\x00" +
+	"\U0001f4ff\x00:prayer_beads:\x00" +
+	"\U0001f500\x00:twisted_rightwards_arrows:\x00" +
+	"\U0001f501\x00:repeat:\x00" +
+	"\U0001f502\x00:repeat_one:\x00" +
+	"\U0001f503\x00:clockwise_vertical_arrows:\x00" +
+	"\U0001f504\x00:counterclockwise_arrows_button:\x00" +
+	"\U0001f505\x00:dim_button:\x00" +
+	"\U0001f506\x00:high_brightness:\x00" +
+	"\U0001f507\x00:muted_speaker:\x00" +
+	"\U0001f508\x00:speaker:\x00" +
+	"\U0001f509\x00:sound:\x00" +
+	"\U0001f50a\x00:speaker_high_volume:\x00" +
+	"\U0001f50b\x00:battery:\x00" +
+	"\U0001f50c\x00:electric_plug:\x00" +
+	"\U0001f50d\x00:magnifying_glass_tilted_left:\x00" +
+	"\U0001f50e\x00:mag_right:\x00" +
+	"\U0001f50f\x00:locked_with_pen:\x00" +
+	"\U0001f510\x00:closed_lock_with_key:\x00" +
+	"\U0001f511\x00:key:\x00" +
+	"\U0001f512\x00:lock:\x00" +
+	"\U0001f513\x00:unlocked:\x00" +
+	"\U0001f514\x00:bell:\x00" +
+	"\U0001f515\x00:no_bell:\x00" +
+	"\U0001f516\x00:bookmark:\x00" +
+	"\U0001f517\x00:link:\x00" +
+	"\U0001f518\x00:radio_button:\x00" +
+	"\U0001f519\x00:back:\x00" +
+	"\U0001f51a\x00:end:\x00" +
+	"\U0001f51b\x00:on_arrow:\x00" +
+	"\U0001f51c\x00:soon:\x00" +
+	"\U0001f51d\x00:top_arrow:\x00" +
+	"\U0001f51e\x00:no_one_under_eighteen:\x00" +
+	"\U0001f51f\x00:keycap_ten:\x00" +
+	"\U0001f520\x00:capital_abcd:\x00" +
+	"\U0001f521\x00:abcd:\x00" +
+	"\U0001f522\x00:input_numbers:\x00" +
+	"\U0001f523\x00:input_symbols:\x00" +
+	"\U0001f524\x00:input_latin_letters:\x00" +
+	"\U0001f525\x00:fire:\x00" +
+	"\U0001f526\x00:flashlight:\x00" +
+	"\U0001f527\x00:wrench:\x00" +
+	"\U0001f528\x00:hammer:\x00" +
+	"\U0001f529\x00:nut_and_bolt:\x00" +
+	"\U0001f52a\x00:kitchen_knife:\x00" +
+	"\U0001f52b\x00:water_pistol:\x00" +
+	"\U0001f52c\x00:microscope:\x00" +
+	"\U0001f52d\x00:telescope:\x00" +
+	"\U0001f52e\x00:crystal_ball:\x00" +
+	"\U0001f52f\x00:six_pointed_star:\x00" +
+	"\U0001f530\x00:japanese_symbol_for_beginner:\x00" +
+	"\U0001f531\x00:trident:\x00" +
+	"\U0001f532\x00:black_square_button:\x00" +
+	"\U0001f533\x00:white_square_button:\x00" +
+	"\U0001f534\x00:red_circle:\x00" +
+	"\U0001f535\x00:blue_circle:\x00" +
+	"\U0001f536\x00:large_orange_diamond:\x00" +
+	"\U0001f537\x00:large_blue_diamond:\x00" +
+	"\U0001f538\x00:small_orange_diamond:\x00" +
+	"\U0001f539\x00:small_blue_diamond:\x00" +
+	"\U0001f53a\x00:small_red_triangle:\x00" +
+	"\U0001f53b\x00:small_red_triangle_down:\x00" +
+	"\U0001f53c\x00:upwards_button:\x00" +
+	"\U0001f53d\x00:downwards_button:\x00" +
+	"\U0001f549\ufe0f\x00:om:\x00" +
+	"\U0001f54a\ufe0f\x00:dove:\x00" +
+	"\U0001f54b\x00:kaaba:\x00" +
+	"\U0001f54c\x00:mosque:\x00" +
+	"\U0001f54d\x00:synagogue:\x00" +
+	"\U0001f54e\x00:menorah:\x00" +
+	"\U0001f550\x00:clock1:\x00" +
+	"\U0001f551\x00:clock2:\x00" +
+	"\U0001f552\x00:three_o_clock:\x00" +
+	"\U0001f553\x00:clock4:\x00" +
+	"\U0001f554\x00:clock5:\x00" +
+	"\U0001f555\x00:clock6:\x00" +
+	"\U0001f556\x00:seven_o_clock:\x00" +
+	"\U0001f557\x00:clock8:\x00" +
+	"\U0001f558\x00:clock9:\x00" +
+	"\U0001f559\x00:ten_o_clock:\x00" +
+	"\U0001f55a\x00:eleven_o_clock:\x00" +
+	"\U0001f55b\x00:clock12:\x00" +
+	"\U0001f55c\x00:clock130:\x00" +
+	"\U0001f55d\x00:clock230:\x00" +
+	"\U0001f55e\x00:clock330:\x00" +
+	"\U0001f55f\x00:four_thirty:\x00" +
+	"\U0001f560\x00:five_thirty:\x00" +
+	"\U0001f561\x00:clock630:\x00" +
+	"\U0001f562\x00:clock730:\x00" +
+	"\U0001f563\x00:eight_thirty:\x00" +
+	"\U0001f564\x00:clock930:\x00" +
+	"\U0001f565\x00:clock1030:\x00" +
+	"\U0001f566\x00:clock1130:\x00" +
+	"\U0001f567\x00:twelve_thirty:\x00" +
+	"\U0001f56f\ufe0f\x00:candle:\x00" +
+	"\U0001f570\ufe0f\x00:mantelpiece_clock:\x00" +
+	"\U0001f573\ufe0f\x00:hole:\x00" +
+	"\U0001f574\ufe0f\x00:business_suit_levitating:\x00" +
+	"\U0001f575\ufe0f\x00:detective:\x00" +
+	"\U0001f575\ufe0f\u200d\u2640\ufe0f\x00:female_detective:\x00" +
+	"\U0001f575\ufe0f\u200d\u2642\ufe0f\x00:male_detective:\x00" +
+	"\U0001f576\ufe0f\x00:dark_sunglasses:\x00" +
+	"\U0001f577\ufe0f\x00:spider:\x00" +
+	"\U0001f578\ufe0f\x00:spider_web:\x00" +
+	"\U0001f579\ufe0f\x00:joystick:\x00" +
+	"\U0001f57a\x00:man_dancing:\x00" +
+	"\U0001f587\ufe0f\x00:linked_paperclips:\x00" +
+	"\U0001f58a\ufe0f\x00:pen:\x00" +
+	"\U0001f58b\ufe0f\x00:fountain_pen:\x00" +
+	"\U0001f58c\ufe0f\x00:paintbrush:\x00" +
+	"\U0001f58d\ufe0f\x00:crayon:\x00" +
+	"\U0001f590\ufe0f\x00:raised_hand_with_fingers_splayed:\x00" +
+	"\U0001f595\x00:fu:\x00" +
+	"\U0001f596\x00:vulcan_salute:\x00" +
+	"\U0001f5a4\x00:black_heart:\x00" +
+	"\U0001f5a5\ufe0f\x00:desktop_computer:\x00" +
+	"\U0001f5a8\ufe0f\x00:printer:\x00" +
+	"\U0001f5b1\ufe0f\x00:computer_mouse:\x00" +
+	"\U0001f5b2\ufe0f\x00:trackball:\x00" +
+	"\U0001f5bc\ufe0f\x00:framed_picture:\x00" +
+	"\U0001f5c2\ufe0f\x00:card_index_dividers:\x00" +
+	"\U0001f5c3\ufe0f\x00:card_file_box:\x00" +
+	"\U0001f5c4\ufe0f\x00:file_cabinet:\x00" +
+	"\U0001f5d1\ufe0f\x00:wastebasket:\x00" +
+	"\U0001f5d2\ufe0f\x00:spiral_notepad:\x00" +
+	"\U0001f5d3\ufe0f\x00:spiral_calendar:\x00" +
+	"\U0001f5dc\ufe0f\x00:clamp:\x00" +
+	"\U0001f5dd\ufe0f\x00:old_key:\x00" +
+	"\U0001f5de\ufe0f\x00:newspaper_roll:\x00" +
+	"\U0001f5e1\ufe0f\x00:dagger:\x00" +
+	"\U0001f5e3\ufe0f\x00:speaking_head:\x00" +
+	"\U0001f5e8\ufe0f\x00:left_speech_bubble:\x00" +
+	"\U0001f5ef\ufe0f\x00:right_anger_bubble:\x00" +
+	"\U0001f5f3\ufe0f\x00:ballot_box_with_ballot:\x00" +
+	"\U0001f5fa\ufe0f\x00:world_map:\x00" +
+	"\U0001f5fb\x00:mount_fuji:\x00" +
+	"\U0001f5fc\x00:tokyo_tower:\x00" +
+	"\U0001f5fd\x00:statue_of_liberty:\x00" +
+	"\U0001f5fe\x00:japan:\x00" +
+	"\U0001f5ff\x00:moai:\x00" +
+	"\U0001f600\x00:grinning_face:\x00" +
+	"\U0001f601\x00:beaming_face_with_smiling_eyes:\x00" +
+	"\U0001f602\x00:joy:\x00" +
+	"\U0001f603\x00:smiley:\x00" +
+	"\U0001f604\x00:grinning_face_with_smiling_eyes:\x00" +
+	"\U0001f605\x00:sweat_smile:\x00" +
+	"\U0001f606\x00:laughing:\x00" +
+	"\U0001f607\x00:innocent:\x00" +
+	"\U0001f608\x00:smiling_face_with_horns:\x00" +
+	"\U0001f609\x00:winking_face:\x00" +
+	"\U0001f60a\x00:smiling_face_with_smiling_eyes:\x00" +
+	"\U0001f60b\x00:face_savoring_food:\x00" +
+	"\U0001f60c\x00:relieved:\x00" +
+	"\U0001f60d\x00:heart_eyes:\x00" +
+	"\U0001f60e\x00:sunglasses:\x00" +
+	"\U0001f60f\x00:smirk:\x00" +
+	"\U0001f610\x00:neutral_face:\x00" +
+	"\U0001f611\x00:expressionless_face:\x00" +
+	"\U0001f612\x00:unamused_face:\x00" +
+	"\U0001f613\x00:sweat:\x00" +
+	"\U0001f614\x00:pensive_face:\x00" +
+	"\U0001f615\x00:confused:\x00" +
+	"\U0001f616\x00:confounded_face:\x00" +
+	"\U0001f617\x00:kissing_face:\x00" +
+	"\U0001f618\x00:face_blowing_a_kiss:\x00" +
+	"\U0001f619\x00:kissing_smiling_eyes:\x00" +
+	"\U0001f61a\x00:kissing_face_with_closed_eyes:\x00" +
+	"\U0001f61b\x00:face_with_tongue:\x00" +
+	"\U0001f61c\x00:stuck_out_tongue_winking_eye:\x00" +
+	"\U0001f61d\x00:squinting_face_with_tongue:\x00" +
+	"\U0001f61e\x00:disappointed:\x00" +
+	"\U0001f61f\x00:worried:\x00" +
+	"\U0001f620\x00:angry_face:\x00" +
+	"\U0001f621\x00:rage:\x00" +
+	"\U0001f622\x00:crying_face:\x00" +
+	"\U0001f623\x00:persevere:\x00" +
+	"\U0001f624\x00:triumph:\x00" +
+	"\U0001f625\x00:disappointed_relieved:\x00" +
+	"\U0001f626\x00:frowning:\x00" +
+	"\U0001f627\x00:anguished_face:\x00" +
+	"\U0001f628\x00:fearful_face:\x00" +
+	"\U0001f629\x00:weary_face:\x00" +
+	"\U0001f62a\x00:sleepy_face:\x00" +
+	"\U0001f62b\x00:tired_face:\x00" +
+	"\U0001f62c\x00:grimacing_face:\x00" +
+	"\U0001f62d\x00:sob:\x00" +
+	"\U0001f62e\x00:face_with_open_mouth:\x00" +
+	"\U0001f62e\u200d\U0001f4a8\x00:face_exhaling:\x00" +
+	"\U0001f62f\x00:hushed_face:\x00" +
+	"\U0001f630\x00:cold_sweat:\x00" +
+	"\U0001f631\x00:face_screaming_in_fear:\x00" +
+	"\U0001f632\x00:astonished:\x00" +
+	"\U0001f633\x00:flushed_face:\x00" +
+	"\U0001f634\x00:sleeping_face:\x00" +
+	"\U0001f635\x00:dizzy_face:\x00" +
+	"\U0001f635\u200d\U0001f4ab\x00:face_with_spiral_eyes:\x00" +
+	"\U0001f636\x00:face_without_mouth:\x00" +
+	"\U0001f636\u200d\U0001f32b\ufe0f\x00:face_in_clouds:\x00" +
+	"\U0001f637\x00:mask:\x00" +
+	"\U0001f638\x00:grinning_cat_with_smiling_eyes:\x00" +
+	"\U0001f639\x00:cat_with_tears_of_joy:\x00" +
+	"\U0001f63a\x00:smiley_cat:\x00" +
+	"\U0001f63b\x00:heart_eyes_cat:\x00" +
+	"\U0001f63c\x00:smirk_cat:\x00" +
+	"\U0001f63d\x00:kissing_cat:\x00" +
+	"\U0001f63e\x00:pouting_cat:\x00" +
+	"\U0001f63f\x00:crying_cat_face:\x00" +
+	"\U0001f640\x00:scream_cat:\x00" +
+	"\U0001f641\x00:slightly_frowning_face:\x00" +
+	"\U0001f642\x00:slightly_smiling_face:\x00" +
+	"\U0001f643\x00:upside_down_face:\x00" +
+	"\U0001f644\x00:face_with_rolling_eyes:\x00" +
+	"\U0001f645\x00:no_good:\x00" +
+	"\U0001f645\u200d\u2640\ufe0f\x00:ng_woman:\x00" +
+	"\U0001f645\u200d\u2642\ufe0f\x00:no_good_man:\x00" +
+	"\U0001f646\x00:person_gesturing_ok:\x00" +
+	"\U0001f646\u200d\u2640\ufe0f\x00:woman_gesturing_ok:\x00" +
+	"\U0001f646\u200d\u2642\ufe0f\x00:man_gesturing_ok:\x00" +
+	"\U0001f647\x00:person_bowing:\x00" +
+	"\U0001f647\u200d\u2640\ufe0f\x00:woman_bowing:\x00" +
+	"\U0001f647\u200d\u2642\ufe0f\x00:man_bowing:\x00" +
+	"\U0001f648\x00:see_no_evil:\x00" +
+	"\U0001f649\x00:hear_no_evil_monkey:\x00" +
+	"\U0001f64a\x00:speak_no_evil:\x00" +
+	"\U0001f64b\x00:person_raising_hand:\x00" +
+	"\U0001f64b\u200d\u2640\ufe0f\x00:raising_hand_woman:\x00" +
+	"\U0001f64b\u200d\u2642\ufe0f\x00:man_raising_hand:\x00" +
+	"\U0001f64c\x00:raising_hands:\x00" +
+	"\U0001f64d\x00:person_frowning:\x00" +
+	"\U0001f64d\u200d\u2640\ufe0f\x00:woman_frowning:\x00" +
+	"\U0001f64d\u200d\u2642\ufe0f\x00:frowning_man:\x00" +
+	"\U0001f64e\x00:pouting_face:\x00" +
+	"\U0001f64e\u200d\u2640\ufe0f\x00:pouting_woman:\x00" +
+	"\U0001f64e\u200d\u2642\ufe0f\x00:man_pouting:\x00" +
+	"\U0001f64f\x00:folded_hands:\x00" +
+	"\U0001f680\x00:rocket:\x00" +
+	"\U0001f681\x00:helicopter:\x00" +
+	"\U0001f682\x00:locomotive:\x00" +
+	"\U0001f683\x00:railway_car:\x00" +
+	"\U0001f684\x00:bullettrain_side:\x00" +
+	"\U0001f685\x00:bullet_train:\x00" +
+	"\U0001f686\x00:train2:\x00" +
+	"\U0001f687\x00:metro:\x00" +
+	"\U0001f688\x00:light_rail:\x00" +
+	"\U0001f689\x00:station:\x00" +
+	"\U0001f68a\x00:tram:\x00" +
+	"\U0001f68b\x00:train:\x00" +
+	"\U0001f68c\x00:bus:\x00" +
+	"\U0001f68d\x00:oncoming_bus:\x00" +
+	"\U0001f68e\x00:trolleybus:\x00" +
+	"\U0001f68f\x00:busstop:\x00" +
+	"\U0001f690\x00:minibus:\x00" +
+	"\U0001f691\x00:ambulance:\x00" +
+	"\U0001f692\x00:fire_engine:\x00" +
+	"\U0001f693\x00:police_car:\x00" +
+	"\U0001f694\x00:oncoming_police_car:\x00" +
+	"\U0001f695\x00:taxi:\x00" +
+	"\U0001f696\x00:oncoming_taxi:\x00" +
+	"\U0001f697\x00:red_car:\x00" +
+	"\U0001f698\x00:oncoming_automobile:\x00" +
+	"\U0001f699\x00:blue_car:\x00" +
+	"\U0001f69a\x00:delivery_truck:\x00" +
+	"\U0001f69b\x00:articulated_lorry:\x00" +
+	"\U0001f69c\x00:tractor:\x00" +
+	"\U0001f69d\x00:monorail:\x00" +
+	"\U0001f69e\x00:mountain_railway:\x00" +
+	"\U0001f69f\x00:suspension_railway:\x00" +
+	"\U0001f6a0\x00:mountain_cableway:\x00" +
+	"\U0001f6a1\x00:aerial_tramway:\x00" +
+	"\U0001f6a2\x00:ship:\x00" +
+	"\U0001f6a3\x00:rowboat:\x00" +
+	"\U0001f6a3\u200d\u2640\ufe0f\x00:woman_rowing_boat:\x00" +
+	"\U0001f6a3\u200d\u2642\ufe0f\x00:rowing_man:\x00" +
+	"\U0001f6a4\x00:speedboat:\x00" +
+	"\U0001f6a5\x00:traffic_light:\x00" +
+	"\U0001f6a6\x00:vertical_traffic_light:\x00" +
+	"\U0001f6a7\x00:construction:\x00" +
+	"\U0001f6a8\x00:rotating_light:\x00" +
+	"\U0001f6aa\x00:door:\x00" +
+	"\U0001f6ab\x00:prohibited:\x00" +
+	"\U0001f6ac\x00:cigarette:\x00" +
+	"\U0001f6ad\x00:no_smoking:\x00" +
+	"\U0001f6ae\x00:litter_in_bin_sign:\x00" +
+	"\U0001f6af\x00:no_littering:\x00" +
+	"\U0001f6b0\x00:potable_water:\x00" +
+	"\U0001f6b1\x00:non_potable_water:\x00" +
+	"\U0001f6b2\x00:bicycle:\x00" +
+	"\U0001f6b3\x00:no_bicycles:\x00" +
+	"\U0001f6b4\x00:bicyclist:\x00" +
+	"\U0001f6b4\u200d\u2640\ufe0f\x00:biking_woman:\x00" +
+	"\U0001f6b4\u200d\u2642\ufe0f\x00:biking_man:\x00" +
+	"\U0001f6b5\x00:person_mountain_biking:\x00" +
+	"\U0001f6b5\u200d\u2640\ufe0f\x00:mountain_biking_woman:\x00" +
+	"\U0001f6b5\u200d\u2642\ufe0f\x00:mountain_biking_man:\x00" +
+	"\U0001f6b6\x00:walking:\x00" +
+	"\U0001f6b6\u200d\u2640\ufe0f\x00:walking_woman:\x00" +
+	"\U0001f6b6\u200d\u2642\ufe0f\x00:walking_man:\x00" +
+	"\U0001f6b7\x00:no_pedestrians:\x00" +
+	"\U0001f6b8\x00:children_crossing:\x00" +
+	"\U0001f6b9\x00:mens:\x00" +
+	"\U0001f6ba\x00:women_s_room:\x00" +
+	"\U0001f6bb\x00:restroom:\x00" +
+	"\U0001f6bc\x00:baby_symbol:\x00" +
+	"\U0001f6bd\x00:toilet:\x00" +
+	"\U0001f6be\x00:water_closet:\x00" +
+	"\U0001f6bf\x00:shower:\x00" +
+	"\U0001f6c0\x00:bath:\x00" +
+	"\U0001f6c1\x00:bathtub:\x00" +
+	"\U0001f6c2\x00:passport_control:\x00" +
+	"\U0001f6c3\x00:customs:\x00" +
+	"\U0001f6c4\x00:baggage_claim:\x00" +
+	"\U0001f6c5\x00:left_luggage:\x00" +
+	"\U0001f6cb\ufe0f\x00:couch_and_lamp:\x00" +
+	"\U0001f6cc\x00:person_in_bed:\x00" +
+	"\U0001f6cd\ufe0f\x00:shopping_bags:\x00" +
+	"\U0001f6ce\ufe0f\x00:bellhop_bell:\x00" +
+	"\U0001f6cf\ufe0f\x00:bed:\x00" +
+	"\U0001f6d0\x00:place_of_worship:\x00" +
+	"\U0001f6d1\x00:stop_sign:\x00" +
+	"\U0001f6d2\x00:shopping_cart:\x00" +
+	"\U0001f6d5\x00:hindu_temple:\x00" +
+	"\U0001f6d6\x00:hut:\x00" +
+	"\U0001f6d7\x00:elevator:\x00" +
+	"\U0001f6dd\x00:playground_slide:\x00" +
+	"\U0001f6de\x00:wheel:\x00" +
+	"\U0001f6df\x00:ring_buoy:\x00" +
+	"\U0001f6e0\ufe0f\x00:hammer_and_wrench:\x00" +
+	"\U0001f6e1\ufe0f\x00:shield:\x00" +
+	"\U0001f6e2\ufe0f\x00:oil_drum:\x00" +
+	"\U0001f6e3\ufe0f\x00:motorway:\x00" +
+	"\U0001f6e4\ufe0f\x00:railway_track:\x00" +
+	"\U0001f6e5\ufe0f\x00:motor_boat:\x00" +
+	"\U0001f6e9\ufe0f\x00:small_airplane:\x00" +
+	"\U0001f6eb\x00:airplane_departure:\x00" +
+	"\U0001f6ec\x00:flight_arrival:\x00" +
+	"\U0001f6f0\ufe0f\x00:artificial_satellite:\x00" +
+	"\U0001f6f3\ufe0f\x00:passenger_ship:\x00" +
+	"\U0001f6f4\x00:kick_scooter:\x00" +
+	"\U0001f6f5\x00:motor_scooter:\x00" +
+	"\U0001f6f6\x00:canoe:\x00" +
+	"\U0001f6f7\x00:sled:\x00" +
+	"\U0001f6f8\x00:flying_saucer:\x00" +
+	"\U0001f6f9\x00:skateboard:\x00" +
+	"\U0001f6fa\x00:auto_rickshaw:\x00" +
+	"\U0001f6fb\x00:pickup_truck:\x00" +
+	"\U0001f6fc\x00:roller_skate:\x00" +
+	"\U0001f7e0\x00:orange_circle:\x00" +
+	"\U0001f7e1\x00:yellow_circle:\x00" +
+	"\U0001f7e2\x00:green_circle:\x00" +
+	"\U0001f7e3\x00:purple_circle:\x00" +
+	"\U0001f7e4\x00:brown_circle:\x00" +
+	"\U0001f7e5\x00:red_square:\x00" +
+	"\U0001f7e6\x00:blue_square:\x00" +
+	"\U0001f7e7\x00:orange_square:\x00" +
+	"\U0001f7e8\x00:yellow_square:\x00" +
+	"\U0001f7e9\x00:green_square:\x00" +
+	"\U0001f7ea\x00:purple_square:\x00" +
+	"\U0001f7eb\x00:brown_square:\x00" +
+	"\U0001f7f0\x00:heavy_equals_sign:\x00" +
+	"\U0001f90c\x00:pinched_fingers:\x00" +
+	"\U0001f90d\x00:white_heart:\x00" +
+	"\U0001f90e\x00:brown_heart:\x00" +
+	"\U0001f90f\x00:pinching_hand:\x00" +
+	"\U0001f910\x00:zipper_mouth_face:\x00" +
+	"\U0001f911\x00:money_mouth_face:\x00" +
+	"\U0001f912\x00:face_with_thermometer:\x00" +
+	"\U0001f913\x00:nerd_face:\x00" +
+	"\U0001f914\x00:thinking:\x00" +
+	"\U0001f915\x00:face_with_head_bandage:\x00" +
+	"\U0001f916\x00:robot_face:\x00" +
+	"\U0001f917\x00:smiling_face_with_open_hands:\x00" +
+	"\U0001f918\x00:sign_of_the_horns:\x00" +
+	"\U0001f919\x00:call_me_hand:\x00" +
+	"\U0001f91a\x00:raised_back_of_hand:\x00" +
+	"\U0001f91b\x00:fist_left:\x00" +
+	"\U0001f91c\x00:fist_right:\x00" +
+	"\U0001f91d\x00:handshake:\x00" +
+	"\U0001f91e\x00:crossed_fingers:\x00" +
+	"\U0001f91f\x00:love_you_gesture:\x00" +
+	"\U0001f920\x00:cowboy_hat_face:\x00" +
+	"\U0001f921\x00:clown_face:\x00" +
+	"\U0001f922\x00:nauseated_face:\x00" +
+	"\U0001f923\x00:rolling_on_the_floor_laughing:\x00" +
+	"\U0001f924\x00:drooling_face:\x00" +
+	"\U0001f925\x00:lying_face:\x00" +
+	"\U0001f926\x00:person_facepalming:\x00" +
+	"\U0001f926\u200d\u2640\ufe0f\x00:woman_facepalming:\x00" +
+	"\U0001f926\u200d\u2642\ufe0f\x00:man_facepalming:\x00" +
+	"\U0001f927\x00:sneezing_face:\x00" +
+	"\U0001f928\x00:raised_eyebrow:\x00" +
+	"\U0001f929\x00:star_struck:\x00" +
+	"\U0001f92a\x00:zany_face:\x00" +
+	"\U0001f92b\x00:shushing_face:\x00" +
+	"\U0001f92c\x00:face_with_symbols_on_mouth:\x00" +
+	"\U0001f92d\x00:face_with_hand_over_mouth:\x00" +
+	"\U0001f92e\x00:face_vomiting:\x00" +
+	"\U0001f92f\x00:exploding_head:\x00" +
+	"\U0001f930\x00:pregnant_woman:\x00" +
+	"\U0001f931\x00:breast_feeding:\x00" +
+	"\U0001f932\x00:palms_up_together:\x00" +
+	"\U0001f933\x00:selfie:\x00" +
+	"\U0001f934\x00:prince:\x00" +
+	"\U0001f935\x00:person_in_tuxedo:\x00" +
+	"\U0001f935\u200d\u2640\ufe0f\x00:woman_in_tuxedo:\x00" +
+	"\U0001f935\u200d\u2642\ufe0f\x00:man_in_tuxedo:\x00" +
+	"\U0001f936\x00:mrs_claus:\x00" +
+	"\U0001f937\x00:person_shrugging:\x00" +
+	"\U0001f937\u200d\u2640\ufe0f\x00:woman_shrugging:\x00" +
+	"\U0001f937\u200d\u2642\ufe0f\x00:man_shrugging:\x00" +
+	"\U0001f938\x00:cartwheeling:\x00" +
+	"\U0001f938\u200d\u2640\ufe0f\x00:woman_cartwheeling:\x00" +
+	"\U0001f938\u200d\u2642\ufe0f\x00:man_cartwheeling:\x00" +
+	"\U0001f939\x00:person_juggling:\x00" +
+	"\U0001f939\u200d\u2640\ufe0f\x00:woman_juggling:\x00" +
+	"\U0001f939\u200d\u2642\ufe0f\x00:man_juggling:\x00" +
+	"\U0001f93a\x00:person_fencing:\x00" +
+	"\U0001f93c\x00:people_wrestling:\x00" +
+	"\U0001f93c\u200d\u2640\ufe0f\x00:women_wrestling:\x00" +
+	"\U0001f93c\u200d\u2642\ufe0f\x00:men_wrestling:\x00" +
+	"\U0001f93d\x00:person_playing_water_polo:\x00" +
+	"\U0001f93d\u200d\u2640\ufe0f\x00:woman_playing_water_polo:\x00" +
+	"\U0001f93d\u200d\u2642\ufe0f\x00:man_playing_water_polo:\x00" +
+	"\U0001f93e\x00:person_playing_handball:\x00" +
+	"\U0001f93e\u200d\u2640\ufe0f\x00:woman_playing_handball:\x00" +
+	"\U0001f93e\u200d\u2642\ufe0f\x00:man_playing_handball:\x00" +
+	"\U0001f93f\x00:diving_mask:\x00" +
+	"\U0001f940\x00:wilted_flower:\x00" +
+	"\U0001f941\x00:drum:\x00" +
+	"\U0001f942\x00:clinking_glasses:\x00" +
+	"\U0001f943\x00:tumbler_glass:\x00" +
+	"\U0001f944\x00:spoon:\x00" +
+	"\U0001f945\x00:goal_net:\x00" +
+	"\U0001f947\x00:1st_place_medal:\x00" +
+	"\U0001f948\x00:second_place_medal:\x00" +
+	"\U0001f949\x00:third_place_medal:\x00" +
+	"\U0001f94a\x00:boxing_glove:\x00" +
+	"\U0001f94b\x00:martial_arts_uniform:\x00" +
+	"\U0001f94c\x00:curling_stone:\x00" +
+	"\U0001f94d\x00:lacrosse:\x00" +
+	"\U0001f94e\x00:softball:\x00" +
+	"\U0001f94f\x00:flying_disc:\x00" +
+	"\U0001f950\x00:croissant:\x00" +
+	"\U0001f951\x00:avocado:\x00" +
+	"\U0001f952\x00:cucumber:\x00" +
+	"\U0001f953\x00:bacon:\x00" +
+	"\U0001f954\x00:potato:\x00" +
+	"\U0001f955\x00:carrot:\x00" +
+	"\U0001f956\x00:baguette_bread:\x00" +
+	"\U0001f957\x00:green_salad:\x00" +
+	"\U0001f958\x00:shallow_pan_of_food:\x00" +
+	"\U0001f959\x00:stuffed_flatbread:\x00" +
+	"\U0001f95a\x00:egg:\x00" +
+	"\U0001f95b\x00:milk_glass:\x00" +
+	"\U0001f95c\x00:peanuts:\x00" +
+	"\U0001f95d\x00:kiwi_fruit:\x00" +
+	"\U0001f95e\x00:pancakes:\x00" +
+	"\U0001f95f\x00:dumpling:\x00" +
+	"\U0001f960\x00:fortune_cookie:\x00" +
+	"\U0001f961\x00:takeout_box:\x00" +
+	"\U0001f962\x00:chopsticks:\x00" +
+	"\U0001f963\x00:bowl_with_spoon:\x00" +
+	"\U0001f964\x00:cup_with_straw:\x00" +
+	"\U0001f965\x00:coconut:\x00" +
+	"\U0001f966\x00:broccoli:\x00" +
+	"\U0001f967\x00:pie:\x00" +
+	"\U0001f968\x00:pretzel:\x00" +
+	"\U0001f969\x00:cut_of_meat:\x00" +
+	"\U0001f96a\x00:sandwich:\x00" +
+	"\U0001f96b\x00:canned_food:\x00" +
+	"\U0001f96c\x00:leafy_green:\x00" +
+	"\U0001f96d\x00:mango:\x00" +
+	"\U0001f96e\x00:moon_cake:\x00" +
+	"\U0001f96f\x00:bagel:\x00" +
+	"\U0001f970\x00:smiling_face_with_three_hearts:\x00" +
+	"\U0001f971\x00:yawning_face:\x00" +
+	"\U0001f972\x00:smiling_face_with_tear:\x00" +
+	"\U0001f973\x00:partying_face:\x00" +
+	"\U0001f974\x00:woozy_face:\x00" +
+	"\U0001f975\x00:hot_face:\x00" +
+	"\U0001f976\x00:cold_face:\x00" +
+	"\U0001f977\x00:ninja:\x00" +
+	"\U0001f978\x00:disguised_face:\x00" +
+	"\U0001f979\x00:face_holding_back_tears:\x00" +
+	"\U0001f97a\x00:pleading_face:\x00" +
+	"\U0001f97b\x00:sari:\x00" +
+	"\U0001f97c\x00:lab_coat:\x00" +
+	"\U0001f97d\x00:goggles:\x00" +
+	"\U0001f97e\x00:hiking_boot:\x00" +
+	"\U0001f97f\x00:flat_shoe:\x00" +
+	"\U0001f980\x00:crab:\x00" +
+	"\U0001f981\x00:lion:\x00" +
+	"\U0001f982\x00:scorpion:\x00" +
+	"\U0001f983\x00:turkey:\x00" +
+	"\U0001f984\x00:unicorn:\x00" +
+	"\U0001f985\x00:eagle:\x00" +
+	"\U0001f986\x00:duck:\x00" +
+	"\U0001f987\x00:bat:\x00" +
+	"\U0001f988\x00:shark:\x00" +
+	"\U0001f989\x00:owl:\x00" +
+	"\U0001f98a\x00:fox:\x00" +
+	"\U0001f98b\x00:butterfly:\x00" +
+	"\U0001f98c\x00:deer:\x00" +
+	"\U0001f98d\x00:gorilla:\x00" +
+	"\U0001f98e\x00:lizard:\x00" +
+	"\U0001f98f\x00:rhinoceros:\x00" +
+	"\U0001f990\x00:shrimp:\x00" +
+	"\U0001f991\x00:squid:\x00" +
+	"\U0001f992\x00:giraffe:\x00" +
+	"\U0001f993\x00:zebra:\x00" +
+	"\U0001f994\x00:hedgehog:\x00" +
+	"\U0001f995\x00:sauropod:\x00" +
+	"\U0001f996\x00:t-rex:\x00" +
+	"\U0001f997\x00:cricket:\x00" +
+	"\U0001f998\x00:kangaroo:\x00" +
+	"\U0001f999\x00:llama:\x00" +
+	"\U0001f99a\x00:peacock:\x00" +
+	"\U0001f99b\x00:hippopotamus:\x00" +
+	"\U0001f99c\x00:parrot:\x00" +
+	"\U0001f99d\x00:raccoon:\x00" +
+	"\U0001f99e\x00:lobster:\x00" +
+	"\U0001f99f\x00:mosquito:\x00" +
+	"\U0001f9a0\x00:microbe:\x00" +
+	"\U0001f9a1\x00:badger:\x00" +
+	"\U0001f9a2\x00:swan:\x00" +
+	"\U0001f9a3\x00:mammoth:\x00" +
+	"\U0001f9a4\x00:dodo:\x00" +
+	"\U0001f9a5\x00:sloth:\x00" +
+	"\U0001f9a6\x00:otter:\x00" +
+	"\U0001f9a7\x00:orangutan:\x00" +
+	"\U0001f9a8\x00:skunk:\x00" +
+	"\U0001f9a9\x00:flamingo:\x00" +
+	"\U0001f9aa\x00:oyster:\x00" +
+	"\U0001f9ab\x00:beaver:\x00" +
+	"\U0001f9ac\x00:bison:\x00" +
+	"\U0001f9ad\x00:seal:\x00" +
+	"\U0001f9ae\x00:guide_dog:\x00" +
+	"\U0001f9af\x00:white_cane:\x00" +
+	"\U0001f9b0\x00:red_hair:\x00" +
+	"\U0001f9b1\x00:curly_hair:\x00" +
+	"\U0001f9b2\x00:bald:\x00" +
+	"\U0001f9b3\x00:white_hair:\x00" +
+	"\U0001f9b4\x00:bone:\x00" +
+	"\U0001f9b5\x00:leg:\x00" +
+	"\U0001f9b6\x00:foot:\x00" +
+	"\U0001f9b7\x00:tooth:\x00" +
+	"\U0001f9b8\x00:superhero:\x00" +
+	"\U0001f9b8\u200d\u2640\ufe0f\x00:superhero_woman:\x00" +
+	"\U0001f9b8\u200d\u2642\ufe0f\x00:man_superhero:\x00" +
+	"\U0001f9b9\x00:supervillain:\x00" +
+	"\U0001f9b9\u200d\u2640\ufe0f\x00:supervillain_woman:\x00" +
+	"\U0001f9b9\u200d\u2642\ufe0f\x00:supervillain_man:\x00" +
+	"\U0001f9ba\x00:safety_vest:\x00" +
+	"\U0001f9bb\x00:ear_with_hearing_aid:\x00" +
+	"\U0001f9bc\x00:motorized_wheelchair:\x00" +
+	"\U0001f9bd\x00:manual_wheelchair:\x00" +
+	"\U0001f9be\x00:mechanical_arm:\x00" +
+	"\U0001f9bf\x00:mechanical_leg:\x00" +
+	"\U0001f9c0\x00:cheese_wedge:\x00" +
+	"\U0001f9c1\x00:cupcake:\x00" +
+	"\U0001f9c2\x00:salt:\x00" +
+	"\U0001f9c3\x00:beverage_box:\x00" +
+	"\U0001f9c4\x00:garlic:\x00" +
+	"\U0001f9c5\x00:onion:\x00" +
+	"\U0001f9c6\x00:falafel:\x00" +
+	"\U0001f9c7\x00:waffle:\x00" +
+	"\U0001f9c8\x00:butter:\x00" +
+	"\U0001f9c9\x00:mate:\x00" +
+	"\U0001f9ca\x00:ice_cube:\x00" +
+	"\U0001f9cb\x00:bubble_tea:\x00" +
+	"\U0001f9cc\x00:troll:\x00" +
+	"\U0001f9cd\x00:person_standing:\x00" +
+	"\U0001f9cd\u200d\u2640\ufe0f\x00:woman_standing:\x00" +
+	"\U0001f9cd\u200d\u2642\ufe0f\x00:man_standing:\x00" +
+	"\U0001f9ce\x00:person_kneeling:\x00" +
+	"\U0001f9ce\u200d\u2640\ufe0f\x00:kneeling_woman:\x00" +
+	"\U0001f9ce\u200d\u2642\ufe0f\x00:kneeling_man:\x00" +
+	"\U0001f9cf\x00:deaf_person:\x00" +
+	"\U0001f9cf\u200d\u2640\ufe0f\x00:deaf_woman:\x00" +
+	"\U0001f9cf\u200d\u2642\ufe0f\x00:deaf_man:\x00" +
+	"\U0001f9d0\x00:face_with_monocle:\x00" +
+	"\U0001f9d1\x00:adult:\x00" +
+	"\U0001f9d1\u200d\u2695\ufe0f\x00:health_worker:\x00" +
+	"\U0001f9d1\u200d\u2696\ufe0f\x00:judge:\x00" +
+	"\U0001f9d1\u200d\u2708\ufe0f\x00:pilot:\x00" +
+	"\U0001f9d1\u200d\U0001f33e\x00:farmer:\x00" +
+	"\U0001f9d1\u200d\U0001f373\x00:cook:\x00" +
+	"\U0001f9d1\u200d\U0001f37c\x00:person_feeding_baby:\x00" +
+	"\U0001f9d1\u200d\U0001f384\x00:mx_claus:\x00" +
+	"\U0001f9d1\u200d\U0001f393\x00:student:\x00" +
+	"\U0001f9d1\u200d\U0001f3a4\x00:singer:\x00" +
+	"\U0001f9d1\u200d\U0001f3a8\x00:artist:\x00" +
+	"\U0001f9d1\u200d\U0001f3eb\x00:teacher:\x00" +
+	"\U0001f9d1\u200d\U0001f3ed\x00:factory_worker:\x00" +
+	"\U0001f9d1\u200d\U0001f4bb\x00:technologist:\x00" +
+	"\U0001f9d1\u200d\U0001f4bc\x00:office_worker:\x00" +
+	"\U0001f9d1\u200d\U0001f527\x00:mechanic:\x00" +
+	"\U0001f9d1\u200d\U0001f52c\x00:scientist:\x00" +
+	"\U0001f9d1\u200d\U0001f680\x00:astronaut:\x00" +
+	"\U0001f9d1\u200d\U0001f692\x00:firefighter:\x00" +
+	"\U0001f9d1\u200d\U0001f91d\u200d\U0001f9d1\x00:people_holding_hands:\x00" +
+	"\U0001f9d1\u200d\U0001f9af\x00:person_with_probing_cane:\x00" +
+	"\U0001f9d1\u200d\U0001f9b0\x00:person_red_hair:\x00" +
+	"\U0001f9d1\u200d\U0001f9b1\x00:person_curly_hair:\x00" +
+	"\U0001f9d1\u200d\U0001f9b2\x00:person_bald:\x00" +
+	"\U0001f9d1\u200d\U0001f9b3\x00:person_with_white_hair:\x00" +
+	"\U0001f9d1\u200d\U0001f9bc\x00:person_in_motorized_wheelchair:\x00" +
+	"\U0001f9d1\u200d\U0001f9bd\x00:person_in_manual_wheelchair:\x00" +
+	"\U0001f9d1\U0001f3fb\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f9d1\U0001f3fc\x00:kiss_person_person:\x00" +
+	"\U0001f9d1\U0001f3fb\u200d\u2764\ufe0f\u200d\U0001f9d1\U0001f3fc\x00:couple_with_heart_person_person:\x00" +
+	"\U0001f9d2\x00:child:\x00" +
+	"\U0001f9d3\x00:older_adult:\x00" +
+	"\U0001f9d4\x00:person_with_beard:\x00" +
+	"\U0001f9d4\u200d\u2640\ufe0f\x00:woman_with_beard:\x00" +
+	"\U0001f9d4\u200d\u2642\ufe0f\x00:man_beard:\x00" +
+	"\U0001f9d5\x00:woman_with_headscarf:\x00" +
+	"\U0001f9d6\x00:sauna_person:\x00" +
+	"\U0001f9d6\u200d\u2640\ufe0f\x00:sauna_woman:\x00" +
+	"\U0001f9d6\u200d\u2642\ufe0f\x00:sauna_man:\x00" +
+	"\U0001f9d7\x00:person_climbing:\x00" +
+	"\U0001f9d7\u200d\u2640\ufe0f\x00:woman_climbing:\x00" +
+	"\U0001f9d7\u200d\u2642\ufe0f\x00:climbing_man:\x00" +
+	"\U0001f9d8\x00:lotus_position:\x00" +
+	"\U0001f9d8\u200d\u2640\ufe0f\x00:lotus_position_woman:\x00" +
+	"\U0001f9d8\u200d\u2642\ufe0f\x00:man_in_lotus_position:\x00" +
+	"\U0001f9d9\x00:mage:\x00" +
+	"\U0001f9d9\u200d\u2640\ufe0f\x00:mage_woman:\x00" +
+	"\U0001f9d9\u200d\u2642\ufe0f\x00:mage_man:\x00" +
+	"\U0001f9da\x00:fairy:\x00" +
+	"\U0001f9da\u200d\u2640\ufe0f\x00:woman_fairy:\x00" +
+	"\U0001f9da\u200d\u2642\ufe0f\x00:fairy_man:\x00" +
+	"\U0001f9db\x00:vampire:\x00" +
+	"\U0001f9db\u200d\u2640\ufe0f\x00:woman_vampire:\x00" +
+	"\U0001f9db\u200d\u2642\ufe0f\x00:vampire_man:\x00" +
+	"\U0001f9dc\x00:merperson:\x00" +
+	"\U0001f9dc\u200d\u2640\ufe0f\x00:mermaid:\x00" +
+	"\U0001f9dc\u200d\u2642\ufe0f\x00:merman:\x00" +
+	"\U0001f9dd\x00:elf:\x00" +
+	"\U0001f9dd\u200d\u2640\ufe0f\x00:elf_woman:\x00" +
+	"\U0001f9dd\u200d\u2642\ufe0f\x00:elf_man:\x00" +
+	"\U0001f9de\x00:genie:\x00" +
+	"\U0001f9de\u200d\u2640\ufe0f\x00:woman_genie:\x00" +
+	"\U0001f9de\u200d\u2642\ufe0f\x00:man_genie:\x00" +
+	"\U0001f9df\x00:zombie:\x00" +
+	"\U0001f9df\u200d\u2640\ufe0f\x00:zombie_woman:\x00" +
+	"\U0001f9df\u200d\u2642\ufe0f\x00:zombie_man:\x00" +
+	"\U0001f9e0\x00:brain:\x00" +
+	"\U0001f9e1\x00:orange_heart:\x00" +
+	"\U0001f9e2\x00:billed_cap:\x00" +
+	"\U0001f9e3\x00:scarf:\x00" +
+	"\U0001f9e4\x00:gloves:\x00" +
+	"\U0001f9e5\x00:coat:\x00" +
+	"\U0001f9e6\x00:socks:\x00" +
+	"\U0001f9e7\x00:red_envelope:\x00" +
+	"\U0001f9e8\x00:firecracker:\x00" +
+	"\U0001f9e9\x00:puzzle_piece:\x00" +
+	"\U0001f9ea\x00:test_tube:\x00" +
+	"\U0001f9eb\x00:petri_dish:\x00" +
+	"\U0001f9ec\x00:dna:\x00" +
+	"\U0001f9ed\x00:compass:\x00" +
+	"\U0001f9ee\x00:abacus:\x00" +
+	"\U0001f9ef\x00:fire_extinguisher:\x00" +
+	"\U0001f9f0\x00:toolbox:\x00" +
+	"\U0001f9f1\x00:brick:\x00" +
+	"\U0001f9f2\x00:magnet:\x00" +
+	"\U0001f9f3\x00:luggage:\x00" +
+	"\U0001f9f4\x00:lotion_bottle:\x00" +
+	"\U0001f9f5\x00:thread:\x00" +
+	"\U0001f9f6\x00:yarn:\x00" +
+	"\U0001f9f7\x00:safety_pin:\x00" +
+	"\U0001f9f8\x00:teddy_bear:\x00" +
+	"\U0001f9f9\x00:broom:\x00" +
+	"\U0001f9fa\x00:basket:\x00" +
+	"\U0001f9fb\x00:roll_of_paper:\x00" +
+	"\U0001f9fc\x00:soap:\x00" +
+	"\U0001f9fd\x00:sponge:\x00" +
+	"\U0001f9fe\x00:receipt:\x00" +
+	"\U0001f9ff\x00:nazar_amulet:\x00" +
+	"\U0001fa70\x00:ballet_shoes:\x00" +
+	"\U0001fa71\x00:one_piece_swimsuit:\x00" +
+	"\U0001fa72\x00:swim_brief:\x00" +
+	"\U0001fa73\x00:shorts:\x00" +
+	"\U0001fa74\x00:thong_sandal:\x00" +
+	"\U0001fa78\x00:drop_of_blood:\x00" +
+	"\U0001fa79\x00:adhesive_bandage:\x00" +
+	"\U0001fa7a\x00:stethoscope:\x00" +
+	"\U0001fa7b\x00:x_ray:\x00" +
+	"\U0001fa7c\x00:crutch:\x00" +
+	"\U0001fa80\x00:yo_yo:\x00" +
+	"\U0001fa81\x00:kite:\x00" +
+	"\U0001fa82\x00:parachute:\x00" +
+	"\U0001fa83\x00:boomerang:\x00" +
+	"\U0001fa84\x00:magic_wand:\x00" +
+	"\U0001fa85\x00:pinata:\x00" +
+	"\U0001fa86\x00:nesting_dolls:\x00" +
+	"\U0001fa90\x00:ringed_planet:\x00" +
+	"\U0001fa91\x00:chair:\x00" +
+	"\U0001fa92\x00:razor:\x00" +
+	"\U0001fa93\x00:axe:\x00" +
+	"\U0001fa94\x00:diya_lamp:\x00" +
+	"\U0001fa95\x00:banjo:\x00" +
+	"\U0001fa96\x00:military_helmet:\x00" +
+	"\U0001fa97\x00:accordion:\x00" +
+	"\U0001fa98\x00:long_drum:\x00" +
+	"\U0001fa99\x00:coin:\x00" +
+	"\U0001fa9a\x00:carpentry_saw:\x00" +
+	"\U0001fa9b\x00:screwdriver:\x00" +
+	"\U0001fa9c\x00:ladder:\x00" +
+	"\U0001fa9d\x00:hook:\x00" +
+	"\U0001fa9e\x00:mirror:\x00" +
+	"\U0001fa9f\x00:window:\x00" +
+	"\U0001faa0\x00:plunger:\x00" +
+	"\U0001faa1\x00:sewing_needle:\x00" +
+	"\U0001faa2\x00:knot:\x00" +
+	"\U0001faa3\x00:bucket:\x00" +
+	"\U0001faa4\x00:mouse_trap:\x00" +
+	"\U0001faa5\x00:toothbrush:\x00" +
+	"\U0001faa6\x00:headstone:\x00" +
+	"\U0001faa7\x00:placard:\x00" +
+	"\U0001faa8\x00:rock:\x00" +
+	"\U0001faa9\x00:mirror_ball:\x00" +
+	"\U0001faaa\x00:identification_card:\x00" +
+	"\U0001faab\x00:low_battery:\x00" +
+	"\U0001faac\x00:hamsa:\x00" +
+	"\U0001fab0\x00:fly:\x00" +
+	"\U0001fab1\x00:worm:\x00" +
+	"\U0001fab2\x00:beetle:\x00" +
+	"\U0001fab3\x00:cockroach:\x00" +
+	"\U0001fab4\x00:potted_plant:\x00" +
+	"\U0001fab5\x00:wood:\x00" +
+	"\U0001fab6\x00:feather:\x00" +
+	"\U0001fab7\x00:lotus:\x00" +
+	"\U0001fab8\x00:coral:\x00" +
+	"\U0001fab9\x00:empty_nest:\x00" +
+	"\U0001faba\x00:nest_with_eggs:\x00" +
+	"\U0001fac0\x00:anatomical_heart:\x00" +
+	"\U0001fac1\x00:lungs:\x00" +
+	"\U0001fac2\x00:people_hugging:\x00" +
+	"\U0001fac3\x00:pregnant_man:\x00" +
+	"\U0001fac4\x00:pregnant_person:\x00" +
+	"\U0001fac5\x00:person_with_crown:\x00" +
+	"\U0001fad0\x00:blueberries:\x00" +
+	"\U0001fad1\x00:bell_pepper:\x00" +
+	"\U0001fad2\x00:olive:\x00" +
+	"\U0001fad3\x00:flatbread:\x00" +
+	"\U0001fad4\x00:tamale:\x00" +
+	"\U0001fad5\x00:fondue:\x00" +
+	"\U0001fad6\x00:teapot:\x00" +
+	"\U0001fad7\x00:pouring_liquid:\x00" +
+	"\U0001fad8\x00:beans:\x00" +
+	"\U0001fad9\x00:jar:\x00" +
+	"\U0001fae0\x00:melting_face:\x00" +
+	"\U0001fae1\x00:saluting_face:\x00" +
+	"\U0001fae2\x00:face_with_open_eyes_and_hand_over_mouth:\x00" +
+	"\U0001fae3\x00:face_with_peeking_eye:\x00" +
+	"\U0001fae4\x00:face_with_diagonal_mouth:\x00" +
+	"\U0001fae5\x00:dotted_line_face:\x00" +
+	"\U0001fae6\x00:biting_lip:\x00" +
+	"\U0001fae7\x00:bubbles:\x00" +
+	"\U0001faf0\x00:hand_with_index_finger_and_thumb_crossed:\x00" +
+	"\U0001faf1\x00:rightwards_hand:\x00" +
+	"\U0001faf2\x00:leftwards_hand:\x00" +
+	"\U0001faf3\x00:palm_down_hand:\x00" +
+	"\U0001faf4\x00:palm_up_hand:\x00" +
+	"\U0001faf5\x00:index_pointing_at_the_viewer:\x00" +
+	"\U0001faf6\x00:heart_hands:\x00"
@@ -6,15 +6,15 @@ import (
 )
 
 func TestEmojiExists(t *testing.T) {
-	x := reverseEmojiMap["❤️"]
+	x, _ := FindReverse("❤️")
 	if x != ":red_heart:" {
 		t.Fatal("Emoji not found")
 	}
-	y := reverseEmojiMap["1️⃣"]
+	y, _ := FindReverse("1️⃣")
 	if y != ":one:" {
 		t.Fatal("Emoji not found")
 	}
-	z := reverseEmojiMap["❤️‍🔥"]
+	z, _ := FindReverse("❤️‍🔥")
 	if z != ":heart_on_fire:" {
 		t.Fatal("Emoji not found")
 	}
@@ -46,7 +46,7 @@ func TestEmojiExists(t *testing.T) {
 	}
 	for _, tt := range testCases {
 		t.Run(tt.name, func(t *testing.T) {
-			got := reverseEmojiMap[tt.emoji]
+			got, _ := FindReverse(tt.emoji)
 			if tt.want != got {
 				t.Fatalf("test case %v fail: got: %v, expected: %v", tt.name, got, tt.want)
 			}
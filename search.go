@@ -0,0 +1,278 @@
+package emoji
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// annotation is the name and the keywords of an emoji.
+type annotation struct {
+	name     string
+	keywords []string
+}
+
+// annotationOf returns the annotation of the emoji.
+func annotationOf(code string) (annotation, bool) {
+	if a, ok := emojiAnnotations[code]; ok {
+		return a, true
+	}
+	a, ok := flagAnnotations[code]
+
+	return a, ok
+}
+
+// Result is an emoji found by Search.
+type Result struct {
+	// Code is the code of the emoji.
+	Code string
+	// Alias is the alias of the emoji, e.g. ":grinning:". It's empty if the emoji has no alias.
+	Alias string
+	// Name is the name of the emoji, e.g. "grinning face".
+	Name string
+	// Score is the relevance of the emoji between 0 and 1, 1 being the most relevant.
+	Score float64
+}
+
+// SearchOption configures Search.
+type SearchOption func(*searchOptions)
+
+type searchOptions struct {
+	limit int
+	group string
+}
+
+// WithLimit returns at most n results.
+func WithLimit(n int) SearchOption {
+	return func(o *searchOptions) {
+		o.limit = n
+	}
+}
+
+// InGroup returns only the emojis of the group, e.g. "Smileys & Emotion".
+func InGroup(name string) SearchOption {
+	return func(o *searchOptions) {
+		o.group = name
+	}
+}
+
+// Weights of the fields of emojis in search scores
+const (
+	nameWeight    = 3
+	keywordWeight = 2
+	aliasWeight   = 1
+)
+
+// posting is an emoji having a term.
+type posting struct {
+	code   string
+	weight float64
+}
+
+var (
+	searchIndexOnce sync.Once
+	searchIndex     map[string][]posting
+	searchTerms     []string
+	searchRanks     map[string]int
+)
+
+// loadSearchIndex indexes the stemmed words of the names, keywords and aliases of emojis.
+func loadSearchIndex() {
+	best := make(map[string]map[string]float64)
+	add := func(code, text string, weight float64) {
+		for _, term := range searchTokens(text) {
+			if best[term] == nil {
+				best[term] = make(map[string]float64)
+			}
+			if weight > best[term][code] {
+				best[term][code] = weight
+			}
+		}
+	}
+
+	for _, annotations := range []map[string]annotation{emojiAnnotations, flagAnnotations} {
+		for code, a := range annotations {
+			add(code, a.name, nameWeight)
+			for _, k := range a.keywords {
+				add(code, k, keywordWeight)
+			}
+		}
+	}
+	emojiMap.each(func(alias, code string) {
+		if _, ok := annotationOf(code); ok {
+			add(code, strings.Trim(alias, ":"), aliasWeight)
+		}
+	})
+
+	searchIndex = make(map[string][]posting, len(best))
+	searchTerms = make([]string, 0, len(best))
+	for term, codes := range best {
+		for code, weight := range codes {
+			searchIndex[term] = append(searchIndex[term], posting{code: code, weight: weight})
+		}
+		searchTerms = append(searchTerms, term)
+	}
+	sort.Strings(searchTerms)
+
+	searchRanks = popularityRanks()
+}
+
+// Search returns the emojis matching all words of the query by their names, keywords and aliases,
+// most relevant first, e.g. 😀 and 🥳 for "happy". Words are matched regardless of their endings,
+// e.g. "smiling" matches "smile", and the last word of the query also matches as a prefix.
+func Search(query string, opts ...SearchOption) []Result {
+	searchIndexOnce.Do(loadSearchIndex)
+
+	var o searchOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	results := make([]Result, 0)
+	terms := searchTokens(query)
+	if len(terms) == 0 {
+		return results
+	}
+
+	var scores map[string]float64
+	for i, term := range terms {
+		matches := make(map[string]float64)
+		for _, p := range searchIndex[term] {
+			matches[p.code] = p.weight
+		}
+
+		// the last word might not be typed completely
+		if i == len(terms)-1 {
+			for n := sort.SearchStrings(searchTerms, term); n < len(searchTerms) && strings.HasPrefix(searchTerms[n], term); n++ {
+				for _, p := range searchIndex[searchTerms[n]] {
+					if w := p.weight / 2; w > matches[p.code] {
+						matches[p.code] = w
+					}
+				}
+			}
+		}
+
+		if scores == nil {
+			scores = matches
+			continue
+		}
+		for code, score := range scores {
+			if w, ok := matches[code]; ok {
+				scores[code] = score + w
+			} else {
+				delete(scores, code)
+			}
+		}
+	}
+
+	for code, score := range scores {
+		if grp, _ := emojiGroups.find(code); o.group != "" && grp != o.group {
+			continue
+		}
+		alias, _ := FindReverse(code)
+		a, _ := annotationOf(code)
+		results = append(results, Result{
+			Code:  code,
+			Alias: alias,
+			Name:  a.name,
+			Score: score / float64(nameWeight*len(terms)),
+		})
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		a, b := results[i], results[j]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		// shorter names are matched more closely
+		if len(a.Name) != len(b.Name) {
+			return len(a.Name) < len(b.Name)
+		}
+		if ra, rb := searchRank(a.Code), searchRank(b.Code); ra != rb {
+			return ra < rb
+		}
+		return a.Code < b.Code
+	})
+
+	if o.limit > 0 && len(results) > o.limit {
+		results = results[:o.limit]
+	}
+
+	return results
+}
+
+func searchRank(code string) int {
+	if rank, ok := searchRanks[code]; ok {
+		return rank
+	}
+
+	return len(popularEmojis)
+}
+
+// searchTokens returns the distinct stemmed words of the text.
+func searchTokens(text string) []string {
+	var tokens []string
+	seen := make(map[string]bool)
+	for _, word := range strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		if s := stem(word); !seen[s] {
+			seen[s] = true
+			tokens = append(tokens, s)
+		}
+	}
+
+	return tokens
+}
+
+// stem removes the common English suffixes of the word, so its forms have the same stem,
+// e.g. "smile", "smiles" and "smiling" are "smil". Stems are not meant to be words.
+func stem(word string) string {
+	if len(word) <= 3 {
+		return word
+	}
+
+	switch {
+	case strings.HasSuffix(word, "sses"):
+		word = word[:len(word)-2]
+	case strings.HasSuffix(word, "ies"):
+		word = word[:len(word)-3] + "y"
+	case strings.HasSuffix(word, "s") && !strings.HasSuffix(word, "ss") && !strings.HasSuffix(word, "us"):
+		word = word[:len(word)-1]
+	}
+
+	for _, suffix := range []string{"iness", "ness", "ing", "ed", "ly"} {
+		if base := strings.TrimSuffix(word, suffix); base != word && len(base) >= 3 && strings.ContainsAny(base, "aeiouy") {
+			if suffix == "iness" {
+				base += "y"
+			}
+			if (suffix == "ing" || suffix == "ed") && isDoubleConsonant(base) {
+				base = base[:len(base)-1]
+			}
+			word = base
+			break
+		}
+	}
+
+	if len(word) > 3 {
+		switch word[len(word)-1] {
+		case 'e':
+			word = word[:len(word)-1]
+		case 'y':
+			word = word[:len(word)-1] + "i"
+		}
+	}
+
+	return word
+}
+
+// isDoubleConsonant checks whether the word ends with a double consonant other than ll, ss or zz, e.g. "runn".
+func isDoubleConsonant(word string) bool {
+	n := len(word)
+	if n < 2 || word[n-1] != word[n-2] {
+		return false
+	}
+
+	return !strings.ContainsRune("aeioulsz", rune(word[n-1]))
+}
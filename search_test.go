@@ -0,0 +1,72 @@
+package emoji
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestSearch(t *testing.T) {
+	if len(emojiAnnotations) == 0 {
+		t.Skip("names and keywords are not built")
+	}
+
+	tt := []struct {
+		query    string
+		opts     []SearchOption
+		contains []string
+		first    string
+		empty    bool
+	}{
+		{query: "smile", contains: []string{"😃", "😊"}},
+		{query: "party", contains: []string{"🥳"}},
+		{query: "Red Heart", first: "❤️"},
+		{query: "partying", contains: []string{"🎉", "🥳"}},
+		{query: "cat fa", first: "🐱"},
+		{query: "cat", opts: []SearchOption{InGroup("Animals & Nature"), WithLimit(1)}, first: "🐈"},
+		{query: "grinning", opts: []SearchOption{WithLimit(2)}, contains: []string{"😀"}},
+		{query: "xyzzy", empty: true},
+		{query: " - ", empty: true},
+	}
+
+	for i, tc := range tt {
+		t.Run(fmt.Sprintf("test #%d", i), func(t *testing.T) {
+			got := Search(tc.query, tc.opts...)
+			codes := make(map[string]bool)
+			for _, r := range got {
+				codes[r.Code] = true
+				if a, _ := annotationOf(r.Code); r.Name != a.name || r.Score <= 0 || r.Score > 1 {
+					t.Fatalf("test case %v fail: got: %+v, expected a valid result", i+1, r)
+				}
+			}
+
+			if tc.empty != (len(got) == 0) {
+				t.Fatalf("test case %v fail: got: %v results, expected empty: %v", i+1, len(got), tc.empty)
+			}
+			if tc.first != "" && (len(got) == 0 || got[0].Code != tc.first) {
+				t.Fatalf("test case %v fail: got: %v, expected first: %v", i+1, got, tc.first)
+			}
+			for _, c := range tc.contains {
+				if !codes[c] {
+					t.Fatalf("test case %v fail: got: %v, expected to contain: %v", i+1, got, c)
+				}
+			}
+		})
+	}
+}
+
+func TestStem(t *testing.T) {
+	tt := [][]string{
+		{"smile", "smiles", "smiling", "smiled"},
+		{"happy", "happily", "happiness"},
+		{"grin", "grinning"},
+		{"heart", "hearts"},
+	}
+
+	for i, words := range tt {
+		for _, w := range words[1:] {
+			if stem(w) != stem(words[0]) {
+				t.Fatalf("test case %v fail: got: %v for %v, expected: %v", i+1, stem(w), w, stem(words[0]))
+			}
+		}
+	}
+}
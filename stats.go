@@ -0,0 +1,89 @@
+package emoji
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// Stats holds emoji statistics of a string.
+type Stats struct {
+	// Total is the number of emojis.
+	Total int
+	// Distinct is the number of different emojis.
+	Distinct int
+	// Groups is the number of emojis per Unicode group, e.g. "Smileys & Emotion".
+	Groups map[string]int
+	// Tones is the number of skin tone modifiers per tone.
+	Tones map[Tone]int
+	// Ratio is the share of emojis among the non-space characters.
+	Ratio float64
+	// EmojiOnly reports whether the string has emojis and whitespace only.
+	EmojiOnly bool
+}
+
+// Count returns the number of emojis in the s string.
+func Count(s string) int {
+	count := 0
+	for i := 0; i < len(s); {
+		start, end := nextEmoji(s, i)
+		if start < 0 {
+			break
+		}
+		count++
+		i = end
+	}
+
+	return count
+}
+
+// GetStats returns emoji statistics of the s string.
+func GetStats(s string) Stats {
+	stats := Stats{
+		Groups: make(map[string]int),
+		Tones:  make(map[Tone]int),
+	}
+	distinct := make(map[string]bool)
+	chars := 0
+
+	for i := 0; i < len(s); {
+		n := emojiLen(s[i:])
+		if n == 0 {
+			r, size := utf8.DecodeRuneInString(s[i:])
+			if !unicode.IsSpace(r) {
+				chars++
+			}
+			i += size
+			continue
+		}
+
+		code := s[i : i+n]
+		stats.Total++
+		distinct[code] = true
+		if grp := groupOf(code); grp != "" {
+			stats.Groups[grp]++
+		}
+		for _, t := range GetAllTones(code) {
+			stats.Tones[t]++
+		}
+		i += n
+	}
+
+	stats.Distinct = len(distinct)
+	if chars+stats.Total > 0 {
+		stats.Ratio = float64(stats.Total) / float64(chars+stats.Total)
+	}
+	stats.EmojiOnly = stats.Total > 0 && chars == 0
+
+	return stats
+}
+
+// groupOf returns the Unicode group name of the emoji code.
+// It returns an empty string if the emoji is not known.
+func groupOf(code string) string {
+	if grp, ok := emojiGroups.find(code); ok {
+		return grp
+	}
+
+	grp, _ := emojiGroups.find(toneRegex.ReplaceAllString(code, ""))
+	return grp
+}
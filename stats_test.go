@@ -0,0 +1,58 @@
+package emoji
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCount(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want int
+	}{
+		{name: "empty input string", in: "", want: 0},
+		{name: "string without emoji", in: "hello 123 #1", want: 0},
+		{name: "simple emojis", in: "I ❤️ 🍕 and 🍣", want: 3},
+		{name: "complex emojis", in: "👩🏽‍❤️‍💋‍👨🏿👨🏿‍🦰 f4mily! 👨‍👨‍👧*️⃣🇹🇷", want: 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Count(tt.in); got != tt.want {
+				t.Errorf("Count() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetStats(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want Stats
+	}{
+		{
+			name: "string without emoji",
+			in:   "hello",
+			want: Stats{Groups: map[string]int{}, Tones: map[Tone]int{}},
+		},
+		{
+			name: "mixed text",
+			in:   "hi 👍🏿👍🏿 🍕",
+			want: Stats{
+				Total:    3,
+				Distinct: 2,
+				Groups:   map[string]int{"People & Body": 2, "Food & Drink": 1},
+				Tones:    map[Tone]int{Dark: 2},
+				Ratio:    0.6,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GetStats(tt.in); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetStats() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package emoji
+
+import (
+	"sort"
+	"strings"
+)
+
+// Suggestion is an emoji alias similar to a given one.
+type Suggestion struct {
+	// Alias is the suggested alias, e.g. ":thumbs_up:".
+	Alias string
+	// Code is the code of the emoji.
+	Code string
+	// Score is the similarity of the aliases between 0 and 1, 1 being the same.
+	Score float64
+}
+
+// Weights of the similarity measures in suggestion scores
+const (
+	editWeight  = 0.7
+	tokenWeight = 0.3
+)
+
+// minSuggestionScore is the minimum score of the suggestions in errors.
+const minSuggestionScore = 0.5
+
+// Suggest returns the n most similar aliases to the given alias, e.g. ":thumbs_up:" for ":thumbsup_:".
+// Similarity is measured by edit distance and overlap of underscore separated words,
+// so words in a different order are similar too, e.g. ":heart_red:" and ":red_heart:".
+func Suggest(alias string, n int) []Suggestion {
+	suggestions := make([]Suggestion, 0)
+	if n <= 0 {
+		return suggestions
+	}
+
+	name := aliasName(alias)
+	tokens := aliasTokens(name)
+	sorted := sortedTokens(tokens)
+
+	emojiMap.each(func(a, code string) {
+		candidate := aliasName(a)
+		candidateTokens := aliasTokens(candidate)
+
+		edit := editSimilarity(name, candidate)
+		if len(tokens) > 1 {
+			if s := editSimilarity(sorted, sortedTokens(candidateTokens)); s > edit {
+				edit = s
+			}
+		}
+
+		score := editWeight*edit + tokenWeight*tokenOverlap(tokens, candidateTokens)
+		if score > 0 {
+			suggestions = append(suggestions, Suggestion{Alias: a, Code: code, Score: score})
+		}
+	})
+
+	sort.Slice(suggestions, func(i, j int) bool {
+		a, b := suggestions[i], suggestions[j]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		if len(a.Alias) != len(b.Alias) {
+			return len(a.Alias) < len(b.Alias)
+		}
+		return a.Alias < b.Alias
+	})
+
+	if len(suggestions) > n {
+		suggestions = suggestions[:n]
+	}
+
+	return suggestions
+}
+
+// suggestion returns the most similar alias to the given name with the delimiters of the Replacer
+// for error messages. It returns an empty string if there is no similar enough alias the Replacer allows.
+func (p *Replacer) suggestion(name string) string {
+	open, close := p.delimiters()
+	for _, s := range Suggest(name, 5) {
+		if s.Score < minSuggestionScore {
+			break
+		}
+		if p.allowed(s.Code) {
+			return open + s.Alias[1:len(s.Alias)-1] + close
+		}
+	}
+
+	return ""
+}
+
+// aliasName returns the lowercase name of the alias without its colons, e.g. "pizza" for ":Pizza:".
+func aliasName(alias string) string {
+	return strings.ToLower(strings.Trim(alias, ":"))
+}
+
+// aliasTokens returns the words of the alias name, e.g. ["thumbs", "up"] for "thumbs_up".
+func aliasTokens(name string) []string {
+	return strings.FieldsFunc(name, func(r rune) bool {
+		return r == '_' || r == '-'
+	})
+}
+
+// sortedTokens returns the tokens in alphabetical order joined with underscores.
+func sortedTokens(tokens []string) string {
+	sorted := append([]string(nil), tokens...)
+	sort.Strings(sorted)
+
+	return strings.Join(sorted, "_")
+}
+
+// editSimilarity returns 1 minus the edit distance of the strings relative to the longer one.
+func editSimilarity(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+	longest := len(ra)
+	if len(rb) > longest {
+		longest = len(rb)
+	}
+	if longest == 0 {
+		return 1
+	}
+
+	return 1 - float64(levenshtein(ra, rb))/float64(longest)
+}
+
+// levenshtein returns the edit distance of the rune slices.
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = minInt(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(b)]
+}
+
+// tokenOverlap returns the Jaccard index of the token sets.
+func tokenOverlap(a, b []string) float64 {
+	set := make(map[string]bool, len(a))
+	for _, t := range a {
+		set[t] = true
+	}
+
+	common := 0
+	union := len(set)
+	seen := make(map[string]bool, len(b))
+	for _, t := range b {
+		if seen[t] {
+			continue
+		}
+		seen[t] = true
+		if set[t] {
+			common++
+		} else {
+			union++
+		}
+	}
+
+	if union == 0 {
+		return 0
+	}
+
+	return float64(common) / float64(union)
+}
+
+func minInt(values ...int) int {
+	m := values[0]
+	for _, v := range values[1:] {
+		if v < m {
+			m = v
+		}
+	}
+
+	return m
+}
@@ -0,0 +1,37 @@
+package emoji
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestSuggest(t *testing.T) {
+	tt := []struct {
+		input    string
+		n        int
+		expected []string
+	}{
+		{input: ":thumbsup_:", n: 2, expected: []string{":thumbsup:", ":thumbs_up:"}},
+		{input: "piza", n: 1, expected: []string{":pizza:"}},
+		{input: ":heart_red:", n: 1, expected: []string{":red_heart:"}},
+		{input: ":Smile:", n: 1, expected: []string{":smile:"}},
+		{input: ":pizza:", n: 0, expected: []string{}},
+	}
+
+	for i, tc := range tt {
+		t.Run(fmt.Sprintf("test #%d", i), func(t *testing.T) {
+			got := Suggest(tc.input, tc.n)
+			if len(got) != len(tc.expected) {
+				t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got, tc.expected)
+			}
+			for j, s := range got {
+				if s.Alias != tc.expected[j] {
+					t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got, tc.expected)
+				}
+				if !isCode(s.Alias, s.Code) || s.Score <= 0 || s.Score > 1 {
+					t.Fatalf("test case %v fail: got: %+v, expected a valid suggestion", i+1, s)
+				}
+			}
+		})
+	}
+}
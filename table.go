@@ -0,0 +1,114 @@
+package emoji
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// table is a table of keys and values packed into strings, "key\x00value\x00" for each entry.
+// Each part of the table is sorted by the keys, so entries are found by binary search. Parts are
+// generated separately, so they can be left out with build tags, e.g. flags with emoji_noflags.
+// Unlike a map literal, a table doesn't need to be built at start-up.
+// It's turned into a map when the map is needed, e.g. to change it, and the map is used from then on.
+type table struct {
+	parts []string
+
+	once    sync.Once
+	offsets [][]uint32 // the offsets of the entries in each part, and the end of the part
+
+	mapOnce sync.Once
+	mapped  uint32 // set when m is built
+	m       map[string]string
+}
+
+func (t *table) load() {
+	t.offsets = make([][]uint32, len(t.parts))
+	for p, data := range t.parts {
+		offsets := make([]uint32, 0, strings.Count(data, "\x00")/2+1)
+		for i := 0; i < len(data); {
+			offsets = append(offsets, uint32(i))
+			i += strings.IndexByte(data[i:], 0) + 1
+			i += strings.IndexByte(data[i:], 0) + 1
+		}
+		t.offsets[p] = append(offsets, uint32(len(data)))
+	}
+}
+
+// entry returns the key and the value of the i-th entry of the p-th part.
+func (t *table) entry(p, i int) (string, string) {
+	e := t.parts[p][t.offsets[p][i] : t.offsets[p][i+1]-1]
+	n := strings.IndexByte(e, 0)
+
+	return e[:n], e[n+1:]
+}
+
+// find returns the value of the key.
+func (t *table) find(key string) (string, bool) {
+	if atomic.LoadUint32(&t.mapped) == 1 {
+		v, ok := t.m[key]
+		return v, ok
+	}
+
+	t.once.Do(t.load)
+	for p := range t.parts {
+		n := len(t.offsets[p]) - 1
+		i := sort.Search(n, func(i int) bool {
+			k, _ := t.entry(p, i)
+			return k >= key
+		})
+		if i < n {
+			if k, v := t.entry(p, i); k == key {
+				return v, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// each calls fn with the keys and the values in no particular order.
+func (t *table) each(fn func(key, value string)) {
+	if atomic.LoadUint32(&t.mapped) == 1 {
+		for k, v := range t.m {
+			fn(k, v)
+		}
+		return
+	}
+
+	t.once.Do(t.load)
+	for p := range t.parts {
+		for i := 0; i < len(t.offsets[p])-1; i++ {
+			fn(t.entry(p, i))
+		}
+	}
+}
+
+// len returns the number of the entries.
+func (t *table) len() int {
+	if atomic.LoadUint32(&t.mapped) == 1 {
+		return len(t.m)
+	}
+
+	t.once.Do(t.load)
+	n := 0
+	for p := range t.parts {
+		n += len(t.offsets[p]) - 1
+	}
+	return n
+}
+
+// toMap returns the map of the table. Changes of the map are seen by the table.
+func (t *table) toMap() map[string]string {
+	t.mapOnce.Do(func() {
+		m := make(map[string]string, t.len())
+		t.each(func(k, v string) {
+			m[k] = v
+		})
+		t.m = m
+		atomic.StoreUint32(&t.mapped, 1)
+	})
+
+	return t.m
+}
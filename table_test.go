@@ -0,0 +1,125 @@
+package emoji
+
+import (
+	"testing"
+)
+
+func TestTable(t *testing.T) {
+	tb := &table{parts: []string{"a\x001\x00c\x003\x00", "", "b\x00\x00"}}
+
+	testCases := []struct {
+		key   string
+		value string
+		ok    bool
+	}{
+		{key: "a", value: "1", ok: true},
+		{key: "b", value: "", ok: true},
+		{key: "c", value: "3", ok: true},
+		{key: "", ok: false},
+		{key: "0", ok: false},
+		{key: "bb", ok: false},
+		{key: "d", ok: false},
+	}
+
+	check := func(mode string) {
+		for _, tc := range testCases {
+			value, ok := tb.find(tc.key)
+			if value != tc.value || ok != tc.ok {
+				t.Errorf("%v: find(%q) = %q, %v, want %q, %v", mode, tc.key, value, ok, tc.value, tc.ok)
+			}
+		}
+		if n := tb.len(); n != 3 {
+			t.Errorf("%v: len() = %v, want 3", mode, n)
+		}
+	}
+
+	check("table")
+
+	m := tb.toMap()
+	if len(m) != 3 || m["a"] != "1" || m["c"] != "3" {
+		t.Fatalf("toMap() = %v", m)
+	}
+	check("map")
+
+	m["d"] = "4"
+	if value, ok := tb.find("d"); !ok || value != "4" {
+		t.Errorf("find(%q) = %q, %v after changing the map", "d", value, ok)
+	}
+}
+
+func TestGeneratedTables(t *testing.T) {
+	for name, parts := range map[string][]string{
+		"emojiMap":        emojiMap.parts,
+		"reverseEmojiMap": reverseEmojiMap.parts,
+		"emojiGroups":     emojiGroups.parts,
+		"emojiVersions":   emojiVersions.parts,
+	} {
+		tb := &table{parts: parts}
+		tb.once.Do(tb.load)
+
+		seen := make(map[string]bool)
+		for p := range parts {
+			prev := ""
+			for i := 0; i < len(tb.offsets[p])-1; i++ {
+				key, value := tb.entry(p, i)
+				if i > 0 && key <= prev {
+					t.Fatalf("%v is not sorted: %q after %q", name, key, prev)
+				}
+				if seen[key] {
+					t.Fatalf("%v has duplicate key %q", name, key)
+				}
+				if got, ok := tb.find(key); !ok || got != value {
+					t.Fatalf("%v: find(%q) = %q, %v, want %q", name, key, got, ok, value)
+				}
+				seen[key] = true
+				prev = key
+			}
+		}
+	}
+}
+
+// isCode checks whether the alias is of the emoji code.
+func isCode(alias, code string) bool {
+	c, ok := Find(alias)
+	return ok && c == code
+}
+
+var benchmarkKeys = []string{":thumbs_up:", ":red_heart:", ":flag_for_turkey:", ":zzz:", ":not_exist:"}
+
+func BenchmarkTableFind(b *testing.B) {
+	tb := &table{parts: emojiMap.parts}
+	tb.once.Do(tb.load)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tb.find(benchmarkKeys[i%len(benchmarkKeys)])
+	}
+}
+
+func BenchmarkMapFind(b *testing.B) {
+	m := (&table{parts: emojiMap.parts}).toMap()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = m[benchmarkKeys[i%len(benchmarkKeys)]]
+	}
+}
+
+// BenchmarkTableLoad measures the first use of a table, which replaces the initialization of a map literal.
+func BenchmarkTableLoad(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		tb := &table{parts: emojiMap.parts}
+		tb.once.Do(tb.load)
+	}
+}
+
+// BenchmarkMapBuild measures building the map of a table, which is what a map literal does at start-up.
+func BenchmarkMapBuild(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		(&table{parts: emojiMap.parts}).toMap()
+	}
+}
@@ -0,0 +1,63 @@
+package emoji
+
+import "unicode/utf8"
+
+// TruncateRunes returns the longest prefix of s with at most n runes.
+// It never cuts inside an emoji sequence such as a flag or a family.
+func TruncateRunes(s string, n int) string {
+	count := 0
+	for i := 0; i < len(s); {
+		size := unitLen(s[i:])
+		runes := utf8.RuneCountInString(s[i : i+size])
+		if count+runes > n {
+			return s[:i]
+		}
+		count += runes
+		i += size
+	}
+
+	return s
+}
+
+// TruncateBytes returns the longest prefix of s with at most n bytes.
+// It never cuts inside a rune or an emoji sequence.
+func TruncateBytes(s string, n int) string {
+	for i := 0; i < len(s); {
+		size := unitLen(s[i:])
+		if i+size > n {
+			return s[:i]
+		}
+		i += size
+	}
+
+	return s
+}
+
+// SafeSlice returns s[start:end] shrunk to the nearest boundaries that don't cut
+// inside a rune or an emoji sequence. Out of range offsets are clamped to s.
+func SafeSlice(s string, start, end int) string {
+	if start < 0 {
+		start = 0
+	}
+	if end > len(s) {
+		end = len(s)
+	}
+
+	from, to := -1, 0
+	for i := 0; i <= end; {
+		if from < 0 && i >= start {
+			from = i
+		}
+		to = i
+		if i == len(s) {
+			break
+		}
+		i += unitLen(s[i:])
+	}
+
+	if from < 0 || from >= to {
+		return ""
+	}
+
+	return s[from:to]
+}
@@ -0,0 +1,75 @@
+package emoji
+
+import "testing"
+
+func TestTruncateRunes(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		n    int
+		want string
+	}{
+		{name: "plain text", in: "hello world", n: 5, want: "hello"},
+		{name: "shorter than limit", in: "hi 👋", n: 10, want: "hi 👋"},
+		{name: "zero", in: "hello", n: 0, want: ""},
+		{name: "flag is not split", in: "go 🇹🇷", n: 4, want: "go "},
+		{name: "flag fits", in: "go 🇹🇷", n: 5, want: "go 🇹🇷"},
+		{name: "family is not split", in: "a👨‍👨‍👧b", n: 4, want: "a"},
+		{name: "keycap is not split", in: "1️⃣ one", n: 2, want: ""},
+		{name: "skin tone is not split", in: "👍🏿!", n: 1, want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TruncateRunes(tt.in, tt.n); got != tt.want {
+				t.Errorf("TruncateRunes() = [%v], want [%v]", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTruncateBytes(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		n    int
+		want string
+	}{
+		{name: "plain text", in: "hello world", n: 5, want: "hello"},
+		{name: "rune is not split", in: "aé", n: 2, want: "a"},
+		{name: "flag is not split", in: "go 🇹🇷", n: 7, want: "go "},
+		{name: "flag fits", in: "go 🇹🇷", n: 11, want: "go 🇹🇷"},
+		{name: "kiss is not split", in: "👩🏽‍❤️‍💋‍👨🏿 hi", n: 30, want: ""},
+		{name: "negative", in: "hello", n: -1, want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TruncateBytes(tt.in, tt.n); got != tt.want {
+				t.Errorf("TruncateBytes() = [%v], want [%v]", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSafeSlice(t *testing.T) {
+	tests := []struct {
+		name       string
+		in         string
+		start, end int
+		want       string
+	}{
+		{name: "plain text", in: "hello world", start: 6, end: 11, want: "world"},
+		{name: "clamped", in: "hello", start: -3, end: 42, want: "hello"},
+		{name: "start inside flag", in: "🇹🇷go", start: 4, end: 10, want: "go"},
+		{name: "end inside flag", in: "go🇹🇷", start: 0, end: 6, want: "go"},
+		{name: "whole flag", in: "go🇹🇷", start: 2, end: 10, want: "🇹🇷"},
+		{name: "inside one emoji", in: "👨‍👨‍👧", start: 1, end: 5, want: ""},
+		{name: "inverted", in: "hello", start: 4, end: 2, want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SafeSlice(tt.in, tt.start, tt.end); got != tt.want {
+				t.Errorf("SafeSlice() = [%v], want [%v]", got, tt.want)
+			}
+		})
+	}
+}
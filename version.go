@@ -0,0 +1,71 @@
+package emoji
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Version is an Emoji version, e.g. 13.0.
+type Version struct {
+	Major int
+	Minor int
+}
+
+// String returns the version in major.minor format, e.g. "13.0".
+func (v Version) String() string {
+	return fmt.Sprintf("%d.%d", v.Major, v.Minor)
+}
+
+// After checks whether v is a later version than o.
+func (v Version) After(o Version) bool {
+	if v.Major != o.Major {
+		return v.Major > o.Major
+	}
+
+	return v.Minor > o.Minor
+}
+
+// IsZero checks whether v is the zero version, which unknown emojis have.
+func (v Version) IsZero() bool {
+	return v == Version{}
+}
+
+// Since returns the Emoji version that introduced the emoji, e.g. 13.0 for 🥲.
+// It returns the zero version for unknown emojis.
+func Since(code string) Version {
+	if v, ok := emojiVersions.find(code); ok {
+		return parseVersion(v)
+	}
+
+	// emojis might be written without their variation selectors, e.g. ❤
+	if v, ok := emojiVersions.find(code + string(emojiPresentation)); ok {
+		return parseVersion(v)
+	}
+
+	return Version{}
+}
+
+// parseVersion parses the version in major.minor format, e.g. "13.0".
+func parseVersion(s string) Version {
+	var v Version
+	major, minor := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		major, minor = s[:i], s[i+1:]
+	}
+	v.Major, _ = strconv.Atoi(major)
+	v.Minor, _ = strconv.Atoi(minor)
+
+	return v
+}
+
+// FilterMaxVersion removes the emojis introduced after the v version from the s string,
+// e.g. to avoid sending emojis that older systems can't render. Unknown emojis are kept.
+func FilterMaxVersion(s string, v Version) string {
+	return ReplaceEmojiFunc(s, func(m Match) string {
+		if Since(m.Code).After(v) {
+			return ""
+		}
+		return m.Code
+	})
+}
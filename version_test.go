@@ -0,0 +1,70 @@
+package emoji
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestSince(t *testing.T) {
+	tt := []struct {
+		code     string
+		expected Version
+	}{
+		{code: "😀", expected: Version{1, 0}},
+		{code: "🥲", expected: Version{13, 0}},
+		{code: MeltingFace.String(), expected: Version{14, 0}},
+		{code: "❤", expected: Version{0, 6}},
+		{code: "👍🏽", expected: Version{1, 0}},
+		{code: "a", expected: Version{}},
+	}
+
+	for i, tc := range tt {
+		t.Run(fmt.Sprintf("test #%d", i), func(t *testing.T) {
+			if got := Since(tc.code); got != tc.expected {
+				t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestVersion(t *testing.T) {
+	if got := (Version{12, 1}).String(); got != "12.1" {
+		t.Fatalf("test case fail: got: %v, expected: 12.1", got)
+	}
+	if !(Version{12, 1}).After(Version{12, 0}) || !(Version{13, 0}).After(Version{12, 1}) || (Version{12, 0}).After(Version{12, 0}) {
+		t.Fatalf("test case fail: versions are not compared correctly")
+	}
+}
+
+func TestFilterMaxVersion(t *testing.T) {
+	tt := []struct {
+		input    string
+		version  Version
+		expected string
+	}{
+		{input: "hi 😀🥲 🫠!", version: Version{12, 0}, expected: "hi 😀 !"},
+		{input: "hi 😀🥲 🫠!", version: Version{13, 0}, expected: "hi 😀🥲 !"},
+		{input: "hi 😀🥲 🫠!", version: Version{14, 0}, expected: "hi 😀🥲 🫠!"},
+	}
+
+	for i, tc := range tt {
+		t.Run(fmt.Sprintf("test #%d", i), func(t *testing.T) {
+			if got := FilterMaxVersion(tc.input, tc.version); got != tc.expected {
+				t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestReplacerWithMaxVersion(t *testing.T) {
+	r := NewReplacer(WithMaxVersion(Version{13, 0}))
+	if got := r.Replace(":pizza: :melting_face: :smiling_face_with_tear:"); got != "🍕 :melting_face: 🥲" {
+		t.Fatalf("test case fail: got: %v, expected: 🍕 :melting_face: 🥲", got)
+	}
+
+	_, err := NewReplacer(WithMaxVersion(Version{13, 0}), OnUnknown(FailOnUnknown)).ReplaceStrict(":melting_face:")
+	expected := `unknown emoji alias ":melting_face:" at offset 0, did you mean ":lying_face:"?`
+	if err == nil || err.Error() != expected {
+		t.Fatalf("test case fail: got: %v, expected: %v", err, expected)
+	}
+}